@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"tts_proxy/internal/domain"
+)
+
+// runAudiobookCLI는 "audiobook" 하위 명령을 실행합니다. 문서를 제출하거나(-in) 중단된 작업을 재개하고(-resume),
+// 작업이 끝날 때까지 진행률을 출력한 뒤 결과 파일을 -out 디렉토리로 복사합니다.
+func runAudiobookCLI(service domain.AudiobookService, args []string) error {
+	fs := flag.NewFlagSet("audiobook", flag.ContinueOnError)
+	in := fs.String("in", "", "변환할 Markdown/텍스트 파일 (-이면 표준 입력)")
+	voice := fs.String("voice", "", "Voice ID 또는 음성 별칭")
+	title := fs.String("title", "", "오디오북 제목 (비우면 첫 챕터 제목)")
+	format := fs.String("format", "", "문서 형식: markdown, text (비우면 자동 판별)")
+	language := fs.String("language", "", "합성 언어 (비우면 자동 판별)")
+	style := fs.String("style", "", "합성 스타일")
+	model := fs.String("model", "", "합성 모델")
+	resume := fs.String("resume", "", "재개할 작업 ID")
+	out := fs.String("out", "", "결과 파일을 복사할 디렉토리")
+	interval := fs.Duration("interval", time.Second, "진행률 확인 간격")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	var job *domain.AudiobookJob
+	var err error
+	switch {
+	case *resume != "":
+		job, err = service.Resume(ctx, *resume)
+	case *in != "":
+		var doc []byte
+		if *in == "-" {
+			doc, err = io.ReadAll(os.Stdin)
+		} else {
+			doc, err = os.ReadFile(*in)
+		}
+		if err != nil {
+			return err
+		}
+		job, err = service.Submit(ctx, &domain.AudiobookRequest{
+			Title:       *title,
+			Document:    string(doc),
+			Format:      *format,
+			VoiceID:     *voice,
+			TTSDefaults: domain.TTSDefaults{Language: *language, Style: *style, Model: *model},
+		})
+	default:
+		fs.Usage()
+		return fmt.Errorf("either -in or -resume is required")
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Audiobook job %s: %s (%d chapters)\n", job.ID, job.Title, len(job.Chapters))
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+	for job.Status != domain.AudiobookCompleted && job.Status != domain.AudiobookFailed {
+		<-ticker.C
+		if job, err = service.Get(ctx, job.ID); err != nil {
+			return err
+		}
+		fmt.Printf("\r%-9s %5.1f%%", job.Status, job.Progress()*100)
+	}
+	fmt.Println()
+
+	if job.Status == domain.AudiobookFailed {
+		return fmt.Errorf("job %s failed: %s (resume with -resume %s)", job.ID, job.Error, job.ID)
+	}
+	if *out == "" {
+		return nil
+	}
+	return copyAudiobookFiles(ctx, service, job, *out)
+}
+
+func copyAudiobookFiles(ctx context.Context, service domain.AudiobookService, job *domain.AudiobookJob, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	names := []string{"playlist.m3u", "chapters.json"}
+	for _, ch := range job.Chapters {
+		names = append(names, ch.File)
+	}
+	for _, name := range names {
+		if err := copyAudiobookFile(ctx, service, job.ID, name, filepath.Join(dir, name)); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Wrote %d files to %s\n", len(names), dir)
+	return nil
+}
+
+func copyAudiobookFile(ctx context.Context, service domain.AudiobookService, id, name, dst string) error {
+	r, _, err := service.OpenFile(ctx, id, name)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	f, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
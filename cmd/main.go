@@ -1,41 +1,299 @@
 package main
 
 import (
-	"log"
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"tts_proxy/internal/domain"
 	"tts_proxy/internal/infrastructure"
 	"tts_proxy/internal/interface/handler"
 	"tts_proxy/internal/interface/middleware"
 	"tts_proxy/internal/usecase"
 	"tts_proxy/pkg/config"
+	"tts_proxy/pkg/logging"
+	"tts_proxy/pkg/metrics"
+	"tts_proxy/pkg/tracing"
 )
 
 type mockAuthService struct{}
 func (m *mockAuthService) ValidateToken(token string) (string, error) { return "", nil }
 
 func main() {
-	cfg := config.LoadConfig()
-	ttsConfig := config.LoadTTSConfig()
+	// tts_proxy [--config config.yaml] [audiobook|watermark ...]
+	configPath := flag.String("config", os.Getenv("CONFIG_FILE"), "YAML configuration file (environment variables override its values)")
+	flag.Parse()
+	cfg, ttsConfig, err := config.Load(*configPath)
+	if err != nil {
+		// 로거를 만들기 전이고 문제가 여러 줄이므로 그대로 출력
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	secrets := logging.NewSecretSet()
+	logger, err := newLogger(cfg, ttsConfig, secrets)
+	if err != nil {
+		fatal("logger", err)
+	}
+	slog.SetDefault(logger)
+	tracer, err := newTracer(cfg)
+	if err != nil {
+		fatal("tracer", err)
+	}
+	tracing.SetDefault(tracer)
+
+	metricsRegistry := metrics.NewRegistry()
+	usecaseMetrics := usecase.NewMetrics(metricsRegistry)
 
 	ttsAdapter := infrastructure.NewTTSProxyAdapter(infrastructure.TTSProxyConfig{
 		APIURL: ttsConfig.APIURL,
 		APIKey: ttsConfig.APIKey,
-	})
-	ttsService := usecase.NewTTSService(ttsAdapter)
+	}, time.Duration(ttsConfig.Timeout)*time.Second)
+	presetStore, err := infrastructure.NewPresetStore(cfg.PresetsFile)
+	if err != nil {
+		fatal("preset store", err)
+	}
+	aliasConfigs, err := config.LoadVoiceAliasesFile(cfg.VoiceAliasesFile)
+	if err != nil {
+		fatal("voice alias", err)
+	}
+	voiceAliases := usecase.NewVoiceAliasRegistry(toVoiceAliases(aliasConfigs))
+	lexiconStore, err := infrastructure.NewLexiconStore(cfg.LexiconsFile)
+	if err != nil {
+		fatal("lexicon store", err)
+	}
+	globalLexicons, err := infrastructure.LoadLexiconDir(cfg.LexiconDir)
+	if err != nil {
+		fatal("global lexicon", err)
+	}
+	backgroundStore, err := infrastructure.NewBackgroundStore(cfg.BackgroundDir)
+	if err != nil {
+		fatal("background store", err)
+	}
+	bedCache := usecase.NewBedCache()
+	auditLog, err := infrastructure.NewAuditLog(cfg.AuditLogDir, int64(cfg.AuditLogMaxBytes))
+	if err != nil {
+		fatal("audit log", err)
+	}
+	usageStore, err := infrastructure.NewUsageStore(cfg.UsageFile, time.Duration(cfg.UsageRetentionDays)*24*time.Hour)
+	if err != nil {
+		fatal("usage store", err)
+	}
+	pricing, err := config.LoadModelPricingFile(cfg.ModelPricingFile)
+	if err != nil {
+		fatal("model pricing", err)
+	}
+	// 회로 차단기 → 지표 → 업스트림 순서로 감싸 열린 회로에서 막힌 요청은 업스트림 지표에 포함되지 않음
+	circuitBreaker := usecase.NewCircuitBreaker(usecaseMetrics.InstrumentAdapter(ttsAdapter, string(ttsConfig.Provider), voiceAliases),
+		cfg.CircuitFailureThreshold, time.Duration(cfg.CircuitCooldown)*time.Second)
+	ttsService := usecase.NewTTSService(circuitBreaker,
+		usecase.WithPresets(presetStore),
+		usecase.WithVoiceAliases(voiceAliases),
+		usecase.WithLexicons(lexiconStore, globalLexicons),
+		usecase.WithBackgrounds(backgroundStore, bedCache),
+		usecase.WithWatermark([]byte(cfg.WatermarkKey), cfg.WatermarkAll),
+		usecase.WithAudit(auditLog, string(ttsConfig.Provider), cfg.AuditLogText),
+		usecase.WithUsage(usageStore),
+	)
 	authService := &mockAuthService{} // 실제 구현시 대체
 	ttsHandler := handler.NewTTSHandler(ttsService, authService)
+	ttsHandler.VoiceAliases = voiceAliases
+	presetHandler := handler.NewPresetHandler(usecase.NewPresetService(presetStore))
+	lexiconHandler := handler.NewLexiconHandler(usecase.NewLexiconService(lexiconStore))
+	voiceService := usecase.NewVoiceService(ttsAdapter, time.Duration(cfg.VoiceCacheTTL)*time.Second,
+		usecase.WithVoiceCacheMetrics(usecaseMetrics))
+	voiceHandler := handler.NewVoiceHandler(voiceService)
+	dialogueService := usecase.NewDialogueService(ttsService, voiceAliases, cfg.DialogueConcurrency)
+	dialogueHandler := handler.NewDialogueHandler(dialogueService)
+	audiobookStore, err := infrastructure.NewAudiobookStore(cfg.AudiobookDir)
+	if err != nil {
+		fatal("audiobook store", err)
+	}
+	audiobookService := usecase.NewAudiobookService(ttsService, audiobookStore, voiceAliases, cfg.AudiobookWorkers)
+	audiobookHandler := handler.NewAudiobookHandler(audiobookService)
+	backgroundHandler := handler.NewBackgroundHandler(usecase.NewBackgroundService(backgroundStore, bedCache))
+	healthService := usecase.NewHealthService(usecase.HealthConfig{
+		Credentials: func() (string, string) {
+			c := ttsAdapter.Config()
+			return c.APIURL, c.APIKey
+		},
+		Prober:        ttsAdapter,
+		ProbeTimeout:  time.Duration(cfg.HealthProbeTimeoutMS) * time.Millisecond,
+		Circuit:       circuitBreaker,
+		Adapter:       circuitBreaker,
+		CanaryVoiceID: cfg.HealthCanaryVoice,
+		CanaryText:    cfg.HealthCanaryText,
+		CanaryTTL:     time.Duration(cfg.HealthCanaryTTL) * time.Second,
+	})
+	healthHandler := handler.NewHealthHandler(healthService)
+	watermarkService := usecase.NewWatermarkService([]byte(cfg.WatermarkKey))
+	watermarkHandler := handler.NewWatermarkHandler(watermarkService)
+	auditHandler := handler.NewAuditHandler(usecase.NewAuditService(auditLog))
+	usageHandler := handler.NewUsageHandler(usecase.NewUsageService(usageStore, toUsagePricing(pricing)))
+
+	// tts_proxy audiobook -in book.md -voice narrator -out out/
+	if flag.Arg(0) == "audiobook" {
+		if err := runAudiobookCLI(audiobookService, flag.Args()[1:]); err != nil {
+			fatal("audiobook", err)
+		}
+		return
+	}
+	// tts_proxy watermark -in leaked.wav [-user alice]
+	if flag.Arg(0) == "watermark" {
+		if err := runWatermarkCLI(watermarkService, flag.Args()[1:]); err != nil {
+			fatal("watermark", err)
+		}
+		return
+	}
 	authMiddleware := middleware.NewAuthMiddleware(authService)
 
 	server := infrastructure.NewHTTPServer(infrastructure.ServerConfig{
 		Port:        cfg.Port,
 		TTSEndpoint: cfg.TTSEndpoint,
 		APIVersion:  cfg.APIVersion,
-	}, ttsHandler, authMiddleware)
+		AdminKey:    cfg.AdminAPIKey,
+		BodyLimit:   cfg.MaxBodyBytes,
+		Metrics:     metricsRegistry,
+	}, infrastructure.Handlers{
+		TTS:        ttsHandler,
+		Voice:      voiceHandler,
+		Preset:     presetHandler,
+		Lexicon:    lexiconHandler,
+		Dialogue:   dialogueHandler,
+		Audiobook:  audiobookHandler,
+		Background: backgroundHandler,
+		Watermark:  watermarkHandler,
+		Health:     healthHandler,
+		Audit:      auditHandler,
+		Usage:      usageHandler,
+	}, authMiddleware)
+
+	// 서버가 실행 중에 종료되어 끝나지 않은 오디오북 작업을 이어서 실행
+	if n, err := audiobookService.ResumePending(); err != nil {
+		slog.Warn("failed to resume audiobook jobs", "error", err)
+	} else if n > 0 {
+		slog.Info("resumed audiobook jobs", "count", n)
+	}
 	
-	log.Printf("[INFO] Server starting on :%s", cfg.Port)
-	log.Printf("[INFO] Using TTS Provider: %s", ttsConfig.Provider)
-	log.Printf("[INFO] API Endpoint: /api/%s%s", cfg.APIVersion, cfg.TTSEndpoint)
-	if err := server.Start(cfg.Port); err != nil {
-		log.Fatalf("[FATAL] Server error: %v", err)
+	slog.Info("server starting",
+		"port", cfg.Port,
+		"provider", ttsConfig.Provider,
+		"global_lexicons", len(globalLexicons),
+		"lexicon_dir", cfg.LexiconDir,
+		"endpoint", fmt.Sprintf("/api/%s%s", cfg.APIVersion, cfg.TTSEndpoint),
+	)
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGTERM, os.Interrupt)
+	defer stop()
+	reloader := &configReloader{
+		path:         *configPath,
+		adapter:      ttsAdapter,
+		circuit:      circuitBreaker,
+		aliases:      voiceAliases,
+		secrets:      secrets,
+		cfg:          *cfg,
+		tts:          *ttsConfig,
+		aliasConfigs: aliasConfigs,
+	}
+	go reloader.Run(ctx, time.Duration(cfg.ReloadInterval)*time.Second)
+	serverErr := make(chan error, 1)
+	go func() { serverErr <- server.Start(cfg.Port) }()
+	select {
+	case err := <-serverErr:
+		fatal("server", err)
+	case <-ctx.Done():
+	}
+	stop() // 정리 중에 신호를 한 번 더 받으면 바로 종료
+	gracefulShutdown(shutdownConfig{
+		Server:      server,
+		Health:      healthService,
+		Audiobooks:  audiobookService,
+		Usage:       usageStore,
+		Metrics:     metricsRegistry,
+		MetricsFile: cfg.MetricsSnapshotFile,
+		DrainDelay:  time.Duration(cfg.ShutdownDrainDelay) * time.Second,
+		Timeout:     time.Duration(cfg.ShutdownTimeout) * time.Second,
+	})
+}
+
+// newLogger는 환경 설정으로 구조화 로거를 만듭니다. API 키와 관리자 키는 어느 로그에 나타나도 가려집니다.
+func newLogger(cfg *config.Config, ttsConfig *config.TTSAPIConfig, secrets *logging.SecretSet) (*slog.Logger, error) {
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	return logging.New(os.Stderr, logging.Options{
+		Level:       level,
+		Format:      cfg.LogFormat,
+		LogText:     cfg.LogText,
+		Secrets:     []string{ttsConfig.APIKey, cfg.TTSAPIKey, cfg.AdminAPIKey, cfg.WatermarkKey},
+		SecretSet:   secrets,
+		SampleBurst: cfg.LogSampleBurst,
+		SampleEvery: cfg.LogSampleEvery,
+	})
+}
+
+// newTracer는 TRACE_EXPORTER 설정에 따라 Tracer를 만듭니다. none이면 nil(추적 안 함)을 반환합니다.
+func newTracer(cfg *config.Config) (*tracing.Tracer, error) {
+	var exporter tracing.Exporter
+	switch cfg.TraceExporter {
+	case "", "none":
+		return nil, nil
+	case "stdout":
+		exporter = tracing.NewWriterExporter(os.Stdout, cfg.TraceServiceName)
+	case "otlp":
+		exporter = tracing.NewOTLPExporter(cfg.TraceOTLPEndpoint, cfg.TraceServiceName)
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", cfg.TraceExporter)
+	}
+	return tracing.NewTracer(&loggingExporter{exporter}, tracing.TracerOptions{SampleRatio: cfg.TraceSampleRatio}), nil
+}
+
+// loggingExporter는 내보내기 실패를 경고로 기록합니다. 수집기가 꺼져 있어도 요청 처리에는 영향을 주지 않습니다.
+type loggingExporter struct {
+	tracing.Exporter
+}
+
+func (e *loggingExporter) Export(ctx context.Context, spans []tracing.SpanData) error {
+	err := e.Exporter.Export(ctx, spans)
+	if err != nil {
+		slog.Warn("failed to export spans", "spans", len(spans), "error", err)
+	}
+	return err
+}
+
+// fatal은 오류를 기록하고 프로세스를 종료합니다.
+func fatal(msg string, err error) {
+	slog.Error(msg+" error", "error", err)
+	os.Exit(1)
+}
+
+// toVoiceAliases는 설정 파일의 별칭을 도메인 모델로 변환합니다.
+func toVoiceAliases(configs map[string]config.VoiceAliasConfig) []domain.VoiceAlias {
+	aliases := make([]domain.VoiceAlias, 0, len(configs))
+	for name, c := range configs {
+		aliases = append(aliases, domain.VoiceAlias{
+			Name:    name,
+			VoiceID: c.VoiceID,
+			Defaults: domain.TTSDefaults{
+				Language:      c.Language,
+				Style:         c.Style,
+				Model:         c.Model,
+				VoiceSettings: c.VoiceSettings,
+			},
+		})
+	}
+	return aliases
+}
+
+// toUsagePricing은 가격표 설정을 도메인 모델로 변환합니다.
+func toUsagePricing(c *config.ModelPricingConfig) domain.UsagePricing {
+	pricing := domain.UsagePricing{Currency: c.Currency, Models: make(map[string]domain.ModelPrice, len(c.Models))}
+	for model, price := range c.Models {
+		pricing.Models[model] = domain.ModelPrice{PerThousandChars: price.PerThousandChars, PerAudioMinute: price.PerAudioMinute}
 	}
-} 
\ No newline at end of file
+	return pricing
+}
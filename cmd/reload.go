@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"os/signal"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"tts_proxy/internal/infrastructure"
+	"tts_proxy/internal/usecase"
+	"tts_proxy/pkg/config"
+	"tts_proxy/pkg/logging"
+)
+
+// liveSettings는 재시작 없이 실행 중에 바꿀 수 있는 설정 항목입니다. 나머지 항목은 바뀌면 재시작이 필요하다고 경고합니다.
+var liveSettings = map[string]bool{
+	"providers.supertone.api_url":                 true,
+	"providers.supertone.api_key":                 true,
+	"providers.circuit_breaker.failure_threshold": true,
+	"providers.circuit_breaker.cooldown":          true,
+}
+
+// configReloader는 설정 파일, 보안 파일, 음성 별칭 파일을 다시 읽어 업스트림 자격 증명, 회로 차단기 기준, 음성 별칭을 바꿉니다.
+// 다시 읽은 설정이 검증을 통과하지 못하면 아무것도 바꾸지 않습니다.
+type configReloader struct {
+	path    string // --config, 비어 있으면 환경 변수와 보안 파일만 다시 읽음
+	adapter *infrastructure.TTSProxyAdapter
+	circuit *usecase.CircuitBreaker
+	aliases *usecase.VoiceAliasRegistry
+	secrets *logging.SecretSet
+
+	mu           sync.Mutex
+	cfg          config.Config // 실행 중인 설정
+	tts          config.TTSAPIConfig
+	aliasConfigs map[string]config.VoiceAliasConfig
+}
+
+// Run은 SIGHUP을 받거나, interval마다 확인해 감시하는 파일이 바뀌었으면 설정을 다시 읽습니다. ctx가 끝나면 돌아옵니다.
+func (r *configReloader) Run(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	watcher := config.NewFileWatcher(r.path, r.cfg.SecretsFile, r.cfg.VoiceAliasesFile)
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			watcher.Changed() // 이번에 읽을 변경을 다음 확인에서 다시 읽지 않도록
+			r.Reload("SIGHUP")
+		case <-tick:
+			if changed := watcher.Changed(); len(changed) > 0 {
+				r.Reload("file changed: " + strings.Join(changed, ", "))
+			}
+		}
+	}
+}
+
+// Reload는 설정을 다시 읽어 바뀐 항목을 적용하고 무엇이 바뀌었는지 기록합니다.
+// 처리 중인 요청은 시작할 때의 자격 증명과 별칭으로 끝까지 처리됩니다.
+func (r *configReloader) Reload(reason string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cfg, tts, err := config.Load(r.path)
+	if err != nil {
+		slog.Error("configuration reload rejected, keeping the running configuration", "reason", reason, "error", err)
+		return err
+	}
+	aliasConfigs, err := config.LoadVoiceAliasesFile(r.cfg.VoiceAliasesFile)
+	if err != nil {
+		slog.Error("configuration reload rejected, keeping the running configuration", "reason", reason, "error", err)
+		return err
+	}
+
+	var applied, restart []string
+	for _, name := range config.Changed(&r.cfg, &r.tts, cfg, tts) {
+		if liveSettings[name] {
+			applied = append(applied, name)
+		} else {
+			restart = append(restart, name)
+		}
+	}
+
+	if tts.APIURL != r.tts.APIURL || tts.APIKey != r.tts.APIKey {
+		r.secrets.Add(tts.APIKey) // 새 키도 로그에서 가려지도록 바꾸기 전에 추가
+		r.adapter.SetConfig(infrastructure.TTSProxyConfig{APIURL: tts.APIURL, APIKey: tts.APIKey})
+		r.tts.APIURL, r.tts.APIKey = tts.APIURL, tts.APIKey
+	}
+	if cfg.CircuitFailureThreshold != r.cfg.CircuitFailureThreshold || cfg.CircuitCooldown != r.cfg.CircuitCooldown {
+		r.circuit.SetLimits(cfg.CircuitFailureThreshold, time.Duration(cfg.CircuitCooldown)*time.Second)
+		r.cfg.CircuitFailureThreshold, r.cfg.CircuitCooldown = cfg.CircuitFailureThreshold, cfg.CircuitCooldown
+	}
+	added, removed, updated := diffVoiceAliases(r.aliasConfigs, aliasConfigs)
+	if len(added)+len(removed)+len(updated) > 0 {
+		r.aliases.Replace(toVoiceAliases(aliasConfigs))
+		r.aliasConfigs = aliasConfigs
+	}
+
+	if len(restart) > 0 {
+		// 적용하지 않은 항목은 실행 중인 값을 유지하므로 다음에 다시 읽을 때도 경고함
+		slog.Warn("changed settings require a restart to take effect", "reason", reason, "settings", restart)
+	}
+	if len(applied)+len(added)+len(removed)+len(updated) == 0 {
+		slog.Info("configuration reloaded, nothing to apply", "reason", reason)
+		return nil
+	}
+	attrs := []any{"reason", reason}
+	for _, field := range []struct {
+		key   string
+		names []string
+	}{{"changed", applied}, {"voice_aliases_added", added}, {"voice_aliases_removed", removed}, {"voice_aliases_updated", updated}} {
+		if len(field.names) > 0 {
+			attrs = append(attrs, field.key, field.names)
+		}
+	}
+	slog.Info("configuration reloaded", attrs...)
+	return nil
+}
+
+// diffVoiceAliases는 추가, 삭제, 변경된 별칭 이름을 정렬해 반환합니다.
+func diffVoiceAliases(before, after map[string]config.VoiceAliasConfig) (added, removed, updated []string) {
+	for name, a := range after {
+		b, ok := before[name]
+		switch {
+		case !ok:
+			added = append(added, name)
+		case !reflect.DeepEqual(a, b):
+			updated = append(updated, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	sort.Strings(updated)
+	return added, removed, updated
+}
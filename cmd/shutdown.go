@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/internal/infrastructure"
+	"tts_proxy/internal/usecase"
+	"tts_proxy/pkg/metrics"
+	"tts_proxy/pkg/tracing"
+)
+
+// shutdownConfig는 종료할 때 정리할 구성 요소와 기한입니다.
+type shutdownConfig struct {
+	Server      *infrastructure.HTTPServer
+	Health      domain.HealthService
+	Audiobooks  *usecase.AudiobookService
+	Usage       *infrastructure.UsageStore
+	Metrics     *metrics.Registry
+	MetricsFile string // 비어 있으면 지표 스냅샷을 저장하지 않음
+	DrainDelay  time.Duration
+	Timeout     time.Duration
+}
+
+// gracefulShutdown은 종료 신호를 받은 뒤 다음 순서로 서버를 정리합니다.
+//  1. /readyz를 실패시키고 DrainDelay 동안 요청을 계속 받아 로드 밸런서가 대상에서 빼도록 함
+//  2. 새 연결을 받지 않고 처리 중인 요청을 기다림
+//  3. 실행 중인 오디오북 작업을 기다리고, 기한이 지나면 취소함 (대기 중이거나 취소된 작업은 다음 시작 때 재개)
+//  4. 사용량 집계, 지표 스냅샷, 남은 스팬을 내보냄
+//
+// 2와 3은 합쳐서 Timeout 안에 끝나야 합니다. 기한이 지나면 기다리기를 멈추고 진행하며, 그때까지 끝나지 않은 요청은
+// 강제로 닫지 않고 남은 정리를 마친 뒤 프로세스가 끝날 때 응답 도중 끊깁니다. 끊길 요청 수를 경고로 남깁니다.
+func gracefulShutdown(cfg shutdownConfig) {
+	slog.Info("shutdown started", "drain_delay", cfg.DrainDelay.String(), "timeout", cfg.Timeout.String())
+	cfg.Health.Drain()
+	time.Sleep(cfg.DrainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
+	defer cancel()
+	if err := cfg.Server.Shutdown(cfg.Timeout); err != nil {
+		slog.Warn("http requests still running at the shutdown deadline will be cut off on exit",
+			"requests", cfg.Server.InFlight(), "error", err)
+	}
+	if err := cfg.Audiobooks.Shutdown(ctx); err != nil {
+		slog.Warn("audiobook jobs cancelled at the shutdown deadline, they will resume on next start", "error", err)
+	}
+
+	// 다른 저장소와 감사 로그는 기록할 때마다 파일에 쓰고 동기화하므로 따로 비울 것이 없음.
+	// 기한 안에 끝나지 않은 오디오북 작업이 계속 기록할 수 있도록 감사 로그는 닫지 않음
+	if err := cfg.Usage.Close(); err != nil {
+		slog.Warn("failed to flush usage", "error", err)
+	}
+	if cfg.MetricsFile != "" {
+		if err := cfg.Metrics.WriteFile(cfg.MetricsFile); err != nil {
+			slog.Warn("failed to write metrics snapshot", "path", cfg.MetricsFile, "error", err)
+		}
+	}
+	flushCtx, flushCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer flushCancel()
+	if err := tracing.Default().Shutdown(flushCtx); err != nil {
+		slog.Warn("failed to flush spans", "error", err)
+	}
+	slog.Info("shutdown complete")
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"tts_proxy/internal/domain"
+)
+
+// runWatermarkCLI는 "watermark" 하위 명령을 실행합니다. WAV 파일에서 워터마크를 찾아 결과를 JSON으로 출력하고,
+// 워터마크가 없으면 오류를 반환합니다.
+func runWatermarkCLI(service domain.WatermarkService, args []string) error {
+	fs := flag.NewFlagSet("watermark", flag.ContinueOnError)
+	in := fs.String("in", "", "검사할 WAV 파일 (-이면 표준 입력)")
+	user := fs.String("user", "", "워터마크의 사용자 지문과 비교할 사용자 ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *in == "" {
+		fs.Usage()
+		return fmt.Errorf("-in is required")
+	}
+
+	var wav []byte
+	var err error
+	if *in == "-" {
+		wav, err = io.ReadAll(os.Stdin)
+	} else {
+		wav, err = os.ReadFile(*in)
+	}
+	if err != nil {
+		return err
+	}
+
+	detection, err := service.Detect(context.Background(), wav, *user)
+	if errors.Is(err, domain.ErrWatermarkNotFound) {
+		return fmt.Errorf("no watermark found in %s", *in)
+	}
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(detection)
+}
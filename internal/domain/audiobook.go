@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+var (
+	// ErrAudiobookNotFound는 요청한 오디오북 작업이나 파일이 없을 때 반환됩니다.
+	ErrAudiobookNotFound = errors.New("audiobook not found")
+	// ErrAudiobookConflict는 작업 상태 때문에 요청을 처리할 수 없을 때 반환됩니다. (예: 완료된 작업 재개)
+	ErrAudiobookConflict = errors.New("audiobook job state conflict")
+)
+
+// 오디오북 작업 상태
+const (
+	AudiobookQueued    = "queued"
+	AudiobookRunning   = "running"
+	AudiobookCompleted = "completed"
+	AudiobookFailed    = "failed"
+)
+
+// AudiobookRequest는 문서를 오디오북으로 변환하는 요청입니다.
+type AudiobookRequest struct {
+	Title    string `json:"title"`
+	Document string `json:"document"`
+	Format   string `json:"format,omitempty"` // markdown, text (비우면 자동 판별)
+	VoiceID  string `json:"voice_id"`         // Voice ID 또는 음성 별칭
+	TTSDefaults
+}
+
+// AudiobookChapter는 챕터별 진행 상황과 결과 파일입니다.
+type AudiobookChapter struct {
+	Index           int     `json:"index"`
+	Title           string  `json:"title"`
+	File            string  `json:"file"`
+	Chunks          int     `json:"chunks"`
+	CompletedChunks int     `json:"completed_chunks"`
+	Duration        float64 `json:"duration,omitempty"` // 초, 챕터 오디오가 만들어진 뒤 채워짐
+}
+
+// AudiobookJob은 백그라운드에서 실행되는 오디오북 변환 작업입니다.
+// 청크 단위 결과가 저장되므로 실패하거나 서버가 재시작되어도 완료된 청크부터 이어서 진행합니다.
+type AudiobookJob struct {
+	ID       string             `json:"id"`
+	UserID   string             `json:"user_id,omitempty"`
+	Title    string             `json:"title"`
+	Format   string             `json:"format"`
+	VoiceID  string             `json:"voice_id"`
+	Settings TTSDefaults        `json:"settings"`
+	Status   string             `json:"status"`
+	Error    string             `json:"error,omitempty"`
+	Chapters []AudiobookChapter `json:"chapters"`
+
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Progress는 완료된 청크 비율(0~1)을 반환합니다.
+func (j *AudiobookJob) Progress() float64 {
+	total, done := 0, 0
+	for _, ch := range j.Chapters {
+		total += ch.Chunks
+		done += ch.CompletedChunks
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(done) / float64(total)
+}
+
+// AudiobookRepository는 작업 상태와 작업별 파일(원문, 청크 오디오, 챕터 오디오, 재생 목록)을 저장합니다.
+type AudiobookRepository interface {
+	Get(id string) (*AudiobookJob, error)
+	List(userID string) ([]AudiobookJob, error)
+	// ListAll은 모든 사용자의 작업을 반환합니다. 시작 시 중단된 작업을 재개할 때 사용합니다.
+	ListAll() ([]AudiobookJob, error)
+	Save(job AudiobookJob) error
+
+	WriteFile(id, name string, data []byte) error
+	ReadFile(id, name string) ([]byte, error)
+	FileExists(id, name string) bool
+	OpenFile(id, name string) (io.ReadCloser, int64, error)
+}
+
+// AudiobookService는 오디오북 변환 유즈케이스를 추상화합니다.
+type AudiobookService interface {
+	// Submit은 작업을 만들고 백그라운드에서 실행합니다.
+	Submit(ctx context.Context, req *AudiobookRequest) (*AudiobookJob, error)
+	Get(ctx context.Context, id string) (*AudiobookJob, error)
+	List(ctx context.Context) ([]AudiobookJob, error)
+	// Resume은 실패하거나 중단된 작업을 완료된 청크 다음부터 다시 실행합니다.
+	Resume(ctx context.Context, id string) (*AudiobookJob, error)
+	// OpenFile은 작업 결과 파일(챕터 WAV, playlist.m3u, chapters.json)을 엽니다.
+	OpenFile(ctx context.Context, id, name string) (io.ReadCloser, int64, error)
+}
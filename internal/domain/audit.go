@@ -0,0 +1,91 @@
+package domain
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+// 감사 기록의 합성 결과
+const (
+	AuditSuccess = "success"
+	AuditFailure = "failure"
+)
+
+// AuditRecord는 합성 요청 하나의 감사 기록입니다. 누가 어떤 음성을 만들었는지 확인하기 위해 남깁니다.
+// PrevHash는 직전 기록의 Hash이고 Hash는 Hash를 뺀 모든 필드로 계산하므로, 기록을 고치거나 지우면 이후 사슬이 끊어집니다.
+type AuditRecord struct {
+	Seq       int64     `json:"seq"`
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"request_id,omitempty"`
+	UserID    string    `json:"user_id"`
+	ClientIP  string    `json:"client_ip,omitempty"`
+	VoiceID   string    `json:"voice_id"`
+	Model     string    `json:"model,omitempty"`
+	Provider  string    `json:"provider,omitempty"`
+	TextHash  string    `json:"text_hash"`      // 원문 텍스트의 SHA-256 (hex)
+	Text      string    `json:"text,omitempty"` // AUDIT_LOG_TEXT가 켜져 있을 때만 기록
+	Chars     int       `json:"chars"`
+	Outcome   string    `json:"outcome"` // success, failure
+	Error     string    `json:"error,omitempty"`
+	AudioHash string    `json:"audio_hash,omitempty"` // 응답 오디오의 SHA-256 (hex)
+	PrevHash  string    `json:"prev_hash"`
+	Hash      string    `json:"hash"`
+}
+
+// ComputeHash는 Hash 필드를 비운 기록의 JSON 표현에 대한 SHA-256 (hex)을 반환합니다.
+func (r AuditRecord) ComputeHash() string {
+	r.Hash = ""
+	data, _ := json.Marshal(r)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// AuditQuery는 감사 기록 조회 조건입니다. 비어 있는 조건은 적용하지 않습니다.
+type AuditQuery struct {
+	UserID string
+	From   time.Time // 이 시각 이후 (포함)
+	To     time.Time // 이 시각 이전 (제외)
+	Limit  int       // 최대 개수, 넘으면 가장 최근 기록만 반환. 0이면 제한 없음
+}
+
+// Match는 기록이 조회 조건에 맞는지 반환합니다.
+func (q AuditQuery) Match(r *AuditRecord) bool {
+	if q.UserID != "" && r.UserID != q.UserID {
+		return false
+	}
+	if !q.From.IsZero() && r.Time.Before(q.From) {
+		return false
+	}
+	if !q.To.IsZero() && !r.Time.Before(q.To) {
+		return false
+	}
+	return true
+}
+
+// AuditVerification은 해시 사슬 검증 결과입니다.
+type AuditVerification struct {
+	Valid   bool   `json:"valid"`
+	Records int64  `json:"records"`        // 검증한 기록 수
+	File    string `json:"file,omitempty"` // 사슬이 끊어진 파일
+	Seq     int64  `json:"seq,omitempty"`  // 사슬이 끊어진 기록
+	Message string `json:"message,omitempty"`
+}
+
+// AuditRepository는 감사 기록을 추가만 가능한 형태로 저장합니다.
+type AuditRepository interface {
+	// Append는 Seq, PrevHash, Hash를 채워 기록을 덧붙입니다.
+	Append(record *AuditRecord) error
+	// Query는 조건에 맞는 기록을 오래된 순으로 반환합니다.
+	Query(q AuditQuery) ([]AuditRecord, error)
+	// Verify는 처음부터 끝까지 해시 사슬을 검증합니다.
+	Verify() (*AuditVerification, error)
+}
+
+// AuditService는 관리자용 감사 기록 조회 유즈케이스를 추상화합니다.
+type AuditService interface {
+	Query(ctx context.Context, q AuditQuery) ([]AuditRecord, error)
+	Verify(ctx context.Context) (*AuditVerification, error)
+}
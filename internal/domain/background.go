@@ -0,0 +1,104 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrBackgroundNotFound는 요청한 배경 트랙이 없을 때 반환됩니다.
+	ErrBackgroundNotFound = errors.New("background track not found")
+	// ErrInvalidBackground는 업로드한 배경 트랙의 이름이나 오디오가 올바르지 않을 때 반환됩니다.
+	ErrInvalidBackground = errors.New("invalid background track")
+)
+
+// 배경 음악 믹스 기본값
+const (
+	DefaultBackgroundGainDB    = -18.0 // 음성보다 충분히 작게 깔리는 음량
+	DefaultBackgroundDuckingDB = -12.0 // 음성이 나오는 동안 추가로 줄이는 음량
+)
+
+// BackgroundTrack은 관리자가 업로드해 서버에 저장된 배경 음악 트랙입니다.
+type BackgroundTrack struct {
+	Name       string    `json:"name"`
+	SampleRate int       `json:"sample_rate"`
+	Channels   int       `json:"channels"`
+	Duration   float64   `json:"duration"` // 초
+	Size       int       `json:"size"`     // WAV 파일 크기(바이트)
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// BackgroundRepository는 배경 트랙의 메타데이터와 WAV 파일을 저장합니다.
+type BackgroundRepository interface {
+	Get(name string) (*BackgroundTrack, error)
+	List() ([]BackgroundTrack, error)
+	// Audio는 트랙의 WAV 파일 내용을 반환합니다.
+	Audio(name string) ([]byte, error)
+	Save(track BackgroundTrack, wav []byte) error
+	Delete(name string) error
+}
+
+// BackgroundService는 배경 트랙 관리 유즈케이스를 추상화합니다.
+type BackgroundService interface {
+	// Upload는 WAV 오디오를 검증한 뒤 name으로 저장합니다. 같은 이름이 있으면 교체합니다.
+	Upload(ctx context.Context, name string, wav []byte) (*BackgroundTrack, error)
+	List(ctx context.Context) ([]BackgroundTrack, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// BackgroundOptions는 합성된 음성 아래에 깔 배경 트랙과 믹스 설정입니다. 생략한 값은 기본값을 사용합니다.
+type BackgroundOptions struct {
+	Track     string   `json:"track"`                // 등록된 배경 트랙 이름
+	GainDB    *float64 `json:"gain_db,omitempty"`    // 배경 음량 (-60 ~ 0)
+	DuckingDB *float64 `json:"ducking_db,omitempty"` // 음성 구간에서 추가로 줄일 음량 (-40 ~ 0, 0이면 덕킹 없음)
+	FadeInMS  int      `json:"fade_in_ms,omitempty"`
+	FadeOutMS int      `json:"fade_out_ms,omitempty"`
+	LeadInMS  int      `json:"lead_in_ms,omitempty"` // 음성 시작 전 배경만 나오는 시간
+	TailMS    int      `json:"tail_ms,omitempty"`    // 음성이 끝난 뒤 배경이 이어지는 시간
+	Loop      *bool    `json:"loop,omitempty"`       // 배경이 짧으면 반복 (기본 true)
+}
+
+// maxBackgroundMS는 페이드, 앞뒤 여유 시간의 상한입니다.
+const maxBackgroundMS = 60000
+
+// Validate는 범위를 벗어난 값이 있으면 ErrInvalidRequest를 반환합니다.
+func (o *BackgroundOptions) Validate() error {
+	if o.Track == "" {
+		return fmt.Errorf("%w: background.track is required", ErrInvalidRequest)
+	}
+	if v := o.GainDB; v != nil && (*v < -60 || *v > 0) {
+		return fmt.Errorf("%w: background.gain_db must be between -60 and 0", ErrInvalidRequest)
+	}
+	if v := o.DuckingDB; v != nil && (*v < -40 || *v > 0) {
+		return fmt.Errorf("%w: background.ducking_db must be between -40 and 0", ErrInvalidRequest)
+	}
+	for name, v := range map[string]int{"fade_in_ms": o.FadeInMS, "fade_out_ms": o.FadeOutMS, "lead_in_ms": o.LeadInMS, "tail_ms": o.TailMS} {
+		if v < 0 || v > maxBackgroundMS {
+			return fmt.Errorf("%w: background.%s must be between 0 and %d", ErrInvalidRequest, name, maxBackgroundMS)
+		}
+	}
+	return nil
+}
+
+// Gain은 배경 음량(dB)을 반환합니다.
+func (o *BackgroundOptions) Gain() float64 {
+	if o.GainDB == nil {
+		return DefaultBackgroundGainDB
+	}
+	return *o.GainDB
+}
+
+// Ducking은 음성 구간에서 줄일 음량(dB)을 반환합니다.
+func (o *BackgroundOptions) Ducking() float64 {
+	if o.DuckingDB == nil {
+		return DefaultBackgroundDuckingDB
+	}
+	return *o.DuckingDB
+}
+
+// LoopEnabled는 배경을 반복할지 반환합니다. 명시하지 않으면 반복합니다.
+func (o *BackgroundOptions) LoopEnabled() bool {
+	return o.Loop == nil || *o.Loop
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDHeader는 요청 ID를 주고받는 HTTP 헤더입니다. 클라이언트 요청, 응답, 업스트림 호출에 모두 사용합니다.
+const RequestIDHeader = "X-Request-ID"
+
+type contextKey int
+
+const (
+	userIDKey contextKey = iota
+	requestIDKey
+	clientIPKey
+)
+
+// WithUserID는 인증된 사용자 ID를 컨텍스트에 저장합니다.
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext는 컨텍스트에 저장된 사용자 ID를 반환합니다. 익명 요청이면 빈 문자열입니다.
+func UserIDFromContext(ctx context.Context) string {
+	userID, _ := ctx.Value(userIDKey).(string)
+	return userID
+}
+
+// NewRequestID는 16자리 16진수 요청 ID를 생성합니다.
+func NewRequestID() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// WithRequestID는 요청 ID를 컨텍스트에 저장합니다.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext는 컨텍스트에 저장된 요청 ID를 반환합니다. 없으면 빈 문자열입니다.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// WithClientIP는 요청한 클라이언트의 IP 주소를 컨텍스트에 저장합니다.
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey, ip)
+}
+
+// ClientIPFromContext는 컨텍스트에 저장된 클라이언트 IP를 반환합니다. 없으면 빈 문자열입니다.
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey).(string)
+	return ip
+}
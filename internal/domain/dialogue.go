@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// DialogueLine은 대화 스크립트의 한 줄입니다.
+type DialogueLine struct {
+	Speaker string `json:"speaker"`
+	// VoiceID는 Voice ID 또는 음성 별칭입니다. 비우면 같은 화자의 앞 줄 음성을 사용합니다.
+	VoiceID string `json:"voice_id,omitempty"`
+	Text    string `json:"text"`
+	Style   string `json:"style,omitempty"`
+	// PauseAfterMs는 다음 줄까지의 간격입니다. 음수이면 다음 줄이 이 줄 끝과 겹쳐 시작합니다.
+	PauseAfterMs int `json:"pause_after_ms,omitempty"`
+}
+
+// DialogueRequest는 여러 화자의 대화를 하나의 오디오로 렌더링하는 요청입니다.
+// Language, Model, VoiceSettings는 모든 줄에 공통으로 적용됩니다.
+type DialogueRequest struct {
+	Lines         []DialogueLine         `json:"lines"`
+	Language      string                 `json:"language,omitempty"`
+	Model         string                 `json:"model,omitempty"`
+	VoiceSettings map[string]interface{} `json:"voice_settings,omitempty"`
+	Delivery      string                 `json:"delivery,omitempty"` // multipart(기본), json
+}
+
+// DialogueTimelineEntry는 믹스된 오디오에서 한 줄이 재생되는 구간입니다.
+type DialogueTimelineEntry struct {
+	Index   int
+	Speaker string
+	VoiceID string
+	Text    string
+	Start   time.Duration
+	End     time.Duration
+}
+
+// MarshalJSON은 시간을 초 단위(밀리초 정밀도)로 출력합니다.
+func (e DialogueTimelineEntry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Index   int     `json:"index"`
+		Speaker string  `json:"speaker"`
+		VoiceID string  `json:"voice_id"`
+		Text    string  `json:"text"`
+		Start   float64 `json:"start"`
+		End     float64 `json:"end"`
+	}{e.Index, e.Speaker, e.VoiceID, e.Text, seconds(e.Start), seconds(e.End)})
+}
+
+// DialogueResult는 믹스된 WAV와 줄별 타임라인입니다.
+type DialogueResult struct {
+	Audio    []byte
+	Duration time.Duration
+	Timeline []DialogueTimelineEntry
+}
+
+// DialogueService는 대화 스크립트 렌더링 유즈케이스를 추상화합니다.
+type DialogueService interface {
+	Render(ctx context.Context, req *DialogueRequest) (*DialogueResult, error)
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// 헬스 체크 상태
+const (
+	HealthOK      = "ok"
+	HealthFail    = "fail"
+	HealthSkipped = "skipped" // 설정되지 않아 확인하지 않음
+)
+
+// CircuitState는 업스트림 회로 차단기 상태입니다.
+type CircuitState string
+
+const (
+	CircuitClosed   CircuitState = "closed"    // 정상, 요청을 보냄
+	CircuitOpen     CircuitState = "open"      // 연속 장애로 요청을 보내지 않음
+	CircuitHalfOpen CircuitState = "half_open" // 대기 시간이 지나 시험 요청 하나를 보내는 중
+)
+
+// HealthCheck는 개별 점검 항목의 결과입니다.
+type HealthCheck struct {
+	Name     string         `json:"name"`
+	Status   string         `json:"status"`
+	Message  string         `json:"message,omitempty"`
+	Duration int64          `json:"duration_ms"`
+	Details  map[string]any `json:"details,omitempty"`
+}
+
+// HealthReport는 점검 결과 묶음입니다. 하나라도 fail이면 Status가 fail입니다.
+type HealthReport struct {
+	Status    string        `json:"status"`
+	CheckedAt time.Time     `json:"checked_at"`
+	Checks    []HealthCheck `json:"checks"`
+}
+
+// OK는 모든 점검을 통과했는지 반환합니다.
+func (r *HealthReport) OK() bool {
+	return r.Status == HealthOK
+}
+
+// HealthService는 프로세스 생존, 트래픽 수신 준비, 실제 합성 가능 여부를 점검합니다.
+type HealthService interface {
+	// Live는 프로세스가 응답할 수 있는지 확인합니다. 외부 의존성은 확인하지 않습니다.
+	Live(ctx context.Context) *HealthReport
+	// Ready는 설정, 비밀 값, 업스트림 연결, 회로 차단기 상태를 확인합니다.
+	Ready(ctx context.Context) *HealthReport
+	// Deep은 카나리 합성을 수행합니다. 결과는 일정 시간 캐시합니다.
+	Deep(ctx context.Context) *HealthReport
+	// Drain은 서버가 종료 중임을 표시합니다. 이후 Ready와 Deep은 다른 점검 없이 실패합니다.
+	Drain()
+}
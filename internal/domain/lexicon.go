@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+)
+
+var (
+	// ErrLexiconNotFound는 요청한 발음 사전이 없을 때 반환됩니다.
+	ErrLexiconNotFound = errors.New("lexicon not found")
+	// ErrLexiconExists는 같은 이름의 발음 사전이 이미 있을 때 반환됩니다.
+	ErrLexiconExists = errors.New("lexicon already exists")
+	// ErrInvalidLexicon은 발음 사전 내용이 올바르지 않을 때 반환됩니다.
+	ErrInvalidLexicon = errors.New("invalid lexicon")
+)
+
+// 발음 사전 항목의 일치 방식입니다.
+const (
+	MatchWord      = "word"      // 영숫자 단어 경계에서만 치환 (기본값)
+	MatchSubstring = "substring" // 위치와 무관하게 치환
+	MatchRegex     = "regex"     // 정규식 치환 ($1 등 그룹 참조 가능)
+)
+
+// LexiconEntry는 표기(grapheme)를 읽을 철자(replacement)로 바꾸는 규칙입니다.
+type LexiconEntry struct {
+	Grapheme    string `json:"grapheme"`
+	Replacement string `json:"replacement"`
+	Language    string `json:"language,omitempty"` // 비어 있으면 사전의 언어를 따름
+	Match       string `json:"match,omitempty"`
+}
+
+// Lexicon은 이름이 붙은 발음 사전입니다. Language가 비어 있으면 모든 언어에 적용됩니다.
+type Lexicon struct {
+	Name      string         `json:"name"`
+	Language  string         `json:"language,omitempty"`
+	Entries   []LexiconEntry `json:"entries"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// MaxLexiconEntries는 사전 하나에 둘 수 있는 최대 항목 수입니다.
+const MaxLexiconEntries = 5000
+
+var lexiconNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// Validate는 사전 이름과 항목을 검증하고 비어 있는 일치 방식을 기본값으로 채웁니다.
+// 올바르지 않으면 ErrInvalidLexicon을 반환합니다.
+func (l *Lexicon) Validate() error {
+	if !lexiconNamePattern.MatchString(l.Name) {
+		return fmt.Errorf("%w: name must be 1-64 characters of letters, digits, '-' or '_'", ErrInvalidLexicon)
+	}
+	if len(l.Entries) > MaxLexiconEntries {
+		return fmt.Errorf("%w: at most %d entries are allowed", ErrInvalidLexicon, MaxLexiconEntries)
+	}
+
+	for i := range l.Entries {
+		e := &l.Entries[i]
+		if e.Grapheme == "" {
+			return fmt.Errorf("%w: entry %d: grapheme is required", ErrInvalidLexicon, i)
+		}
+		switch e.Match {
+		case "":
+			e.Match = MatchWord
+		case MatchWord, MatchSubstring:
+		case MatchRegex:
+			if _, err := regexp.Compile(e.Grapheme); err != nil {
+				return fmt.Errorf("%w: entry %d: %v", ErrInvalidLexicon, i, err)
+			}
+		default:
+			return fmt.Errorf("%w: entry %d: unknown match %q", ErrInvalidLexicon, i, e.Match)
+		}
+	}
+	return nil
+}
+
+// LexiconRepository는 사용자별 발음 사전 저장소를 추상화합니다.
+type LexiconRepository interface {
+	Get(userID, name string) (*Lexicon, error)
+	List(userID string) ([]Lexicon, error)
+	Save(userID string, lexicon Lexicon) error
+	Delete(userID, name string) error
+}
+
+// LexiconService는 발음 사전 관리 유즈케이스를 추상화합니다.
+// 쓰기는 요청 사용자의 사전에만 적용되고, 조회에는 전역 사전이 포함되지 않습니다.
+type LexiconService interface {
+	Create(ctx context.Context, lexicon Lexicon) (*Lexicon, error)
+	Get(ctx context.Context, name string) (*Lexicon, error)
+	List(ctx context.Context) ([]Lexicon, error)
+	Put(ctx context.Context, lexicon Lexicon) (*Lexicon, error) // 생성 또는 교체
+	Delete(ctx context.Context, name string) error
+}
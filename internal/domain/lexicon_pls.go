@@ -0,0 +1,41 @@
+package domain
+
+import "tts_proxy/pkg/pls"
+
+// LexiconFromPLS는 PLS 문서를 발음 사전으로 변환합니다.
+// <alias>가 없는 lexeme(음소만 있는 항목)은 적용할 수 없으므로 건너뛰고 그 개수를 반환합니다.
+func LexiconFromPLS(name string, doc *pls.Lexicon) (Lexicon, int) {
+	lexicon := Lexicon{Name: name, Language: doc.Language, Entries: []LexiconEntry{}}
+	skipped := 0
+	for _, l := range doc.Lexemes {
+		if len(l.Aliases) == 0 {
+			skipped++
+			continue
+		}
+		for _, g := range l.Graphemes {
+			lexicon.Entries = append(lexicon.Entries, LexiconEntry{
+				Grapheme:    g,
+				Replacement: l.Aliases[0],
+				Match:       l.Match,
+			})
+		}
+	}
+	return lexicon, skipped
+}
+
+// LexiconToPLS는 발음 사전을 PLS 문서로 변환합니다. 항목별 언어는 PLS로 표현할 수 없어 사전 언어만 기록됩니다.
+func LexiconToPLS(lexicon Lexicon) *pls.Lexicon {
+	doc := &pls.Lexicon{Language: lexicon.Language}
+	for _, e := range lexicon.Entries {
+		match := e.Match
+		if match == MatchWord {
+			match = ""
+		}
+		doc.Lexemes = append(doc.Lexemes, pls.Lexeme{
+			Graphemes: []string{e.Grapheme},
+			Aliases:   []string{e.Replacement},
+			Match:     match,
+		})
+	}
+	return doc
+}
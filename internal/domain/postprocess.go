@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// 후처리 기본값
+const (
+	DefaultSilenceThresholdDB = -50.0 // dBFS
+	DefaultTrimPadding        = 50 * time.Millisecond
+	DefaultTargetLUFS         = -16.0 // 음성 콘텐츠에 흔히 쓰는 목표 라우드니스
+	DefaultTruePeakDB         = -1.0  // dBTP
+)
+
+// PostProcessingOptions는 합성된 오디오에 적용할 후처리 옵션입니다. 생략한 값은 기본값을 사용합니다.
+type PostProcessingOptions struct {
+	TrimSilence        bool     `json:"trim_silence"`                   // 앞뒤 무음 제거
+	SilenceThresholdDB *float64 `json:"silence_threshold_db,omitempty"` // 이보다 작은 에너지는 무음 (-90 ~ -20)
+	TrimPaddingMS      *int     `json:"trim_padding_ms,omitempty"`      // 소리 앞뒤로 남길 여유 (0 ~ 1000)
+
+	NormalizeLoudness bool     `json:"normalize_loudness"`     // 통합 라우드니스를 TargetLUFS로 맞춤
+	TargetLUFS        *float64 `json:"target_lufs,omitempty"`  // -40 ~ -5
+	TruePeakDB        *float64 `json:"true_peak_db,omitempty"` // 트루 피크 상한 (-9 ~ 0), 지정하면 정규화 없이도 제한
+
+	Watermark bool `json:"watermark"` // 요청 ID와 사용자 ID를 담은 워터마크 삽입
+}
+
+// Validate는 범위를 벗어난 값이 있으면 ErrInvalidRequest를 반환합니다.
+func (o *PostProcessingOptions) Validate() error {
+	if v := o.SilenceThresholdDB; v != nil && (*v < -90 || *v > -20) {
+		return fmt.Errorf("%w: silence_threshold_db must be between -90 and -20", ErrInvalidRequest)
+	}
+	if v := o.TrimPaddingMS; v != nil && (*v < 0 || *v > 1000) {
+		return fmt.Errorf("%w: trim_padding_ms must be between 0 and 1000", ErrInvalidRequest)
+	}
+	if v := o.TargetLUFS; v != nil && (*v < -40 || *v > -5) {
+		return fmt.Errorf("%w: target_lufs must be between -40 and -5", ErrInvalidRequest)
+	}
+	if v := o.TruePeakDB; v != nil && (*v < -9 || *v > 0) {
+		return fmt.Errorf("%w: true_peak_db must be between -9 and 0", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// SilenceThreshold는 무음 판정 기준(dBFS)을 반환합니다.
+func (o *PostProcessingOptions) SilenceThreshold() float64 {
+	if o.SilenceThresholdDB == nil {
+		return DefaultSilenceThresholdDB
+	}
+	return *o.SilenceThresholdDB
+}
+
+// TrimPadding은 무음 제거 후 남길 여유 시간을 반환합니다.
+func (o *PostProcessingOptions) TrimPadding() time.Duration {
+	if o.TrimPaddingMS == nil {
+		return DefaultTrimPadding
+	}
+	return time.Duration(*o.TrimPaddingMS) * time.Millisecond
+}
+
+// Target은 목표 통합 라우드니스(LUFS)를 반환합니다.
+func (o *PostProcessingOptions) Target() float64 {
+	if o.TargetLUFS == nil {
+		return DefaultTargetLUFS
+	}
+	return *o.TargetLUFS
+}
+
+// LimitTruePeak는 트루 피크 제한을 적용할지와 상한(dBTP)을 반환합니다.
+func (o *PostProcessingOptions) LimitTruePeak() (float64, bool) {
+	if o.TruePeakDB != nil {
+		return *o.TruePeakDB, true
+	}
+	return DefaultTruePeakDB, o.NormalizeLoudness
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrPresetNotFound는 요청한 프리셋이 없을 때 반환됩니다.
+	ErrPresetNotFound = errors.New("preset not found")
+	// ErrPresetExists는 같은 이름의 프리셋이 이미 있을 때 반환됩니다.
+	ErrPresetExists = errors.New("preset already exists")
+	// ErrInvalidPreset은 프리셋 내용이 올바르지 않을 때 반환됩니다.
+	ErrInvalidPreset = errors.New("invalid preset")
+)
+
+// Preset은 사용자별로 저장되는 음성, 모델, 스타일, 설정의 이름 붙은 조합입니다.
+type Preset struct {
+	Name    string `json:"name"`
+	VoiceID string `json:"voice_id,omitempty"`
+	TTSDefaults
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PresetRepository는 사용자별 프리셋 저장소를 추상화합니다.
+type PresetRepository interface {
+	Get(userID, name string) (*Preset, error)
+	List(userID string) ([]Preset, error)
+	Save(userID string, preset Preset) error
+	Delete(userID, name string) error
+}
+
+// PresetService는 프리셋 관리 유즈케이스를 추상화합니다.
+type PresetService interface {
+	Create(ctx context.Context, preset Preset) (*Preset, error)
+	Get(ctx context.Context, name string) (*Preset, error)
+	List(ctx context.Context) ([]Preset, error)
+	Update(ctx context.Context, name string, preset Preset) (*Preset, error)
+	Delete(ctx context.Context, name string) error
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// 자막 출력 형식
+const (
+	SubtitleSRT  = "srt"
+	SubtitleVTT  = "vtt"
+	SubtitleJSON = "json"
+)
+
+// 자막 응답 방식
+const (
+	DeliveryMultipart = "multipart" // 오디오와 자막을 multipart/mixed 파트로 전달 (기본)
+	DeliveryJSON      = "json"      // 오디오를 base64로 인코딩해 자막과 함께 JSON으로 전달
+)
+
+// SubtitleOptions는 TTS 응답에 타이밍 추정값과 자막을 함께 받기 위한 옵션입니다.
+type SubtitleOptions struct {
+	Format   string `json:"format"`             // srt, vtt, json
+	Delivery string `json:"delivery,omitempty"` // multipart(기본), json
+}
+
+// Validate는 지원하지 않는 형식이나 응답 방식이면 ErrInvalidRequest를 반환합니다.
+func (o *SubtitleOptions) Validate() error {
+	switch o.Format {
+	case SubtitleSRT, SubtitleVTT, SubtitleJSON:
+	default:
+		return fmt.Errorf("%w: unsupported subtitle format %q", ErrInvalidRequest, o.Format)
+	}
+	switch o.Delivery {
+	case "", DeliveryMultipart, DeliveryJSON:
+	default:
+		return fmt.Errorf("%w: unsupported subtitle delivery %q", ErrInvalidRequest, o.Delivery)
+	}
+	return nil
+}
+
+// WordTiming은 합성된 오디오에서 단어가 발화되는 추정 구간입니다.
+type WordTiming struct {
+	Text  string
+	Start time.Duration
+	End   time.Duration
+}
+
+// SentenceTiming은 문장의 추정 구간과 단어별 구간입니다.
+type SentenceTiming struct {
+	Text  string
+	Start time.Duration
+	End   time.Duration
+	Words []WordTiming
+}
+
+// MarshalJSON은 시간을 초 단위(밀리초 정밀도)로 출력합니다.
+func (w WordTiming) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Text  string  `json:"text"`
+		Start float64 `json:"start"`
+		End   float64 `json:"end"`
+	}{w.Text, seconds(w.Start), seconds(w.End)})
+}
+
+// MarshalJSON은 시간을 초 단위(밀리초 정밀도)로 출력합니다.
+func (s SentenceTiming) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Text  string       `json:"text"`
+		Start float64      `json:"start"`
+		End   float64      `json:"end"`
+		Words []WordTiming `json:"words"`
+	}{s.Text, seconds(s.Start), seconds(s.End), s.Words})
+}
+
+func seconds(d time.Duration) float64 {
+	return math.Round(d.Seconds()*1000) / 1000
+}
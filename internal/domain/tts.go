@@ -1,26 +1,171 @@
 package domain
 
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidRequest는 요청 내용(SSML 등)이 올바르지 않을 때 반환됩니다.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// ErrUpstreamUnavailable은 업스트림 장애로 회로 차단기가 열려 요청을 보내지 않았을 때 반환됩니다.
+var ErrUpstreamUnavailable = errors.New("upstream TTS provider is unavailable")
+
+// UpstreamError는 업스트림 TTS API가 200이 아닌 상태 코드로 응답했을 때 반환됩니다.
+type UpstreamError struct {
+	StatusCode int
+	Status     string // 예: "503 Service Unavailable"
+}
+
+func (e *UpstreamError) Error() string {
+	return "TTS API error: " + e.Status
+}
+
+// ClientError는 요청 내용 때문에 거절된 오류(4xx, 429 제외)인지 반환합니다. 업스트림 장애로 보지 않습니다.
+func (e *UpstreamError) ClientError() bool {
+	return e.StatusCode >= 400 && e.StatusCode < 500 && e.StatusCode != 429
+}
+
 // TTSRequest는 클라이언트가 전달하는 TTS 요청 데이터입니다.
 type TTSRequest struct {
 	Text          string                 `json:"text"`
 	Language      string                 `json:"language"`
-	Style         string                 `json:"style"`         // Supertone API용 스타일 (예: "neutral")
-	Model         string                 `json:"model"`         // Supertone API용 모델 (예: "sona_speech_1")
-	VoiceSettings map[string]interface{} `json:"voice_settings"` // pitch_shift, pitch_variance, speed 등
+	Style         string                 `json:"style"`               // Supertone API용 스타일 (예: "neutral")
+	Model         string                 `json:"model"`               // Supertone API용 모델 (예: "sona_speech_1")
+	VoiceSettings map[string]interface{} `json:"voice_settings"`      // pitch_shift, pitch_variance, speed 등
+	Preset        string                 `json:"preset,omitempty"`    // 사용자 프리셋 이름 (명시한 필드가 우선)
+	Normalize     *bool                  `json:"normalize,omitempty"` // false이면 숫자/날짜/단위 정규화를 건너뜀 (기본 true)
+	// SplitLanguages가 true이면 여러 언어가 섞인 텍스트를 언어별 문장 묶음으로 나눠 합성한 뒤 이어 붙입니다.
+	SplitLanguages bool `json:"split_languages,omitempty"`
+	// Subtitles를 지정하면 문장 단위로 나눠 합성하고 문장/단어 타이밍 추정값을 함께 반환합니다.
+	Subtitles *SubtitleOptions `json:"subtitles,omitempty"`
+	// PostProcessing을 지정하면 합성된 오디오의 앞뒤 무음 제거, 라우드니스 정규화, 트루 피크 제한을 적용합니다.
+	PostProcessing *PostProcessingOptions `json:"post_processing,omitempty"`
+	// Background를 지정하면 등록된 배경 트랙을 음성 아래에 깔아 하나의 오디오로 합칩니다.
+	Background *BackgroundOptions `json:"background,omitempty"`
+	// SampleRate와 Channels를 지정하면 합성된 오디오를 해당 표본화율(Hz)과 채널 수로 변환합니다.
+	SampleRate int `json:"sample_rate,omitempty"`
+	Channels   int `json:"channels,omitempty"`
+	// OutputFormat은 응답 오디오 형식입니다. 비우면 wav이며, mp3는 업스트림에서 그대로 받습니다.
+	OutputFormat string `json:"output_format,omitempty"`
+}
+
+// 출력 오디오 형식
+const (
+	FormatWAV   = "wav"
+	FormatMP3   = "mp3"   // 업스트림 인코딩, 오디오 변환/후처리와 함께 쓸 수 없음
+	FormatFLAC  = "flac"  // 16비트 무손실
+	FormatPCM   = "pcm"   // 헤더 없는 16비트 리틀 엔디언(s16le)
+	FormatMuLaw = "mulaw" // G.711 μ-law, 기본 8kHz 모노
+	FormatALaw  = "alaw"  // G.711 A-law, 기본 8kHz 모노
+)
+
+// G.711 형식에서 sample_rate, channels를 생략했을 때 사용하는 전화망 규격
+const (
+	TelephonySampleRate = 8000
+	TelephonyChannels   = 1
+)
+
+// 출력 변환 범위
+const (
+	MinSampleRate = 8000
+	MaxSampleRate = 192000
+	MaxChannels   = 2
+)
+
+// ValidateOutput은 sample_rate, channels, background, post_processing 값이 범위를 벗어나면 ErrInvalidRequest를 반환합니다.
+func (r *TTSRequest) ValidateOutput() error {
+	if r.SampleRate != 0 && (r.SampleRate < MinSampleRate || r.SampleRate > MaxSampleRate) {
+		return fmt.Errorf("%w: sample_rate must be between %d and %d", ErrInvalidRequest, MinSampleRate, MaxSampleRate)
+	}
+	if r.Channels < 0 || r.Channels > MaxChannels {
+		return fmt.Errorf("%w: channels must be 1 or 2", ErrInvalidRequest)
+	}
+	switch r.OutputFormat {
+	case "", FormatWAV, FormatFLAC, FormatPCM, FormatMuLaw, FormatALaw:
+	case FormatMP3:
+		if r.SampleRate != 0 || r.Channels != 0 || r.PostProcessing != nil || r.Background != nil || r.Subtitles != nil || r.SplitLanguages {
+			return fmt.Errorf("%w: mp3 output cannot be combined with audio conversion, post-processing, background mixing, subtitles or language splitting", ErrInvalidRequest)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported output_format %q", ErrInvalidRequest, r.OutputFormat)
+	}
+	if r.Background != nil {
+		if err := r.Background.Validate(); err != nil {
+			return err
+		}
+	}
+	if r.PostProcessing != nil {
+		return r.PostProcessing.Validate()
+	}
+	return nil
+}
+
+// NeedsAudioProcessing은 합성 결과 WAV를 변환, 후처리 또는 다른 형식으로 인코딩해야 하는지 반환합니다.
+func (r *TTSRequest) NeedsAudioProcessing() bool {
+	switch r.OutputFormat {
+	case "", FormatWAV, FormatMP3:
+		return r.SampleRate != 0 || r.Channels != 0 || r.PostProcessing != nil || r.Background != nil
+	}
+	return true
+}
+
+// NormalizeEnabled는 텍스트 정규화를 적용할지 반환합니다. 명시하지 않으면 적용합니다.
+func (r *TTSRequest) NormalizeEnabled() bool {
+	return r.Normalize == nil || *r.Normalize
+}
+
+// TTSDefaults는 요청에 명시되지 않은 필드를 채울 기본 합성 설정입니다.
+type TTSDefaults struct {
+	Language      string                 `json:"language,omitempty"`
+	Style         string                 `json:"style,omitempty"`
+	Model         string                 `json:"model,omitempty"`
+	VoiceSettings map[string]interface{} `json:"voice_settings,omitempty"`
+}
+
+// ApplyDefaults는 비어 있는 필드를 기본값으로 채웁니다. 클라이언트가 지정한 값이 항상 우선합니다.
+func (r *TTSRequest) ApplyDefaults(d TTSDefaults) {
+	if r.Language == "" {
+		r.Language = d.Language
+	}
+	if r.Style == "" {
+		r.Style = d.Style
+	}
+	if r.Model == "" {
+		r.Model = d.Model
+	}
+	if len(d.VoiceSettings) == 0 {
+		return
+	}
+	merged := make(map[string]interface{}, len(d.VoiceSettings)+len(r.VoiceSettings))
+	for k, v := range d.VoiceSettings {
+		merged[k] = v
+	}
+	for k, v := range r.VoiceSettings {
+		merged[k] = v
+	}
+	r.VoiceSettings = merged
 }
 
 // TTSResponse는 TTS 변환 결과(오디오 바이너리 등)를 나타냅니다.
 type TTSResponse struct {
-	Audio []byte
-	Format string // 예: "wav"
+	Audio   []byte
+	Format  string           // 예: "wav"
+	Timings []SentenceTiming // 요청에 Subtitles가 있을 때만 채워짐
+	// SampleRate와 Channels는 헤더가 없는 형식(pcm, mulaw, alaw)에서 채워집니다.
+	SampleRate int
+	Channels   int
+	// RequestID는 워터마크에 담은 요청 ID입니다. 워터마크를 넣었을 때만 채워집니다.
+	RequestID string
 }
 
 // TTSService는 TTS 변환 유즈케이스를 추상화합니다.
 type TTSService interface {
-	Synthesize(req *TTSRequest, voiceID string) (*TTSResponse, error)
+	Synthesize(ctx context.Context, req *TTSRequest, voiceID string) (*TTSResponse, error)
 }
 
 // AuthService는 인증/계정 식별을 추상화합니다.
 type AuthService interface {
 	ValidateToken(token string) (userID string, err error)
-} 
\ No newline at end of file
+}
@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// 사용량 보고서의 집계 기준
+const (
+	UsageByUser  = "user"
+	UsageByVoice = "voice"
+	UsageByModel = "model"
+)
+
+// DefaultPriceModel은 가격표에 없는 모델(모델을 지정하지 않은 요청 포함)에 적용할 가격 항목 이름입니다.
+const DefaultPriceModel = "default"
+
+// UsageRecord는 완료된 합성 한 건의 사용량입니다.
+type UsageRecord struct {
+	Time         time.Time
+	UserID       string
+	VoiceID      string
+	Model        string
+	Characters   int
+	AudioSeconds float64
+}
+
+// UsageBucket은 한 시간 동안 같은 사용자, 음성, 모델로 합성한 사용량의 합계입니다.
+type UsageBucket struct {
+	Hour         time.Time `json:"hour"`
+	UserID       string    `json:"user_id"`
+	VoiceID      string    `json:"voice_id"`
+	Model        string    `json:"model"`
+	Requests     int64     `json:"requests"`
+	Characters   int64     `json:"characters"`
+	AudioSeconds float64   `json:"audio_seconds"`
+}
+
+// ModelPrice는 모델 하나의 가격입니다. 글자 수와 오디오 길이 요금을 더해 비용을 추정합니다.
+type ModelPrice struct {
+	PerThousandChars float64 `json:"per_1k_chars"`
+	PerAudioMinute   float64 `json:"per_audio_minute"`
+}
+
+// UsagePricing은 모델별 가격표입니다.
+type UsagePricing struct {
+	Currency string                `json:"currency"`
+	Models   map[string]ModelPrice `json:"models"`
+}
+
+// Cost는 model로 characters자, audioSeconds초를 합성한 추정 비용을 반환합니다.
+// 가격표에 없는 모델은 DefaultPriceModel 항목을 사용하고, 그것도 없으면 0입니다.
+func (p UsagePricing) Cost(model string, characters int64, audioSeconds float64) float64 {
+	price, ok := p.Models[model]
+	if !ok {
+		price = p.Models[DefaultPriceModel]
+	}
+	return float64(characters)/1000*price.PerThousandChars + audioSeconds/60*price.PerAudioMinute
+}
+
+// UsageQuery는 사용량 보고서 조건입니다. 시간당 집계이므로 From과 To는 정시 단위로 비교합니다.
+type UsageQuery struct {
+	From    time.Time // 포함
+	To      time.Time // 제외
+	GroupBy string    // user, voice, model
+}
+
+// UsageRow는 보고서의 한 행입니다. Key는 GroupBy 기준의 사용자 ID, Voice ID 또는 모델입니다.
+type UsageRow struct {
+	Key           string  `json:"key"`
+	Requests      int64   `json:"requests"`
+	Characters    int64   `json:"characters"`
+	AudioSeconds  float64 `json:"audio_seconds"`
+	EstimatedCost float64 `json:"estimated_cost"`
+}
+
+// UsageReport는 기간별 사용량 보고서입니다.
+type UsageReport struct {
+	From     time.Time  `json:"from"`
+	To       time.Time  `json:"to"`
+	GroupBy  string     `json:"group_by"`
+	Currency string     `json:"currency,omitempty"`
+	Rows     []UsageRow `json:"rows"`
+	Total    UsageRow   `json:"total"`
+}
+
+// UsageRepository는 사용량을 시간당 버킷으로 집계해 저장합니다.
+type UsageRepository interface {
+	Add(record UsageRecord) error
+	// Query는 Hour가 [from, to) 범위인 버킷을 반환합니다.
+	Query(from, to time.Time) ([]UsageBucket, error)
+}
+
+// UsageService는 관리자용 사용량 보고 유즈케이스를 추상화합니다.
+type UsageService interface {
+	Report(ctx context.Context, q UsageQuery) (*UsageReport, error)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrVoiceNotFound는 요청한 Voice ID가 카탈로그에 없을 때 반환됩니다.
+var ErrVoiceNotFound = errors.New("voice not found")
+
+// Voice는 제공자와 무관하게 정규화된 음성 정보입니다.
+type Voice struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Provider    string   `json:"provider"`
+	Description string   `json:"description,omitempty"`
+	Gender      string   `json:"gender,omitempty"`
+	Age         string   `json:"age,omitempty"`
+	UseCase     string   `json:"use_case,omitempty"`
+	Languages   []string `json:"languages"`
+	Styles      []string `json:"styles"`
+	Models      []string `json:"models"`
+}
+
+// VoiceFilter는 음성 목록 조회 시 적용할 필터입니다. 빈 값은 무시됩니다.
+type VoiceFilter struct {
+	Language string
+	Gender   string
+	Age      string
+	Style    string
+	Model    string
+}
+
+// VoiceService는 음성 카탈로그 조회 유즈케이스를 추상화합니다.
+type VoiceService interface {
+	ListVoices(ctx context.Context, filter VoiceFilter) ([]Voice, error)
+	GetVoice(ctx context.Context, id string) (*Voice, error)
+}
+
+// VoiceAlias는 제품에서 사용하는 음성 별칭과 실제 제공자 Voice ID, 기본 설정의 매핑입니다.
+type VoiceAlias struct {
+	Name     string
+	VoiceID  string
+	Defaults TTSDefaults
+}
+
+// VoiceAliasRegistry는 별칭으로 음성을 찾는 저장소를 추상화합니다.
+type VoiceAliasRegistry interface {
+	Resolve(name string) (*VoiceAlias, bool)
+}
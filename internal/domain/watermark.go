@@ -0,0 +1,31 @@
+package domain
+
+import (
+	"context"
+	"errors"
+)
+
+// WatermarkedHeader는 워터마크를 넣은 응답에만 "true"로 붙는 HTTP 헤더입니다.
+// WATERMARK_ALL이어도 mp3 출력이나 너무 짧은 오디오에는 워터마크가 없으므로 이 헤더로 구분합니다.
+const WatermarkedHeader = "X-Watermarked"
+
+// ErrWatermarkNotFound는 업로드한 오디오에서 워터마크를 찾지 못했을 때 반환됩니다.
+var ErrWatermarkNotFound = errors.New("watermark not found")
+
+// WatermarkDetection은 오디오에서 읽어낸 워터마크 페이로드입니다.
+type WatermarkDetection struct {
+	// RequestID는 합성 요청 ID입니다. 16자리 16진수가 아닌 요청 ID는 SHA-256 앞 8바이트로 기록됩니다.
+	RequestID string `json:"request_id"`
+	// UserFingerprint는 워터마크 키로 계산한 사용자 ID의 32비트 지문(16진수)입니다.
+	UserFingerprint string `json:"user_fingerprint"`
+	// UserMatch는 확인할 사용자 ID를 함께 보냈을 때 지문이 일치하는지입니다.
+	UserMatch  *bool   `json:"user_match,omitempty"`
+	Confidence float64 `json:"confidence"` // 개별 비트 읽기가 페이로드와 일치하는 비율 (0.5 ~ 1)
+	Copies     float64 `json:"copies"`     // 오디오에 담긴 페이로드 사본 수
+}
+
+// WatermarkService는 워터마크 검출 유즈케이스를 추상화합니다.
+type WatermarkService interface {
+	// Detect는 WAV 오디오에서 워터마크를 찾습니다. userID를 주면 사용자 지문과 일치하는지도 확인합니다.
+	Detect(ctx context.Context, wav []byte, userID string) (*WatermarkDetection, error)
+}
@@ -0,0 +1,169 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"tts_proxy/internal/domain"
+)
+
+const audiobookJobFile = "job.json"
+
+// AudiobookStore는 작업마다 root/{id} 디렉토리를 만들어 job.json과 결과 파일을 저장합니다.
+type AudiobookStore struct {
+	root string
+	mu   sync.RWMutex
+}
+
+// NewAudiobookStore는 root 디렉토리를 사용하는 오디오북 저장소를 생성합니다.
+func NewAudiobookStore(root string) (*AudiobookStore, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create audiobook directory %s: %w", root, err)
+	}
+	return &AudiobookStore{root: root}, nil
+}
+
+func (s *AudiobookStore) Get(id string) (*domain.AudiobookJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.read(id)
+}
+
+func (s *AudiobookStore) List(userID string) ([]domain.AudiobookJob, error) {
+	jobs, err := s.ListAll()
+	if err != nil {
+		return nil, err
+	}
+	owned := jobs[:0]
+	for _, job := range jobs {
+		if job.UserID == userID {
+			owned = append(owned, job)
+		}
+	}
+	return owned, nil
+}
+
+// ListAll은 모든 작업을 생성 시각 순으로 반환합니다.
+func (s *AudiobookStore) ListAll() ([]domain.AudiobookJob, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries, err := os.ReadDir(s.root)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]domain.AudiobookJob, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		job, err := s.read(e.Name())
+		if errors.Is(err, domain.ErrAudiobookNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, *job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].CreatedAt.Before(jobs[j].CreatedAt) })
+	return jobs, nil
+}
+
+func (s *AudiobookStore) Save(job domain.AudiobookJob) error {
+	data, err := json.MarshalIndent(job, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.WriteFile(job.ID, audiobookJobFile, data)
+}
+
+// WriteFile은 임시 파일에 쓴 뒤 이름을 바꿔 파일을 원자적으로 교체합니다.
+func (s *AudiobookStore) WriteFile(id, name string, data []byte) error {
+	path, err := s.path(id, name)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create job directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return os.Rename(tmp, path)
+}
+
+func (s *AudiobookStore) ReadFile(id, name string) ([]byte, error) {
+	path, err := s.path(id, name)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s/%s", domain.ErrAudiobookNotFound, id, name)
+	}
+	return data, err
+}
+
+func (s *AudiobookStore) FileExists(id, name string) bool {
+	path, err := s.path(id, name)
+	if err != nil {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular()
+}
+
+func (s *AudiobookStore) OpenFile(id, name string) (io.ReadCloser, int64, error) {
+	path, err := s.path(id, name)
+	if err != nil {
+		return nil, 0, err
+	}
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, fmt.Errorf("%w: %s/%s", domain.ErrAudiobookNotFound, id, name)
+	}
+	if err != nil {
+		return nil, 0, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, err
+	}
+	return f, info.Size(), nil
+}
+
+// read는 job.json을 읽습니다. 호출자가 잠금을 보유해야 합니다.
+func (s *AudiobookStore) read(id string) (*domain.AudiobookJob, error) {
+	data, err := s.ReadFile(id, audiobookJobFile)
+	if err != nil {
+		return nil, err
+	}
+	var job domain.AudiobookJob
+	if err := json.Unmarshal(data, &job); err != nil {
+		return nil, fmt.Errorf("failed to parse job %s: %w", id, err)
+	}
+	return &job, nil
+}
+
+// path는 작업 디렉토리 밖을 가리키는 이름을 거부합니다.
+func (s *AudiobookStore) path(id, name string) (string, error) {
+	for _, part := range []string{id, name} {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, `/\`) {
+			return "", fmt.Errorf("%w: invalid path %q", domain.ErrAudiobookNotFound, part)
+		}
+	}
+	return filepath.Join(s.root, id, name), nil
+}
@@ -0,0 +1,65 @@
+package infrastructure
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+func TestAudiobookStore_Jobs(t *testing.T) {
+	store, err := NewAudiobookStore(t.TempDir())
+	assert.NoError(t, err)
+
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.Save(domain.AudiobookJob{ID: "b", UserID: "alice", Status: domain.AudiobookQueued, CreatedAt: now.Add(time.Minute)}))
+	assert.NoError(t, store.Save(domain.AudiobookJob{ID: "a", UserID: "alice", Status: domain.AudiobookCompleted, CreatedAt: now}))
+	assert.NoError(t, store.Save(domain.AudiobookJob{ID: "c", UserID: "bob", CreatedAt: now}))
+
+	job, err := store.Get("b")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AudiobookQueued, job.Status)
+
+	_, err = store.Get("missing")
+	assert.ErrorIs(t, err, domain.ErrAudiobookNotFound)
+
+	jobs, err := store.List("alice")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, []string{jobs[0].ID, jobs[1].ID})
+
+	all, err := store.ListAll()
+	assert.NoError(t, err)
+	assert.Len(t, all, 3)
+}
+
+func TestAudiobookStore_Files(t *testing.T) {
+	store, err := NewAudiobookStore(t.TempDir())
+	assert.NoError(t, err)
+
+	assert.False(t, store.FileExists("job", "chapter_01.wav"))
+	assert.NoError(t, store.WriteFile("job", "chapter_01.wav", []byte("RIFF")))
+	assert.True(t, store.FileExists("job", "chapter_01.wav"))
+
+	data, err := store.ReadFile("job", "chapter_01.wav")
+	assert.NoError(t, err)
+	assert.Equal(t, "RIFF", string(data))
+
+	r, size, err := store.OpenFile("job", "chapter_01.wav")
+	assert.NoError(t, err)
+	defer r.Close()
+	assert.Equal(t, int64(4), size)
+	data, _ = io.ReadAll(r)
+	assert.Equal(t, "RIFF", string(data))
+
+	_, err = store.ReadFile("job", "missing.wav")
+	assert.ErrorIs(t, err, domain.ErrAudiobookNotFound)
+
+	// 작업 디렉토리 밖은 접근할 수 없음
+	for _, name := range []string{"../job.json", "..", "a/b", ""} {
+		_, _, err = store.OpenFile("job", name)
+		assert.ErrorIs(t, err, domain.ErrAudiobookNotFound, name)
+		assert.Error(t, store.WriteFile("job", name, nil), name)
+	}
+}
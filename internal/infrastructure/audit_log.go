@@ -0,0 +1,451 @@
+package infrastructure
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"tts_proxy/internal/domain"
+)
+
+const (
+	auditFilePrefix      = "audit-"
+	auditFileSuffix      = ".jsonl"
+	auditHeadFile        = "audit-head.json"
+	defaultAuditMaxBytes = 64 << 20
+)
+
+// AuditLog는 감사 기록을 dir 아래 JSONL 파일에 덧붙입니다. 파일이 maxBytes를 넘으면 다음 기록부터 새 파일에 쓰고,
+// 다 쓴 파일은 읽기 전용으로 바꿉니다. 파일 이름은 첫 기록의 Seq라서 이름 순서가 곧 기록 순서입니다.
+//
+// 동기화할 때마다 마지막 기록의 Seq와 해시를 로그 밖의 head 파일(audit-head.json)에 남겨서, 끝부분 기록이나
+// 파일을 지워 로그를 줄여도 Verify가 알아챌 수 있게 합니다.
+//
+// 동시에 들어온 기록은 한 번의 fsync로 함께 동기화합니다(group commit). 쓰기는 잠금 안에서 순서대로 하고,
+// 동기화는 잠금 없이 한 호출자가 대표로 수행하는 동안 다른 호출자는 다음 동기화에 포함될 기록을 계속 씁니다.
+type AuditLog struct {
+	dir      string
+	maxBytes int64
+
+	mu       sync.Mutex
+	synced   *sync.Cond // syncing이 끝날 때마다 깨움
+	file     *os.File
+	name     string
+	size     int64
+	seq      int64
+	lastHash string
+
+	syncing   bool  // 잠금 밖에서 file을 동기화하는 중. 이때는 file을 닫지 않음
+	syncedSeq int64 // 디스크에 동기화된 마지막 Seq
+	broken    error // 쓰다 만 기록을 잘라내지 못한 오류. 이후 기록을 받지 않음
+}
+
+// auditHead는 디스크에 동기화된 마지막 기록입니다.
+type auditHead struct {
+	Seq  int64  `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// auditSnapshot은 읽기 시점까지 기록된 파일과 마지막 파일의 크기입니다. 쓰는 중인 줄을 읽지 않도록 이 크기까지만 읽습니다.
+type auditSnapshot struct {
+	files    []string
+	lastSize int64
+}
+
+// NewAuditLog는 dir의 감사 로그를 엽니다. 기존 파일이 있으면 마지막 기록에서 사슬을 이어갑니다.
+// 로그가 head 파일에 남은 기록보다 짧으면 잘린 로그에 이어 쓰지 않도록 오류를 반환합니다. maxBytes가 0 이하이면 64MiB마다 새 파일을 만듭니다.
+func NewAuditLog(dir string, maxBytes int64) (*AuditLog, error) {
+	if maxBytes <= 0 {
+		maxBytes = defaultAuditMaxBytes
+	}
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory %s: %w", dir, err)
+	}
+	l := &AuditLog{dir: dir, maxBytes: maxBytes}
+	l.synced = sync.NewCond(&l.mu)
+	files, err := l.list()
+	if err != nil {
+		return nil, err
+	}
+	if len(files) > 0 {
+		if err := l.resume(files[len(files)-1]); err != nil {
+			return nil, err
+		}
+	}
+
+	head, err := l.readHead()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case head == nil && l.seq > 0:
+		// head 파일이 생기기 전의 로그
+		slog.Warn("audit head file missing, recreating from the last record", "seq", l.seq)
+		if err := l.writeHead(l.seq, l.lastHash); err != nil {
+			return nil, err
+		}
+	case head != nil && head.Seq > l.seq:
+		return nil, fmt.Errorf("audit log ends at seq %d but %s records seq %d", l.seq, auditHeadFile, head.Seq)
+	}
+	return l, nil
+}
+
+// readHead는 head 파일을 읽습니다. 파일이 없으면 nil을 반환합니다.
+func (l *AuditLog) readHead() (*auditHead, error) {
+	data, err := os.ReadFile(filepath.Join(l.dir, auditHeadFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var head auditHead
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", auditHeadFile, err)
+	}
+	return &head, nil
+}
+
+// writeHead는 seq까지 동기화되었음을 head 파일에 남깁니다. 기록을 동기화한 뒤에만 호출하므로 head는 로그보다 앞서지 않습니다.
+func (l *AuditLog) writeHead(seq int64, hash string) error {
+	data, err := json.Marshal(auditHead{Seq: seq, Hash: hash})
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(l.dir, auditHeadFile), data)
+}
+
+// resume은 마지막 파일의 마지막 기록을 읽어 Seq와 해시를 복원하고 파일을 이어 쓰기로 엽니다.
+// 기록 도중 종료되어 줄바꿈 없이 끝난 마지막 줄은 잘라냅니다.
+func (l *AuditLog) resume(name string) error {
+	path := filepath.Join(l.dir, name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if end := bytes.LastIndexByte(data, '\n') + 1; end < len(data) {
+		slog.Warn("truncating incomplete audit record", "file", name, "bytes", len(data)-end)
+		if err := os.Truncate(path, int64(end)); err != nil {
+			return fmt.Errorf("failed to truncate audit log %s: %w", name, err)
+		}
+		data = data[:end]
+	}
+
+	lines := bytes.Split(bytes.TrimSuffix(data, []byte("\n")), []byte("\n"))
+	if last := lines[len(lines)-1]; len(last) > 0 {
+		var record domain.AuditRecord
+		if err := json.Unmarshal(last, &record); err != nil {
+			return fmt.Errorf("failed to read last audit record in %s: %w", name, err)
+		}
+		l.seq, l.lastHash = record.Seq, record.Hash
+		l.syncedSeq = record.Seq
+	}
+
+	if int64(len(data)) >= l.maxBytes {
+		return nil // 다음 기록은 새 파일에
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	l.file, l.name, l.size = f, name, int64(len(data))
+	return nil
+}
+
+// Append는 기록에 Seq와 해시를 채워 덧붙이고, 디스크에 동기화될 때까지 기다립니다.
+func (l *AuditLog) Append(record *domain.AuditRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.broken != nil {
+		return fmt.Errorf("audit log is unusable after a failed write: %w", l.broken)
+	}
+
+	r := *record
+	r.Time = r.Time.UTC()
+	var line []byte
+	for {
+		r.Seq = l.seq + 1
+		r.PrevHash = l.lastHash
+		r.Hash = r.ComputeHash()
+		var err error
+		if line, err = json.Marshal(r); err != nil {
+			return err
+		}
+		line = append(line, '\n')
+
+		if l.file != nil && (l.size == 0 || l.size+int64(len(line)) <= l.maxBytes) {
+			break
+		}
+		if l.syncing {
+			// 동기화 중인 파일은 닫을 수 없음. 기다리는 동안 다른 기록이 끼어들 수 있으므로 Seq부터 다시 계산
+			l.synced.Wait()
+			continue
+		}
+		if err := l.rotate(r.Seq); err != nil {
+			return err
+		}
+		break
+	}
+	if _, err := l.file.Write(line); err != nil {
+		// 일부만 쓰였을 수 있으므로 마지막 온전한 기록까지 되돌림. 되돌리지 못하면 이후 기록이 깨진 줄 뒤에 붙지 않도록 멈춤
+		if truncErr := l.file.Truncate(l.size); truncErr != nil {
+			l.broken = truncErr
+			slog.Error("failed to truncate partial audit record", "file", l.name, "error", truncErr)
+		}
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	l.size += int64(len(line))
+	l.seq, l.lastHash = r.Seq, r.Hash
+
+	if err := l.waitSync(r.Seq); err != nil {
+		return err
+	}
+	*record = r
+	return nil
+}
+
+// waitSync는 seq까지의 기록이 디스크에 동기화될 때까지 기다립니다. 진행 중인 동기화가 없으면 호출자가
+// 그때까지 쓰인 기록 전체를 대표로 동기화합니다. 호출자가 잠금을 보유해야 하며, 동기화하는 동안은 잠금을 풉니다.
+func (l *AuditLog) waitSync(seq int64) error {
+	for l.syncedSeq < seq {
+		if l.syncing {
+			l.synced.Wait()
+			continue
+		}
+		l.syncing = true
+		f, target, hash := l.file, l.seq, l.lastHash
+		l.mu.Unlock()
+		err := f.Sync()
+		if err == nil {
+			err = l.writeHead(target, hash)
+		}
+		l.mu.Lock()
+		l.syncing = false
+		l.synced.Broadcast()
+		if err != nil {
+			return fmt.Errorf("failed to sync audit log: %w", err)
+		}
+		l.syncedSeq = max(l.syncedSeq, target)
+	}
+	return nil
+}
+
+// closeFile은 진행 중인 동기화가 끝나길 기다린 뒤 현재 파일을 동기화하고 닫습니다. 호출자가 잠금을 보유해야 합니다.
+func (l *AuditLog) closeFile() error {
+	for l.syncing {
+		l.synced.Wait()
+	}
+	if l.file == nil {
+		return nil
+	}
+	if err := l.file.Sync(); err != nil {
+		return fmt.Errorf("failed to sync audit log: %w", err)
+	}
+	if l.syncedSeq < l.seq {
+		if err := l.writeHead(l.seq, l.lastHash); err != nil {
+			return fmt.Errorf("failed to sync audit log: %w", err)
+		}
+	}
+	l.syncedSeq = l.seq
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// rotate는 현재 파일을 닫아 읽기 전용으로 바꾸고 seq로 시작하는 새 파일을 엽니다.
+// 호출자가 잠금을 보유해야 하고 동기화 중이 아니어야 합니다.
+func (l *AuditLog) rotate(seq int64) error {
+	if l.file != nil {
+		if err := l.closeFile(); err != nil {
+			return err
+		}
+		if err := os.Chmod(filepath.Join(l.dir, l.name), 0440); err != nil {
+			slog.Warn("failed to make rotated audit log read-only", "file", l.name, "error", err)
+		}
+	}
+	name := fmt.Sprintf("%s%012d%s", auditFilePrefix, seq, auditFileSuffix)
+	f, err := os.OpenFile(filepath.Join(l.dir, name), os.O_WRONLY|os.O_APPEND|os.O_CREATE|os.O_EXCL, 0640)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log file: %w", err)
+	}
+	l.file, l.name, l.size = f, name, 0
+	return nil
+}
+
+// Close는 현재 파일을 동기화하고 닫습니다.
+func (l *AuditLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.closeFile()
+}
+
+func (l *AuditLog) Query(q domain.AuditQuery) ([]domain.AuditRecord, error) {
+	var records []domain.AuditRecord
+	err := l.scan(func(_ string, r *domain.AuditRecord) error {
+		if !q.Match(r) {
+			return nil
+		}
+		records = append(records, *r)
+		if q.Limit > 0 && len(records) > q.Limit {
+			records = records[1:]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+var (
+	// errAuditChainBroken은 Verify가 검사를 멈추기 위해 scan에 돌려주는 내부 오류입니다.
+	errAuditChainBroken = errors.New("audit chain broken")
+	// errAuditCorrupt는 JSON으로 읽을 수 없는 줄이 있을 때 반환됩니다.
+	errAuditCorrupt = errors.New("corrupt audit record")
+)
+
+// Verify는 첫 기록부터 사슬을 따라가며 검사하고, 마지막 기록이 head 파일에 남은 기록까지 이어지는지 확인합니다.
+// head는 동기화한 뒤에 쓰므로 로그가 head보다 길 수는 있지만 짧으면 끝부분이 지워진 것입니다.
+func (l *AuditLog) Verify() (*domain.AuditVerification, error) {
+	// head를 먼저 읽어야 검사 중에 덧붙은 기록 때문에 head가 로그보다 앞서지 않음
+	head, err := l.readHead()
+	if err != nil {
+		return nil, err
+	}
+	result := &domain.AuditVerification{Valid: true}
+	var prev *domain.AuditRecord
+	var lastFile string
+	err = l.scan(func(file string, r *domain.AuditRecord) error {
+		var message string
+		switch {
+		case r.Hash != r.ComputeHash():
+			message = "record hash does not match its contents"
+		case prev == nil && (r.Seq != 1 || r.PrevHash != ""):
+			message = "log does not start at seq 1"
+		case prev != nil && r.Seq != prev.Seq+1:
+			message = fmt.Sprintf("expected seq %d", prev.Seq+1)
+		case prev != nil && r.PrevHash != prev.Hash:
+			message = "prev_hash does not match the previous record"
+		case head != nil && r.Seq == head.Seq && r.Hash != head.Hash:
+			message = fmt.Sprintf("hash does not match %s", auditHeadFile)
+		}
+		if message != "" {
+			result.Valid, result.File, result.Seq, result.Message = false, file, r.Seq, message
+			return errAuditChainBroken
+		}
+		result.Records++
+		prev, lastFile = r, file
+		return nil
+	})
+	switch {
+	case errors.Is(err, errAuditCorrupt):
+		result.Valid, result.Message = false, err.Error()
+	case err != nil && !errors.Is(err, errAuditChainBroken):
+		return nil, err
+	}
+	if !result.Valid {
+		return result, nil
+	}
+	switch {
+	case head == nil && prev != nil:
+		result.Valid, result.File, result.Message = false, lastFile, fmt.Sprintf("%s is missing", auditHeadFile)
+	case head != nil && (prev == nil || prev.Seq < head.Seq):
+		result.Valid, result.File, result.Seq = false, lastFile, result.Records+1
+		result.Message = fmt.Sprintf("log ends at seq %d but %s records seq %d", result.Records, auditHeadFile, head.Seq)
+	}
+	return result, nil
+}
+
+// scan은 모든 기록을 순서대로 fn에 넘깁니다. fn이 오류를 반환하면 멈춥니다.
+func (l *AuditLog) scan(fn func(file string, r *domain.AuditRecord) error) error {
+	snap, err := l.snapshot()
+	if err != nil {
+		return err
+	}
+	for i, name := range snap.files {
+		f, err := os.Open(filepath.Join(l.dir, name))
+		if err != nil {
+			return err
+		}
+		var r io.Reader = f
+		if i == len(snap.files)-1 {
+			r = io.LimitReader(f, snap.lastSize)
+		}
+		err = scanAuditFile(name, r, fn)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func scanAuditFile(name string, r io.Reader, fn func(file string, r *domain.AuditRecord) error) error {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var record domain.AuditRecord
+			if jsonErr := json.Unmarshal(line, &record); jsonErr != nil {
+				return fmt.Errorf("%w in %s: %v", errAuditCorrupt, name, jsonErr)
+			}
+			if fnErr := fn(name, &record); fnErr != nil {
+				return fnErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (l *AuditLog) snapshot() (*auditSnapshot, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	files, err := l.list()
+	if err != nil {
+		return nil, err
+	}
+	snap := &auditSnapshot{files: files}
+	if len(files) > 0 {
+		last := files[len(files)-1]
+		if last == l.name {
+			snap.lastSize = l.size
+		} else {
+			info, err := os.Stat(filepath.Join(l.dir, last))
+			if err != nil {
+				return nil, err
+			}
+			snap.lastSize = info.Size()
+		}
+	}
+	return snap, nil
+}
+
+// list는 감사 로그 파일 이름을 기록 순서대로 반환합니다.
+func (l *AuditLog) list() ([]string, error) {
+	entries, err := os.ReadDir(l.dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		if !e.IsDir() && strings.HasPrefix(e.Name(), auditFilePrefix) && strings.HasSuffix(e.Name(), auditFileSuffix) {
+			files = append(files, e.Name())
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
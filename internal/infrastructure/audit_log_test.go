@@ -0,0 +1,221 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+)
+
+func auditRecord(userID string, at time.Time) *domain.AuditRecord {
+	return &domain.AuditRecord{
+		Time: at, UserID: userID, VoiceID: "voice-1", TextHash: strings.Repeat("a", 64),
+		Chars: 5, Outcome: domain.AuditSuccess, AudioHash: strings.Repeat("b", 64),
+	}
+}
+
+func TestAuditLog_AppendQueryRotate(t *testing.T) {
+	dir := t.TempDir()
+	log, err := NewAuditLog(dir, 900)
+	require.NoError(t, err)
+
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	for i, user := range []string{"alice", "bob", "alice", "alice"} {
+		require.NoError(t, log.Append(auditRecord(user, start.Add(time.Duration(i)*time.Minute))))
+	}
+	first, err := log.Query(domain.AuditQuery{})
+	require.NoError(t, err)
+	require.Len(t, first, 4)
+	assert.Equal(t, int64(1), first[0].Seq)
+	assert.Empty(t, first[0].PrevHash)
+	assert.Equal(t, first[0].Hash, first[1].PrevHash)
+
+	// 기록 하나가 400바이트 남짓이라 두 개마다 새 파일
+	files, _ := filepath.Glob(filepath.Join(dir, "audit-*.jsonl"))
+	assert.Equal(t, []string{
+		filepath.Join(dir, "audit-000000000001.jsonl"),
+		filepath.Join(dir, "audit-000000000003.jsonl"),
+	}, files)
+	info, _ := os.Stat(files[0])
+	assert.Equal(t, os.FileMode(0440), info.Mode().Perm())
+
+	records, err := log.Query(domain.AuditQuery{UserID: "alice", From: start.Add(time.Minute), To: start.Add(3 * time.Minute)})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, int64(3), records[0].Seq)
+
+	records, err = log.Query(domain.AuditQuery{UserID: "alice", Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, []int64{records[0].Seq, records[1].Seq})
+
+	// 다시 열면 사슬을 이어감
+	require.NoError(t, log.Close())
+	log, err = NewAuditLog(dir, 900)
+	require.NoError(t, err)
+	next := auditRecord("carol", start.Add(time.Hour))
+	require.NoError(t, log.Append(next))
+	assert.Equal(t, int64(5), next.Seq)
+	assert.Equal(t, first[3].Hash, next.PrevHash)
+
+	result, err := log.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, &domain.AuditVerification{Valid: true, Records: 5}, result)
+}
+
+func TestAuditLog_VerifyDetectsTampering(t *testing.T) {
+	dir := t.TempDir()
+	log, err := NewAuditLog(dir, 0)
+	require.NoError(t, err)
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, log.Append(auditRecord("alice", start.Add(time.Duration(i)*time.Minute))))
+	}
+	require.NoError(t, log.Close())
+	path := filepath.Join(dir, "audit-000000000001.jsonl")
+	data, _ := os.ReadFile(path)
+	lines := strings.SplitAfter(string(data), "\n")
+
+	// 사용자를 바꾸면 해당 기록의 해시가 맞지 않음
+	tampered := lines[0] + strings.Replace(lines[1], `"user_id":"alice"`, `"user_id":"mallory"`, 1) + lines[2]
+	require.NoError(t, os.WriteFile(path, []byte(tampered), 0640))
+	result, err := log.Verify()
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	assert.Equal(t, int64(2), result.Seq)
+	assert.Equal(t, int64(1), result.Records)
+
+	// 기록을 지우면 다음 기록의 seq가 맞지 않음
+	require.NoError(t, os.WriteFile(path, []byte(lines[0]+lines[2]), 0640))
+	result, err = log.Verify()
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	assert.Equal(t, "expected seq 2", result.Message)
+}
+
+func TestAuditLog_TruncatesIncompleteRecord(t *testing.T) {
+	dir := t.TempDir()
+	log, err := NewAuditLog(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(auditRecord("alice", time.Now())))
+	require.NoError(t, log.Close())
+
+	// 기록 도중 종료된 것처럼 줄 일부를 덧붙임
+	f, _ := os.OpenFile(filepath.Join(dir, "audit-000000000001.jsonl"), os.O_WRONLY|os.O_APPEND, 0)
+	f.WriteString(`{"seq":2,"time":`)
+	f.Close()
+
+	log, err = NewAuditLog(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(auditRecord("bob", time.Now())))
+	result, err := log.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, &domain.AuditVerification{Valid: true, Records: 2}, result)
+}
+
+func TestAuditLog_ConcurrentAppend(t *testing.T) {
+	dir := t.TempDir()
+	// 파일마다 기록 두 개라 동기화와 파일 교체가 겹침
+	log, err := NewAuditLog(dir, 900)
+	require.NoError(t, err)
+
+	const n = 40
+	seqs := make([]int64, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			record := auditRecord("alice", time.Now())
+			assert.NoError(t, log.Append(record))
+			seqs[i] = record.Seq
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, log.Close())
+
+	seen := map[int64]bool{}
+	for _, seq := range seqs {
+		seen[seq] = true
+	}
+	assert.Len(t, seen, n)
+	result, err := log.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, &domain.AuditVerification{Valid: true, Records: n}, result)
+}
+
+func TestAuditLog_VerifyDetectsMissingFirstFile(t *testing.T) {
+	dir := t.TempDir()
+	log, err := NewAuditLog(dir, 900)
+	require.NoError(t, err)
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < 4; i++ {
+		require.NoError(t, log.Append(auditRecord("alice", start.Add(time.Duration(i)*time.Minute))))
+	}
+	require.NoError(t, log.Close())
+
+	// 첫 파일을 통째로 지우면 남은 사슬은 온전하지만 seq 1에서 시작하지 않음
+	require.NoError(t, os.Remove(filepath.Join(dir, "audit-000000000001.jsonl")))
+	result, err := log.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, &domain.AuditVerification{
+		File: "audit-000000000003.jsonl", Seq: 3, Message: "log does not start at seq 1",
+	}, result)
+}
+
+func TestAuditLog_VerifyDetectsTruncatedTail(t *testing.T) {
+	dir := t.TempDir()
+	log, err := NewAuditLog(dir, 0)
+	require.NoError(t, err)
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, log.Append(auditRecord("alice", start.Add(time.Duration(i)*time.Minute))))
+	}
+	require.NoError(t, log.Close())
+	path := filepath.Join(dir, "audit-000000000001.jsonl")
+	data, _ := os.ReadFile(path)
+	lines := strings.SplitAfter(string(data), "\n")
+
+	// 마지막 기록을 지우면 head 파일보다 로그가 짧음
+	require.NoError(t, os.WriteFile(path, []byte(lines[0]+lines[1]), 0640))
+	result, err := log.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, &domain.AuditVerification{
+		Records: 2, File: "audit-000000000001.jsonl", Seq: 3,
+		Message: "log ends at seq 2 but audit-head.json records seq 3",
+	}, result)
+
+	// 잘린 로그에는 이어 쓰지 않음
+	_, err = NewAuditLog(dir, 0)
+	assert.ErrorContains(t, err, "audit log ends at seq 2")
+
+	// head 파일까지 지우면 그 사실을 알림
+	require.NoError(t, os.Remove(filepath.Join(dir, auditHeadFile)))
+	result, err = log.Verify()
+	require.NoError(t, err)
+	assert.False(t, result.Valid)
+	assert.Equal(t, "audit-head.json is missing", result.Message)
+}
+
+func TestAuditLog_StopsAfterUnrecoverableWrite(t *testing.T) {
+	dir := t.TempDir()
+	log, err := NewAuditLog(dir, 0)
+	require.NoError(t, err)
+	require.NoError(t, log.Append(auditRecord("alice", time.Now())))
+
+	// 쓰기도 자르기도 실패하는 파일로 바꿈
+	require.NoError(t, log.file.Close())
+	log.file, err = os.Open(filepath.Join(dir, log.name))
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, log.Append(auditRecord("bob", time.Now())), "failed to write audit record")
+	assert.ErrorContains(t, log.Append(auditRecord("carol", time.Now())), "audit log is unusable")
+	result, err := log.Verify()
+	require.NoError(t, err)
+	assert.Equal(t, &domain.AuditVerification{Valid: true, Records: 1}, result)
+}
@@ -0,0 +1,145 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"tts_proxy/internal/domain"
+)
+
+// BackgroundStore는 배경 트랙마다 root/{name}.wav와 메타데이터 root/{name}.json을 저장합니다.
+type BackgroundStore struct {
+	root string
+	mu   sync.RWMutex
+}
+
+// NewBackgroundStore는 root 디렉토리를 사용하는 배경 트랙 저장소를 생성합니다.
+func NewBackgroundStore(root string) (*BackgroundStore, error) {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create background directory %s: %w", root, err)
+	}
+	return &BackgroundStore{root: root}, nil
+}
+
+func (s *BackgroundStore) Get(name string) (*domain.BackgroundTrack, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.read(name)
+}
+
+// List는 배경 트랙을 이름 순으로 반환합니다.
+func (s *BackgroundStore) List() ([]domain.BackgroundTrack, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries, err := os.ReadDir(s.root)
+	if err != nil {
+		return nil, err
+	}
+	tracks := make([]domain.BackgroundTrack, 0, len(entries))
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".json")
+		if !ok || e.IsDir() {
+			continue
+		}
+		track, err := s.read(name)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, *track)
+	}
+	sort.Slice(tracks, func(i, j int) bool { return tracks[i].Name < tracks[j].Name })
+	return tracks, nil
+}
+
+func (s *BackgroundStore) Audio(name string) ([]byte, error) {
+	if err := checkBackgroundName(name); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, err := os.ReadFile(filepath.Join(s.root, name+".wav"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrBackgroundNotFound, name)
+	}
+	return data, err
+}
+
+// Save는 WAV를 먼저 쓰고 메타데이터를 나중에 써서, 목록에 보이는 트랙은 항상 오디오가 있도록 합니다.
+func (s *BackgroundStore) Save(track domain.BackgroundTrack, wav []byte) error {
+	if err := checkBackgroundName(track.Name); err != nil {
+		return err
+	}
+	meta, err := json.MarshalIndent(track, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := writeFileAtomic(filepath.Join(s.root, track.Name+".wav"), wav); err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(s.root, track.Name+".json"), meta)
+}
+
+func (s *BackgroundStore) Delete(name string) error {
+	if err := checkBackgroundName(name); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	err := os.Remove(filepath.Join(s.root, name+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %s", domain.ErrBackgroundNotFound, name)
+	}
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(filepath.Join(s.root, name+".wav")); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// read는 메타데이터를 읽습니다. 호출자가 잠금을 보유해야 합니다.
+func (s *BackgroundStore) read(name string) (*domain.BackgroundTrack, error) {
+	if err := checkBackgroundName(name); err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(s.root, name+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrBackgroundNotFound, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var track domain.BackgroundTrack
+	if err := json.Unmarshal(data, &track); err != nil {
+		return nil, fmt.Errorf("failed to parse background %s: %w", name, err)
+	}
+	return &track, nil
+}
+
+// checkBackgroundName은 저장소 디렉토리 밖을 가리키는 이름을 거부합니다.
+func checkBackgroundName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("%w: invalid name %q", domain.ErrBackgroundNotFound, name)
+	}
+	return nil
+}
+
+// writeFileAtomic은 임시 파일에 쓴 뒤 이름을 바꿔 파일을 원자적으로 교체합니다.
+func writeFileAtomic(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", filepath.Base(path), err)
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,47 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+func TestBackgroundStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewBackgroundStore(dir)
+	assert.NoError(t, err)
+
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	assert.NoError(t, store.Save(domain.BackgroundTrack{Name: "rain", SampleRate: 44100, Channels: 2, Size: 4, CreatedAt: now}, []byte("RIFF")))
+	assert.NoError(t, store.Save(domain.BackgroundTrack{Name: "piano", SampleRate: 48000, Channels: 1, Size: 4, CreatedAt: now}, []byte("WAVE")))
+
+	track, err := store.Get("rain")
+	assert.NoError(t, err)
+	assert.Equal(t, 44100, track.SampleRate)
+
+	data, err := store.Audio("piano")
+	assert.NoError(t, err)
+	assert.Equal(t, "WAVE", string(data))
+
+	// 다시 열어도 목록이 유지됨
+	store, err = NewBackgroundStore(dir)
+	assert.NoError(t, err)
+	tracks, err := store.List()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"piano", "rain"}, []string{tracks[0].Name, tracks[1].Name})
+
+	assert.NoError(t, store.Delete("rain"))
+	_, err = store.Get("rain")
+	assert.ErrorIs(t, err, domain.ErrBackgroundNotFound)
+	_, err = store.Audio("rain")
+	assert.ErrorIs(t, err, domain.ErrBackgroundNotFound)
+	assert.ErrorIs(t, store.Delete("rain"), domain.ErrBackgroundNotFound)
+
+	for _, name := range []string{"../rain", "..", "a/b", ""} {
+		_, err := store.Audio(name)
+		assert.ErrorIs(t, err, domain.ErrBackgroundNotFound, name)
+		assert.Error(t, store.Save(domain.BackgroundTrack{Name: name}, nil), name)
+	}
+}
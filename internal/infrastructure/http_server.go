@@ -2,24 +2,75 @@ package infrastructure
 
 import (
 	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"tts_proxy/internal/interface/handler"
 	"tts_proxy/internal/interface/middleware"
+	"tts_proxy/pkg/metrics"
+	"tts_proxy/pkg/tracing"
 )
 
 type ServerConfig struct {
 	Port        string
 	TTSEndpoint string
 	APIVersion  string
+	AdminKey    string            // 비어 있으면 /admin 엔드포인트 비활성화
+	BodyLimit   int               // 요청 본문 최대 크기(바이트), 0이면 Fiber 기본값
+	Logger      *slog.Logger      // 접근 로그를 남길 로거, nil이면 slog.Default()
+	Metrics     *metrics.Registry // nil이 아니면 HTTP 지표를 기록하고 /metrics로 노출
+	Tracer      *tracing.Tracer   // 서버 스팬을 만들 Tracer, nil이면 tracing.Default()
+}
+
+// Handlers는 HTTP 서버에 등록할 핸들러 모음입니다.
+type Handlers struct {
+	TTS        *handler.TTSHandler
+	Voice      *handler.VoiceHandler
+	Preset     *handler.PresetHandler
+	Lexicon    *handler.LexiconHandler
+	Dialogue   *handler.DialogueHandler
+	Audiobook  *handler.AudiobookHandler
+	Background *handler.BackgroundHandler
+	Watermark  *handler.WatermarkHandler
+	Health     *handler.HealthHandler
+	Audit      *handler.AuditHandler
+	Usage      *handler.UsageHandler
 }
 
 type HTTPServer struct {
 	App *fiber.App
+
+	inFlight atomic.Int64 // 처리 중인 요청 수
 }
 
-func NewHTTPServer(cfg ServerConfig, ttsHandler *handler.TTSHandler, authMiddleware *middleware.AuthMiddleware) *HTTPServer {
-	app := fiber.New()
+func NewHTTPServer(cfg ServerConfig, h Handlers, authMiddleware *middleware.AuthMiddleware) *HTTPServer {
+	app := fiber.New(fiber.Config{BodyLimit: cfg.BodyLimit})
+	s := &HTTPServer{App: app}
+
+	// 종료 기한까지 끝나지 않은 요청 수를 알 수 있도록 처리 중인 요청을 셈
+	app.Use(func(c *fiber.Ctx) error {
+		s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+		return c.Next()
+	})
+
+	// Prometheus 지표
+	if cfg.Metrics != nil {
+		app.Use(middleware.NewMetricsMiddleware(cfg.Metrics).Handle)
+		app.Get("/metrics", handler.NewMetricsHandler(cfg.Metrics).ServeMetrics)
+	}
+
+	// 분산 추적 (traceparent 전파)
+	app.Use(middleware.NewTracingMiddleware(cfg.Tracer).Handle)
+
+	// 요청 ID (X-Request-ID를 받아들이거나 생성해 응답에 돌려줌)
+	app.Use(middleware.NewRequestIDMiddleware().Handle)
+
+	// 접근 로그
+	app.Use(middleware.NewLoggingMiddleware(cfg.Logger).Handle)
 
 	// CORS 허용
 	app.Use(cors.New())
@@ -27,15 +78,76 @@ func NewHTTPServer(cfg ServerConfig, ttsHandler *handler.TTSHandler, authMiddlew
 	// 인증 미들웨어(목업) - 향후 확장
 	app.Use(authMiddleware.Handle)
 
+	// 헬스 체크 (오케스트레이터용)
+	app.Get("/healthz", h.Health.Healthz)
+	app.Get("/readyz", h.Health.Readyz)
+	app.Get("/health/deep", h.Health.DeepHealth)
+
 	// API 버전별 라우팅 그룹
 	apiGroup := app.Group(fmt.Sprintf("/api/%s", cfg.APIVersion))
 	
-	// TTS 엔드포인트 - voiceID를 URL 경로 파라미터로 받음
-	apiGroup.Post(fmt.Sprintf("%s/:voiceId", cfg.TTSEndpoint), ttsHandler.HandleTTS)
+	// TTS 엔드포인트 - voiceID를 URL 경로 파라미터로 받음 (프리셋 사용 시 생략 가능)
+	apiGroup.Post(fmt.Sprintf("%s/:voiceId?", cfg.TTSEndpoint), h.TTS.HandleTTS)
+
+	// 음성 카탈로그 엔드포인트
+	apiGroup.Get("/voices", h.Voice.ListVoices)
+	apiGroup.Get("/voices/:id", h.Voice.GetVoice)
+
+	// 사용자별 합성 프리셋 엔드포인트
+	apiGroup.Post("/presets", h.Preset.CreatePreset)
+	apiGroup.Get("/presets", h.Preset.ListPresets)
+	apiGroup.Get("/presets/:name", h.Preset.GetPreset)
+	apiGroup.Put("/presets/:name", h.Preset.UpdatePreset)
+	apiGroup.Delete("/presets/:name", h.Preset.DeletePreset)
 
-	return &HTTPServer{App: app}
+	// 사용자별 발음 사전 엔드포인트 (PLS 가져오기/내보내기 포함)
+	apiGroup.Post("/lexicons", h.Lexicon.CreateLexicon)
+	apiGroup.Get("/lexicons", h.Lexicon.ListLexicons)
+	apiGroup.Get("/lexicons/:name", h.Lexicon.GetLexicon)
+	apiGroup.Put("/lexicons/:name", h.Lexicon.PutLexicon)
+	apiGroup.Delete("/lexicons/:name", h.Lexicon.DeleteLexicon)
+	apiGroup.Post("/lexicons/:name/import", h.Lexicon.ImportLexicon)
+	apiGroup.Get("/lexicons/:name/export", h.Lexicon.ExportLexicon)
+
+	// 다중 화자 대화 렌더링 엔드포인트
+	apiGroup.Post("/dialogues", h.Dialogue.HandleDialogue)
+
+	// 오디오북 변환 작업 엔드포인트
+	apiGroup.Post("/audiobooks", h.Audiobook.CreateAudiobook)
+	apiGroup.Get("/audiobooks", h.Audiobook.ListAudiobooks)
+	apiGroup.Get("/audiobooks/:id", h.Audiobook.GetAudiobook)
+	apiGroup.Post("/audiobooks/:id/resume", h.Audiobook.ResumeAudiobook)
+	apiGroup.Get("/audiobooks/:id/files/:name", h.Audiobook.GetAudiobookFile)
+
+	// 배경 음악 트랙 목록 (요청의 background.track에 사용할 이름)
+	apiGroup.Get("/backgrounds", h.Background.ListBackgrounds)
+
+	// 워터마크 검출 (유출된 오디오의 요청 ID와 사용자 확인)
+	apiGroup.Post("/watermark/detect", h.Watermark.DetectWatermark)
+
+	// 관리자 엔드포인트 - X-Admin-Key 헤더 필요
+	adminGroup := app.Group("/admin", middleware.NewAdminMiddleware(cfg.AdminKey).Handle)
+	adminGroup.Get("/backgrounds", h.Background.ListBackgrounds)
+	adminGroup.Put("/backgrounds/:name", h.Background.UploadBackground)
+	adminGroup.Delete("/backgrounds/:name", h.Background.DeleteBackground)
+	adminGroup.Get("/audit", h.Audit.QueryAudit)
+	adminGroup.Get("/audit/verify", h.Audit.VerifyAudit)
+	adminGroup.Get("/usage", h.Usage.GetUsage)
+
+	return s
 }
 
 func (s *HTTPServer) Start(port string) error {
 	return s.App.Listen(":" + port)
-} 
\ No newline at end of file
+}
+
+// Shutdown은 새 연결을 받지 않고 처리 중인 요청이 끝나기를 기다립니다. timeout이 지나면 기다리기를 멈추고 오류를 반환할 뿐
+// 처리 중인 요청을 끊지는 않습니다. 그 요청들은 프로세스가 끝날 때 응답 도중 끊기므로 InFlight로 수를 확인해 기록하세요.
+func (s *HTTPServer) Shutdown(timeout time.Duration) error {
+	return s.App.ShutdownWithTimeout(timeout)
+}
+
+// InFlight는 처리 중인 요청 수를 반환합니다.
+func (s *HTTPServer) InFlight() int64 {
+	return s.inFlight.Load()
+}
@@ -0,0 +1,116 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/pls"
+)
+
+// LexiconStore는 LexiconRepository의 구현체입니다.
+// path가 비어 있으면 메모리에만 저장하고, 아니면 변경될 때마다 JSON 파일로 기록합니다.
+type LexiconStore struct {
+	store *userStore[domain.Lexicon]
+}
+
+// NewLexiconStore는 LexiconStore를 생성하고, 파일이 있으면 기존 사전을 읽어옵니다.
+func NewLexiconStore(path string) (*LexiconStore, error) {
+	store, err := newUserStore(path, func(l domain.Lexicon) string { return l.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &LexiconStore{store: store}, nil
+}
+
+func (s *LexiconStore) Get(userID, name string) (*domain.Lexicon, error) {
+	lexicon, ok := s.store.get(userID, name)
+	if !ok {
+		return nil, domain.ErrLexiconNotFound
+	}
+	return &lexicon, nil
+}
+
+func (s *LexiconStore) List(userID string) ([]domain.Lexicon, error) {
+	return s.store.list(userID), nil
+}
+
+func (s *LexiconStore) Save(userID string, lexicon domain.Lexicon) error {
+	return s.store.save(userID, lexicon)
+}
+
+func (s *LexiconStore) Delete(userID, name string) error {
+	found, err := s.store.delete(userID, name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return domain.ErrLexiconNotFound
+	}
+	return nil
+}
+
+// LoadLexiconDir는 디렉토리의 *.pls, *.json 파일을 전역 발음 사전으로 읽습니다.
+// 사전 이름은 확장자를 뺀 파일 이름이며, 디렉토리가 없으면 빈 목록을 반환합니다.
+func LoadLexiconDir(dir string) ([]domain.Lexicon, error) {
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lexicon directory %s: %w", dir, err)
+	}
+
+	var lexicons []domain.Lexicon
+	for _, f := range files {
+		ext := strings.ToLower(filepath.Ext(f.Name()))
+		if f.IsDir() || (ext != ".pls" && ext != ".json") {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		name := strings.TrimSuffix(f.Name(), filepath.Ext(f.Name()))
+
+		lexicon, err := loadLexiconFile(path, name, ext)
+		if err != nil {
+			return nil, err
+		}
+		if err := lexicon.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		lexicons = append(lexicons, lexicon)
+	}
+
+	sort.Slice(lexicons, func(i, j int) bool { return lexicons[i].Name < lexicons[j].Name })
+	return lexicons, nil
+}
+
+func loadLexiconFile(path, name, ext string) (domain.Lexicon, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return domain.Lexicon{}, fmt.Errorf("failed to open lexicon %s: %w", path, err)
+	}
+	defer f.Close()
+
+	if ext == ".pls" {
+		doc, err := pls.Decode(f)
+		if err != nil {
+			return domain.Lexicon{}, fmt.Errorf("%s: %w", path, err)
+		}
+		lexicon, _ := domain.LexiconFromPLS(name, doc)
+		return lexicon, nil
+	}
+
+	var lexicon domain.Lexicon
+	if err := json.NewDecoder(f).Decode(&lexicon); err != nil {
+		return domain.Lexicon{}, fmt.Errorf("failed to parse lexicon %s: %w", path, err)
+	}
+	if lexicon.Name == "" {
+		lexicon.Name = name
+	}
+	return lexicon, nil
+}
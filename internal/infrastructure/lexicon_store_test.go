@@ -0,0 +1,59 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+func TestLexiconStore(t *testing.T) {
+	store, err := NewLexiconStore(filepath.Join(t.TempDir(), "lexicons.json"))
+	assert.NoError(t, err)
+
+	assert.NoError(t, store.Save("alice", domain.Lexicon{Name: "brand"}))
+	lexicon, err := store.Get("alice", "brand")
+	assert.NoError(t, err)
+	assert.Equal(t, "brand", lexicon.Name)
+
+	_, err = store.Get("bob", "brand")
+	assert.ErrorIs(t, err, domain.ErrLexiconNotFound)
+	assert.ErrorIs(t, store.Delete("bob", "brand"), domain.ErrLexiconNotFound)
+}
+
+func TestLoadLexiconDir(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "brand.pls"), []byte(`<?xml version="1.0"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="ko">
+  <lexeme><grapheme>Supertone</grapheme><alias>수퍼톤</alias></lexeme>
+  <lexeme><grapheme>W3C</grapheme><phoneme>x</phoneme></lexeme>
+</lexicon>`), 0644)
+	os.WriteFile(filepath.Join(dir, "units.json"), []byte(`{"entries": [{"grapheme": "(\\d+)kg", "replacement": "$1킬로그램", "match": "regex"}]}`), 0644)
+	os.WriteFile(filepath.Join(dir, "README.txt"), []byte("ignored"), 0644)
+
+	lexicons, err := LoadLexiconDir(dir)
+	assert.NoError(t, err)
+	assert.Len(t, lexicons, 2)
+	assert.Equal(t, "brand", lexicons[0].Name)
+	assert.Equal(t, "ko", lexicons[0].Language)
+	assert.Len(t, lexicons[0].Entries, 1)
+	assert.Equal(t, domain.MatchWord, lexicons[0].Entries[0].Match)
+	assert.Equal(t, "units", lexicons[1].Name)
+	assert.Equal(t, domain.MatchRegex, lexicons[1].Entries[0].Match)
+}
+
+func TestLoadLexiconDir_Missing(t *testing.T) {
+	lexicons, err := LoadLexiconDir(filepath.Join(t.TempDir(), "missing"))
+	assert.NoError(t, err)
+	assert.Empty(t, lexicons)
+}
+
+func TestLoadLexiconDir_Invalid(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "bad.json"), []byte(`{"entries": [{"grapheme": "(", "match": "regex"}]}`), 0644)
+
+	_, err := LoadLexiconDir(dir)
+	assert.ErrorIs(t, err, domain.ErrInvalidLexicon)
+}
@@ -0,0 +1,47 @@
+package infrastructure
+
+import (
+	"tts_proxy/internal/domain"
+)
+
+// PresetStore는 PresetRepository의 구현체입니다.
+// path가 비어 있으면 메모리에만 저장하고, 아니면 변경될 때마다 JSON 파일로 기록합니다.
+type PresetStore struct {
+	store *userStore[domain.Preset]
+}
+
+// NewPresetStore는 PresetStore를 생성하고, 파일이 있으면 기존 프리셋을 읽어옵니다.
+func NewPresetStore(path string) (*PresetStore, error) {
+	store, err := newUserStore(path, func(p domain.Preset) string { return p.Name })
+	if err != nil {
+		return nil, err
+	}
+	return &PresetStore{store: store}, nil
+}
+
+func (s *PresetStore) Get(userID, name string) (*domain.Preset, error) {
+	preset, ok := s.store.get(userID, name)
+	if !ok {
+		return nil, domain.ErrPresetNotFound
+	}
+	return &preset, nil
+}
+
+func (s *PresetStore) List(userID string) ([]domain.Preset, error) {
+	return s.store.list(userID), nil
+}
+
+func (s *PresetStore) Save(userID string, preset domain.Preset) error {
+	return s.store.save(userID, preset)
+}
+
+func (s *PresetStore) Delete(userID, name string) error {
+	found, err := s.store.delete(userID, name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return domain.ErrPresetNotFound
+	}
+	return nil
+}
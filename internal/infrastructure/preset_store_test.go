@@ -0,0 +1,74 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+)
+
+func TestPresetStore_PerUser(t *testing.T) {
+	store, err := NewPresetStore("")
+	assert.NoError(t, err)
+
+	assert.NoError(t, store.Save("alice", domain.Preset{Name: "calm", VoiceID: "v1"}))
+	assert.NoError(t, store.Save("alice", domain.Preset{Name: "bright", VoiceID: "v2"}))
+	assert.NoError(t, store.Save("bob", domain.Preset{Name: "calm", VoiceID: "v3"}))
+
+	presets, err := store.List("alice")
+	assert.NoError(t, err)
+	assert.Len(t, presets, 2)
+	assert.Equal(t, "bright", presets[0].Name)
+
+	preset, err := store.Get("bob", "calm")
+	assert.NoError(t, err)
+	assert.Equal(t, "v3", preset.VoiceID)
+
+	_, err = store.Get("bob", "bright")
+	assert.ErrorIs(t, err, domain.ErrPresetNotFound)
+
+	assert.NoError(t, store.Delete("alice", "calm"))
+	assert.ErrorIs(t, store.Delete("alice", "calm"), domain.ErrPresetNotFound)
+}
+
+func TestPresetStore_Persistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "presets.json")
+
+	store, err := NewPresetStore(path)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Save("alice", domain.Preset{
+		Name:        "calm",
+		VoiceID:     "v1",
+		TTSDefaults: domain.TTSDefaults{Style: "neutral", VoiceSettings: map[string]interface{}{"speed": 0.9}},
+	}))
+
+	reloaded, err := NewPresetStore(path)
+	assert.NoError(t, err)
+	preset, err := reloaded.Get("alice", "calm")
+	assert.NoError(t, err)
+	assert.Equal(t, "neutral", preset.Style)
+	assert.Equal(t, 0.9, preset.VoiceSettings["speed"])
+}
+
+func TestPresetStore_FailedWriteKeepsPreviousState(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data", "presets.json")
+	store, err := NewPresetStore(path)
+	require.NoError(t, err)
+	require.NoError(t, store.Save("alice", domain.Preset{Name: "calm", VoiceID: "v1"}))
+
+	// 디렉토리 자리에 파일을 두어 쓰기가 실패하게 함
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "data")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "data"), nil, 0644))
+
+	assert.Error(t, store.Save("alice", domain.Preset{Name: "bright", VoiceID: "v2"}))
+	assert.Error(t, store.Save("alice", domain.Preset{Name: "calm", VoiceID: "v3"}))
+	assert.Error(t, store.Delete("alice", "calm"))
+
+	presets, err := store.List("alice")
+	require.NoError(t, err)
+	assert.Equal(t, []domain.Preset{{Name: "calm", VoiceID: "v1"}}, presets)
+}
@@ -2,42 +2,72 @@ package infrastructure
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"sync"
+	"time"
 
 	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/tracing"
 )
 
+// maxLoggedErrorBody는 로그에 남길 업스트림 오류 본문의 최대 크기입니다.
+const maxLoggedErrorBody = 4 << 10
+
 type TTSProxyConfig struct {
 	APIURL string
 	APIKey string
 }
 
 type TTSProxyAdapter struct {
+	mu     sync.RWMutex
 	config TTSProxyConfig
 	client *http.Client
 }
 
-func NewTTSProxyAdapter(config TTSProxyConfig) *TTSProxyAdapter {
+// NewTTSProxyAdapter는 업스트림 호출 하나(응답 본문 읽기 포함)가 timeout을 넘으면 중단하는 어댑터를 만듭니다.
+// 응답하지 않는 연결이 회로 차단기의 시험 요청을 붙잡아 두지 않도록 제한 시간이 필요합니다.
+func NewTTSProxyAdapter(config TTSProxyConfig, timeout time.Duration) *TTSProxyAdapter {
 	return &TTSProxyAdapter{
 		config: config,
-		client: &http.Client{},
+		client: &http.Client{Timeout: timeout},
 	}
 }
 
+// Config는 현재 업스트림 주소와 API 키를 반환합니다.
+func (a *TTSProxyAdapter) Config() TTSProxyConfig {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.config
+}
+
+// SetConfig는 업스트림 주소와 API 키를 바꿉니다. 이미 보낸 요청은 시작할 때 읽은 값으로 끝까지 처리됩니다.
+func (a *TTSProxyAdapter) SetConfig(config TTSProxyConfig) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.config = config
+}
+
 // Synthesize는 외부 TTS API에 요청을 전달하고 오디오를 반환합니다.
-func (a *TTSProxyAdapter) Synthesize(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+func (a *TTSProxyAdapter) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
 	// Supertone API 스펙에 맞는 URL 구성: BASEURL/v1/text-to-speech/{voiceId}?output_format=wav
 	if voiceID == "" {
 		return nil, errors.New("voice_id is required")
 	}
-	
-	apiURL := fmt.Sprintf("%s/v1/text-to-speech/%s?output_format=wav", a.config.APIURL, voiceID)
-	
+	config := a.Config()
+
+	// mp3는 업스트림에서 그대로 받고, 나머지 형식은 WAV를 받아 서버에서 변환
+	format := domain.FormatWAV
+	if req.OutputFormat == domain.FormatMP3 {
+		format = domain.FormatMP3
+	}
+	apiURL := fmt.Sprintf("%s/v1/text-to-speech/%s?output_format=%s", config.APIURL, voiceID, format)
+
 	// Supertone API 요청 본문 구성 (실제 API 명세에 맞춤)
 	payload := map[string]interface{}{
 		"text":     req.Text,
@@ -64,38 +94,72 @@ func (a *TTSProxyAdapter) Synthesize(req *domain.TTSRequest, voiceID string) (*d
 		return nil, err
 	}
 
-	// 디버깅을 위한 로그 출력
-	log.Printf("[DEBUG] API URL: %s", apiURL)
-	log.Printf("[DEBUG] Request Body: %s", string(requestBody))
+	// 본문의 text는 LOG_TEXT를 켜지 않으면 길이만 기록됨
+	slog.DebugContext(ctx, "upstream request", "url", apiURL, "body", string(requestBody))
+
+	ctx, span := tracing.Start(ctx, "supertone.synthesize", tracing.KindClient,
+		tracing.String("http.request.method", http.MethodPost),
+		tracing.String("url.full", apiURL),
+		tracing.String("tts.voice_id", voiceID),
+	)
+	defer span.End()
 
-	httpReq, err := http.NewRequest("POST", apiURL, bytes.NewReader(requestBody))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", apiURL, bytes.NewReader(requestBody))
 	if err != nil {
 		return nil, err
 	}
 	httpReq.Header.Set("Content-Type", "application/json")
-	httpReq.Header.Set("x-sup-api-key", a.config.APIKey)
+	httpReq.Header.Set("x-sup-api-key", config.APIKey)
+	tracing.Inject(ctx, httpReq.Header)
+	if requestID := domain.RequestIDFromContext(ctx); requestID != "" {
+		httpReq.Header.Set(domain.RequestIDHeader, requestID)
+	}
 
+	start := time.Now()
 	resp, err := a.client.Do(httpReq)
 	if err != nil {
+		span.RecordError(err)
+		slog.ErrorContext(ctx, "upstream request failed", "error", err, "latency", time.Since(start))
 		return nil, err
 	}
 	defer resp.Body.Close()
+	span.SetAttributes(tracing.Int("http.response.status_code", resp.StatusCode))
 
 	if resp.StatusCode != http.StatusOK {
-		// 에러 응답 본문도 읽어서 로그에 출력
-		errorBody, _ := io.ReadAll(resp.Body)
-		log.Printf("[ERROR] API Error Response: %s", string(errorBody))
-		return nil, errors.New("TTS API error: " + resp.Status)
+		span.SetStatus(tracing.StatusError, resp.Status)
+		// 업스트림 오류 본문에는 요청 텍스트가 포함될 수 있어 body 필드로 기록 (기본적으로 가려짐)
+		errorBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxLoggedErrorBody))
+		slog.ErrorContext(ctx, "upstream error", "status", resp.StatusCode, "body", string(errorBody), "latency", time.Since(start))
+		return nil, &domain.UpstreamError{StatusCode: resp.StatusCode, Status: resp.Status}
 	}
 
-	// WAV 바이너리 데이터 읽기
+	// 오디오 바이너리 데이터 읽기
 	audio, err := io.ReadAll(resp.Body)
 	if err != nil {
+		span.RecordError(err)
 		return nil, err
 	}
+	slog.DebugContext(ctx, "upstream response", "status", resp.StatusCode, "bytes", len(audio), "latency", time.Since(start))
 
 	return &domain.TTSResponse{
 		Audio:  audio,
-		Format: "wav",
+		Format: format,
 	}, nil
-} 
\ No newline at end of file
+}
+
+// Ping은 업스트림 API 서버에 연결할 수 있는지 확인합니다. 5xx가 아닌 응답이면 연결 가능으로 봅니다.
+func (a *TTSProxyAdapter) Ping(ctx context.Context) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodHead, a.Config().APIURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return &domain.UpstreamError{StatusCode: resp.StatusCode, Status: resp.Status}
+	}
+	return nil
+}
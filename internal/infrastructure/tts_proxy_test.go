@@ -1,13 +1,20 @@
 package infrastructure
 
 import (
+	"bytes"
+	"context"
 	"io/ioutil"
+	"log/slog"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/logging"
+	"tts_proxy/pkg/tracing"
 )
 
 type mockRoundTripper struct {
@@ -22,7 +29,7 @@ func TestTTSProxyAdapter_Synthesize_Success(t *testing.T) {
 	mockRT := &mockRoundTripper{
 		RoundTripFunc: func(req *http.Request) *http.Response {
 			// URL이 올바른 형태인지 확인
-			expectedURL := "https://supertoneapi.com/v1/text-to-speech/test-voice-123"
+			expectedURL := "https://supertoneapi.com/v1/text-to-speech/test-voice-123?output_format=wav"
 			assert.Equal(t, expectedURL, req.URL.String())
 			
 			return &http.Response{
@@ -47,10 +54,41 @@ func TestTTSProxyAdapter_Synthesize_Success(t *testing.T) {
 			"speed":          1,
 		},
 	}
-	resp, err := adapter.Synthesize(req, "test-voice-123")
+	resp, err := adapter.Synthesize(context.Background(), req, "test-voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("WAVDATA"), resp.Audio)
+	assert.Equal(t, "wav", resp.Format)
+}
+
+func TestTTSProxyAdapter_Synthesize_MP3(t *testing.T) {
+	mockRT := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) *http.Response {
+			// mp3는 업스트림에 그대로 요청
+			assert.Equal(t, "mp3", req.URL.Query().Get("output_format"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader("MP3DATA")),
+			}
+		},
+	}
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "key"},
+		client: &http.Client{Transport: mockRT},
+	}
+
+	resp, err := adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi", Language: "en", OutputFormat: "mp3"}, "test-voice-123")
 	assert.NoError(t, err)
 	assert.Equal(t, []byte("MP3DATA"), resp.Audio)
 	assert.Equal(t, "mp3", resp.Format)
+
+	// 서버에서 변환하는 형식은 WAV로 요청
+	mockRT.RoundTripFunc = func(req *http.Request) *http.Response {
+		assert.Equal(t, "wav", req.URL.Query().Get("output_format"))
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("WAVDATA"))}
+	}
+	resp, err = adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi", Language: "en", OutputFormat: "flac"}, "test-voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "wav", resp.Format)
 }
 
 func TestTTSProxyAdapter_Synthesize_MissingVoiceID(t *testing.T) {
@@ -60,7 +98,7 @@ func TestTTSProxyAdapter_Synthesize_MissingVoiceID(t *testing.T) {
 	}
 
 	req := &domain.TTSRequest{Text: "hi", Language: "en"}
-	resp, err := adapter.Synthesize(req, "") // 빈 voiceID
+	resp, err := adapter.Synthesize(context.Background(), req, "") // 빈 voiceID
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "voice_id is required")
 	assert.Nil(t, resp)
@@ -84,7 +122,150 @@ func TestTTSProxyAdapter_Synthesize_Error(t *testing.T) {
 		Text:    "fail",
 		Language: "en",
 	}
-	resp, err := adapter.Synthesize(req, "test-voice-123")
+	resp, err := adapter.Synthesize(context.Background(), req, "test-voice-123")
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-} 
\ No newline at end of file
+}
+
+func TestTTSProxyAdapter_Synthesize_RedactedLogs(t *testing.T) {
+	var buf bytes.Buffer
+	logger, _ := logging.New(&buf, logging.Options{Level: slog.LevelDebug, Secrets: []string{"sk-secret-key"}})
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(logger)
+
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "sk-secret-key"},
+		client: &http.Client{Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				return &http.Response{
+					StatusCode: http.StatusBadRequest,
+					Status:     "400 Bad Request",
+					Body:       ioutil.NopCloser(strings.NewReader(`{"error":"bad text: 비밀 메모"}`)),
+				}
+			},
+		}},
+	}
+	ctx := logging.With(context.Background(), "request_id", "req-1")
+	_, err := adapter.Synthesize(ctx, &domain.TTSRequest{Text: "비밀 메모", Language: "ko"}, "voice-1")
+	assert.Error(t, err)
+
+	out := buf.String()
+	assert.NotContains(t, out, "비밀 메모")
+	assert.NotContains(t, out, "sk-secret-key")
+	assert.Contains(t, out, `"msg":"upstream request"`)
+	assert.Contains(t, out, `"msg":"upstream error"`)
+	assert.Contains(t, out, `"status":400`)
+	assert.Contains(t, out, `"request_id":"req-1"`)
+}
+
+type memorySpanExporter struct{ spans []tracing.SpanData }
+
+func (m *memorySpanExporter) Export(ctx context.Context, spans []tracing.SpanData) error {
+	m.spans = append(m.spans, spans...)
+	return nil
+}
+
+func TestTTSProxyAdapter_Synthesize_Traceparent(t *testing.T) {
+	exporter := &memorySpanExporter{}
+	tracer := tracing.NewTracer(exporter, tracing.TracerOptions{SampleRatio: 1})
+	defer tracing.SetDefault(tracing.Default())
+	tracing.SetDefault(tracer)
+
+	var traceparent string
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "key"},
+		client: &http.Client{Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				traceparent = req.Header.Get(tracing.TraceparentHeader)
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("WAVDATA"))}
+			},
+		}},
+	}
+	ctx := tracing.Extract(context.Background(), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	_, err := adapter.Synthesize(ctx, &domain.TTSRequest{Text: "hi", Language: "en"}, "voice-1")
+	assert.NoError(t, err)
+	assert.NoError(t, tracer.Shutdown(context.Background()))
+
+	assert.Len(t, exporter.spans, 1)
+	span := exporter.spans[0]
+	assert.Equal(t, "supertone.synthesize", span.Name)
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent.String())
+	assert.Equal(t, span.SpanContext.Traceparent(), traceparent)
+	assert.Contains(t, span.Attributes, tracing.Int("http.response.status_code", 200))
+}
+
+func TestTTSProxyAdapter_Synthesize_ForwardsRequestID(t *testing.T) {
+	var got string
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "key"},
+		client: &http.Client{Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				got = req.Header.Get(domain.RequestIDHeader)
+				return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(strings.NewReader("WAVDATA"))}
+			},
+		}},
+	}
+	ctx := domain.WithRequestID(context.Background(), "req-123")
+	_, err := adapter.Synthesize(ctx, &domain.TTSRequest{Text: "hi", Language: "en"}, "voice-1")
+	assert.NoError(t, err)
+	assert.Equal(t, "req-123", got)
+}
+
+func TestTTSProxyAdapter_Ping(t *testing.T) {
+	status := http.StatusNotFound
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "key"},
+		client: &http.Client{Transport: &mockRoundTripper{
+			RoundTripFunc: func(req *http.Request) *http.Response {
+				assert.Equal(t, http.MethodHead, req.Method)
+				return &http.Response{StatusCode: status, Status: http.StatusText(status), Body: http.NoBody}
+			},
+		}},
+	}
+	assert.NoError(t, adapter.Ping(context.Background())) // 응답이 오면 연결 가능
+
+	status = http.StatusBadGateway
+	var upstreamErr *domain.UpstreamError
+	assert.ErrorAs(t, adapter.Ping(context.Background()), &upstreamErr)
+	assert.Equal(t, http.StatusBadGateway, upstreamErr.StatusCode)
+}
+
+func TestTTSProxyAdapter_SetConfig(t *testing.T) {
+	var gotURL, gotKey string
+	mockRT := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) *http.Response {
+			gotURL, gotKey = req.URL.Host, req.Header.Get("x-sup-api-key")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader("WAVDATA")),
+			}
+		},
+	}
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "old-key"},
+		client: &http.Client{Transport: mockRT},
+	}
+
+	adapter.SetConfig(TTSProxyConfig{APIURL: "https://eu.supertoneapi.com", APIKey: "new-key"})
+	_, err := adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, "voice")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "eu.supertoneapi.com", gotURL)
+	assert.Equal(t, "new-key", gotKey)
+	assert.Equal(t, "new-key", adapter.Config().APIKey)
+}
+
+func TestTTSProxyAdapter_Synthesize_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release // 응답하지 않는 업스트림
+	}))
+	defer server.Close()
+	defer close(release)
+
+	adapter := NewTTSProxyAdapter(TTSProxyConfig{APIURL: server.URL, APIKey: "key"}, 50*time.Millisecond)
+	start := time.Now()
+	_, err := adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi", Language: "en"}, "voice-1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
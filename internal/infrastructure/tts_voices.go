@@ -0,0 +1,125 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/tracing"
+)
+
+// supertoneVoice는 Supertone 음성 목록 API의 개별 항목입니다.
+type supertoneVoice struct {
+	VoiceID     string   `json:"voice_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Age         string   `json:"age"`
+	Gender      string   `json:"gender"`
+	UseCase     string   `json:"use_case"`
+	Language    []string `json:"language"`
+	Styles      []string `json:"styles"`
+	Models      []string `json:"models"`
+}
+
+// supertoneVoiceList는 Supertone 음성 목록 API의 응답 본문입니다.
+type supertoneVoiceList struct {
+	Items         []supertoneVoice `json:"items"`
+	NextPageToken string           `json:"next_page_token"`
+}
+
+// ListVoices는 Supertone API에서 전체 음성 목록을 페이지 단위로 가져와 정규화합니다.
+func (a *TTSProxyAdapter) ListVoices(ctx context.Context) ([]domain.Voice, error) {
+	voices := []domain.Voice{}
+	pageToken := ""
+
+	for {
+		page, err := a.fetchVoicePage(ctx, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range page.Items {
+			voices = append(voices, v.toDomain())
+		}
+		if page.NextPageToken == "" || page.NextPageToken == pageToken {
+			break
+		}
+		pageToken = page.NextPageToken
+	}
+
+	return voices, nil
+}
+
+func (a *TTSProxyAdapter) fetchVoicePage(ctx context.Context, pageToken string) (*supertoneVoiceList, error) {
+	query := url.Values{}
+	query.Set("page_size", "100")
+	if pageToken != "" {
+		query.Set("next_page_token", pageToken)
+	}
+	config := a.Config()
+	apiURL := fmt.Sprintf("%s/v1/voices?%s", config.APIURL, query.Encode())
+
+	ctx, span := tracing.Start(ctx, "supertone.list_voices", tracing.KindClient,
+		tracing.String("http.request.method", http.MethodGet),
+		tracing.String("url.full", apiURL),
+	)
+	defer span.End()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("x-sup-api-key", config.APIKey)
+	tracing.Inject(ctx, httpReq.Header)
+	if requestID := domain.RequestIDFromContext(ctx); requestID != "" {
+		httpReq.Header.Set(domain.RequestIDHeader, requestID)
+	}
+
+	resp, err := a.client.Do(httpReq)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	span.SetAttributes(tracing.Int("http.response.status_code", resp.StatusCode))
+
+	if resp.StatusCode != http.StatusOK {
+		span.SetStatus(tracing.StatusError, resp.Status)
+		errorBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxLoggedErrorBody))
+		slog.ErrorContext(ctx, "voice API error", "status", resp.StatusCode, "body", string(errorBody))
+		return nil, errors.New("voice API error: " + resp.Status)
+	}
+
+	var page supertoneVoiceList
+	if err := json.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return nil, fmt.Errorf("failed to parse voice list: %w", err)
+	}
+	return &page, nil
+}
+
+func (v supertoneVoice) toDomain() domain.Voice {
+	return domain.Voice{
+		ID:          v.VoiceID,
+		Name:        v.Name,
+		Provider:    "supertone",
+		Description: v.Description,
+		Gender:      v.Gender,
+		Age:         v.Age,
+		UseCase:     v.UseCase,
+		Languages:   nonNil(v.Language),
+		Styles:      nonNil(v.Styles),
+		Models:      nonNil(v.Models),
+	}
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
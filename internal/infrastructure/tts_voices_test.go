@@ -0,0 +1,62 @@
+package infrastructure
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTSProxyAdapter_ListVoices_Pagination(t *testing.T) {
+	mockRT := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) *http.Response {
+			assert.Equal(t, "/v1/voices", req.URL.Path)
+			assert.Equal(t, "key", req.Header.Get("x-sup-api-key"))
+
+			body := `{"items":[{"voice_id":"v1","name":"Agatha","gender":"female","age":"young-adult","language":["ko","en"],"styles":["neutral"],"models":["sona_speech_1"]}],"next_page_token":"p2"}`
+			if req.URL.Query().Get("next_page_token") == "p2" {
+				body = `{"items":[{"voice_id":"v2","name":"Bob","gender":"male"}],"next_page_token":""}`
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}
+		},
+	}
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "key"},
+		client: &http.Client{Transport: mockRT},
+	}
+
+	voices, err := adapter.ListVoices(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, voices, 2)
+	assert.Equal(t, "v1", voices[0].ID)
+	assert.Equal(t, "supertone", voices[0].Provider)
+	assert.Equal(t, []string{"ko", "en"}, voices[0].Languages)
+	assert.Equal(t, "v2", voices[1].ID)
+	assert.Equal(t, []string{}, voices[1].Styles)
+}
+
+func TestTTSProxyAdapter_ListVoices_Error(t *testing.T) {
+	mockRT := &mockRoundTripper{
+		RoundTripFunc: func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusUnauthorized,
+				Status:     "401 Unauthorized",
+				Body:       ioutil.NopCloser(strings.NewReader("unauthorized")),
+			}
+		},
+	}
+	adapter := &TTSProxyAdapter{
+		config: TTSProxyConfig{APIURL: "https://supertoneapi.com", APIKey: "bad"},
+		client: &http.Client{Transport: mockRT},
+	}
+
+	voices, err := adapter.ListVoices(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, voices)
+}
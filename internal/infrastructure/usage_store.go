@@ -0,0 +1,213 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"tts_proxy/internal/domain"
+)
+
+// usageFlushInterval은 사용량 파일을 다시 쓰는 간격입니다. 비정상 종료 시 이 시간만큼의 사용량을 잃을 수 있습니다.
+const usageFlushInterval = 10 * time.Second
+
+type usageKey struct {
+	hour                   int64 // Unix 초
+	userID, voiceID, model string
+}
+
+// UsageStore는 사용량을 메모리에서 시간당 버킷으로 집계하고, 백그라운드에서 일정 간격으로 JSON 파일에 씁니다.
+// 파일에 쓸 때 보관 기간이 지난 버킷을 버립니다. path가 비어 있으면 메모리에만 보관합니다.
+type UsageStore struct {
+	path      string
+	retention time.Duration // 0 이하이면 버킷을 버리지 않음
+
+	writeMu sync.Mutex // 파일 쓰기를 직렬화해 오래된 스냅숏이 새 스냅숏을 덮어쓰지 않게 함
+
+	mu      sync.Mutex
+	buckets map[usageKey]*domain.UsageBucket
+	dirty   bool
+	now     func() time.Time
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewUsageStore는 path의 사용량 파일을 읽어 저장소를 생성하고 주기적으로 파일에 쓰기 시작합니다. 파일이 없으면 빈 저장소로 시작합니다.
+// retention보다 오래된 시간 버킷은 버립니다. retention이 0 이하이면 모두 보관합니다. 종료할 때 Close를 호출하세요.
+func NewUsageStore(path string, retention time.Duration) (*UsageStore, error) {
+	return newUsageStore(path, retention, usageFlushInterval)
+}
+
+func newUsageStore(path string, retention, interval time.Duration) (*UsageStore, error) {
+	s := &UsageStore{path: path, retention: retention, buckets: map[usageKey]*domain.UsageBucket{}, now: time.Now, done: make(chan struct{})}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	s.wg.Add(1)
+	go s.loop(interval)
+	return s, nil
+}
+
+// load는 사용량 파일을 읽어 버킷을 채웁니다.
+func (s *UsageStore) load() error {
+	path := s.path
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read usage file %s: %w", path, err)
+	}
+	var buckets []domain.UsageBucket
+	if err := json.Unmarshal(data, &buckets); err != nil {
+		return fmt.Errorf("failed to parse usage file %s: %w", path, err)
+	}
+	for i := range buckets {
+		b := buckets[i]
+		s.buckets[usageKey{b.Hour.Unix(), b.UserID, b.VoiceID, b.Model}] = &b
+	}
+	return nil
+}
+
+func (s *UsageStore) loop(interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := s.Flush(); err != nil {
+				slog.Warn("failed to write usage file", "path", s.path, "error", err)
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Add는 기록을 해당 시간 버킷에 더합니다. 파일에는 백그라운드에서 씁니다.
+func (s *UsageStore) Add(record domain.UsageRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	hour := record.Time.UTC().Truncate(time.Hour)
+	// 키와 버킷은 계속 보관되므로 호출자의 버퍼를 가리키지 않도록 복사
+	key := usageKey{hour.Unix(), strings.Clone(record.UserID), strings.Clone(record.VoiceID), strings.Clone(record.Model)}
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &domain.UsageBucket{Hour: hour, UserID: key.userID, VoiceID: key.voiceID, Model: key.model}
+		s.buckets[key] = b
+	}
+	b.Requests++
+	b.Characters += int64(record.Characters)
+	b.AudioSeconds += record.AudioSeconds
+	s.dirty = true
+	return nil
+}
+
+func (s *UsageStore) Query(from, to time.Time) ([]domain.UsageBucket, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var out []domain.UsageBucket
+	for _, b := range s.buckets {
+		if (from.IsZero() || !b.Hour.Before(from)) && (to.IsZero() || b.Hour.Before(to)) {
+			out = append(out, *b)
+		}
+	}
+	sortUsageBuckets(out)
+	return out, nil
+}
+
+// Close는 주기적인 쓰기를 멈추고 아직 쓰지 않은 사용량을 파일에 씁니다.
+func (s *UsageStore) Close() error {
+	close(s.done)
+	s.wg.Wait()
+	return s.Flush()
+}
+
+// Flush는 보관 기간이 지난 버킷을 버리고, 아직 쓰지 않은 사용량이 있으면 파일에 씁니다.
+// 잠금 안에서는 버킷을 복사만 하고 직렬화와 파일 쓰기는 잠금 밖에서 하므로 Add와 Query를 막지 않습니다.
+func (s *UsageStore) Flush() error {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+
+	buckets := s.snapshot()
+	if buckets == nil {
+		return nil
+	}
+	if err := s.persist(buckets); err != nil {
+		s.mu.Lock()
+		s.dirty = true // 다음에 다시 시도
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// snapshot은 보관 기간이 지난 버킷을 버리고, 파일에 쓸 변경이 있으면 버킷 복사본을 반환합니다.
+func (s *UsageStore) snapshot() []domain.UsageBucket {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.retention > 0 {
+		cutoff := s.now().Add(-s.retention).Unix()
+		for key := range s.buckets {
+			if key.hour < cutoff {
+				delete(s.buckets, key)
+				s.dirty = true
+			}
+		}
+	}
+	if s.path == "" || !s.dirty {
+		return nil
+	}
+	buckets := make([]domain.UsageBucket, 0, len(s.buckets))
+	for _, b := range s.buckets {
+		buckets = append(buckets, *b)
+	}
+	s.dirty = false
+	return buckets
+}
+
+// persist는 임시 파일에 쓴 뒤 이름을 바꿔 파일을 원자적으로 교체합니다.
+func (s *UsageStore) persist(buckets []domain.UsageBucket) error {
+	sortUsageBuckets(buckets)
+	data, err := json.Marshal(buckets)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create usage directory: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write usage file: %w", err)
+	}
+	return os.Rename(tmp, s.path)
+}
+
+func sortUsageBuckets(buckets []domain.UsageBucket) {
+	sort.Slice(buckets, func(i, j int) bool {
+		a, b := buckets[i], buckets[j]
+		if !a.Hour.Equal(b.Hour) {
+			return a.Hour.Before(b.Hour)
+		}
+		if a.UserID != b.UserID {
+			return a.UserID < b.UserID
+		}
+		if a.VoiceID != b.VoiceID {
+			return a.VoiceID < b.VoiceID
+		}
+		return a.Model < b.Model
+	})
+}
@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+)
+
+func TestUsageStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+	store, err := NewUsageStore(path, 0)
+	require.NoError(t, err)
+	defer store.Close()
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	record := domain.UsageRecord{Time: now, UserID: "alice", VoiceID: "voice-1", Model: "sona_speech_1", Characters: 10, AudioSeconds: 1.5}
+	require.NoError(t, store.Add(record))
+	record.Time = now.Add(20 * time.Minute) // 같은 시간 버킷
+	require.NoError(t, store.Add(record))
+	record.Time, record.UserID = now.Add(time.Hour), "bob"
+	require.NoError(t, store.Add(record))
+
+	buckets, err := store.Query(time.Time{}, time.Time{})
+	require.NoError(t, err)
+	require.Len(t, buckets, 2)
+	assert.Equal(t, domain.UsageBucket{
+		Hour: time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC), UserID: "alice", VoiceID: "voice-1", Model: "sona_speech_1",
+		Requests: 2, Characters: 20, AudioSeconds: 3,
+	}, buckets[0])
+
+	buckets, err = store.Query(time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC), time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, buckets, 1)
+	assert.Equal(t, "bob", buckets[0].UserID)
+
+	// 기록은 요청 중에 파일에 쓰지 않음
+	assert.NoFileExists(t, path)
+
+	require.NoError(t, store.Flush())
+	reopened, err := NewUsageStore(path, 0)
+	require.NoError(t, err)
+	defer reopened.Close()
+	buckets, _ = reopened.Query(time.Time{}, time.Time{})
+	require.Len(t, buckets, 2)
+	assert.Equal(t, int64(2), buckets[0].Requests)
+	assert.True(t, buckets[0].Hour.Equal(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)))
+}
+
+func TestUsageStore_BackgroundFlushAndRetention(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+	store, err := newUsageStore(path, 48*time.Hour, 10*time.Millisecond)
+	require.NoError(t, err)
+	now := time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC)
+	store.mu.Lock()
+	store.now = func() time.Time { return now }
+	store.mu.Unlock()
+
+	record := domain.UsageRecord{Time: now.Add(-72 * time.Hour), UserID: "alice", VoiceID: "voice-1", Characters: 10}
+	require.NoError(t, store.Add(record))
+	record.Time = now
+	require.NoError(t, store.Add(record))
+
+	// 백그라운드에서 파일에 쓰면서 보관 기간이 지난 버킷을 버림
+	assert.Eventually(t, func() bool {
+		_, err := os.Stat(path)
+		return err == nil
+	}, time.Second, 5*time.Millisecond)
+	require.NoError(t, store.Close())
+
+	buckets, _ := store.Query(time.Time{}, time.Time{})
+	require.Len(t, buckets, 1)
+	assert.True(t, buckets[0].Hour.Equal(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)))
+
+	reopened, err := NewUsageStore(path, 0)
+	require.NoError(t, err)
+	defer reopened.Close()
+	buckets, _ = reopened.Query(time.Time{}, time.Time{})
+	assert.Len(t, buckets, 1)
+}
@@ -0,0 +1,126 @@
+package infrastructure
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// userStore는 사용자별로 이름이 붙은 항목을 보관하는 저장소입니다.
+// path가 비어 있으면 메모리에만 저장하고, 아니면 변경될 때마다 JSON 파일로 기록합니다.
+type userStore[T any] struct {
+	path  string
+	name  func(T) string
+	mu    sync.RWMutex
+	items map[string]map[string]T // userID -> name -> item
+}
+
+func newUserStore[T any](path string, name func(T) string) (*userStore[T], error) {
+	s := &userStore[T]{path: path, name: name, items: map[string]map[string]T{}}
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read store file %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, &s.items); err != nil {
+		return nil, fmt.Errorf("failed to parse store file %s: %w", path, err)
+	}
+	return s, nil
+}
+
+func (s *userStore[T]) get(userID, name string) (T, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	item, ok := s.items[userID][name]
+	return item, ok
+}
+
+// list는 사용자의 항목을 이름순으로 반환합니다.
+func (s *userStore[T]) list(userID string) []T {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	items := make([]T, 0, len(s.items[userID]))
+	for _, item := range s.items[userID] {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return s.name(items[i]) < s.name(items[j]) })
+	return items
+}
+
+// save는 항목을 저장합니다. 파일에 쓰지 못하면 메모리의 항목도 바꾸지 않습니다.
+func (s *userStore[T]) save(userID string, item T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := s.withUser(userID)
+	items[userID][s.name(item)] = item
+	if err := s.persist(items); err != nil {
+		return err
+	}
+	s.items = items
+	return nil
+}
+
+// delete는 항목을 삭제합니다. 항목이 없으면 false를 반환합니다.
+func (s *userStore[T]) delete(userID, name string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.items[userID][name]; !ok {
+		return false, nil
+	}
+	items := s.withUser(userID)
+	delete(items[userID], name)
+	if err := s.persist(items); err != nil {
+		return false, err
+	}
+	s.items = items
+	return true, nil
+}
+
+// withUser는 userID의 항목만 복사한 새 맵을 반환합니다. 다른 사용자의 맵은 바뀌지 않으므로 그대로 공유합니다.
+// 호출자가 잠금을 보유해야 합니다.
+func (s *userStore[T]) withUser(userID string) map[string]map[string]T {
+	items := make(map[string]map[string]T, len(s.items)+1)
+	for id, userItems := range s.items {
+		items[id] = userItems
+	}
+	userItems := make(map[string]T, len(s.items[userID])+1)
+	for name, item := range s.items[userID] {
+		userItems[name] = item
+	}
+	items[userID] = userItems
+	return items
+}
+
+// persist는 items를 임시 파일에 쓴 뒤 이름을 바꿔 파일을 원자적으로 교체합니다. 호출자가 잠금을 보유해야 합니다.
+func (s *userStore[T]) persist(items map[string]map[string]T) error {
+	if s.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return fmt.Errorf("failed to create store directory: %w", err)
+	}
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write store file: %w", err)
+	}
+	return os.Rename(tmp, s.path)
+}
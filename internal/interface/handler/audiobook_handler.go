@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"path"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+)
+
+type AudiobookHandler struct {
+	AudiobookService domain.AudiobookService
+}
+
+func NewAudiobookHandler(audiobookService domain.AudiobookService) *AudiobookHandler {
+	return &AudiobookHandler{AudiobookService: audiobookService}
+}
+
+// audiobookView는 작업 상태에 진행률(0~1)을 덧붙인 응답입니다.
+type audiobookView struct {
+	*domain.AudiobookJob
+	Progress float64 `json:"progress"`
+}
+
+func newAudiobookView(job *domain.AudiobookJob) audiobookView {
+	return audiobookView{AudiobookJob: job, Progress: job.Progress()}
+}
+
+// CreateAudiobook은 /audiobooks POST 요청을 처리합니다. 작업은 백그라운드에서 실행되므로 202를 반환합니다.
+func (h *AudiobookHandler) CreateAudiobook(c *fiber.Ctx) error {
+	var req domain.AudiobookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	job, err := h.AudiobookService.Submit(c.UserContext(), &req)
+	if err != nil {
+		return audiobookError(c, err)
+	}
+	return c.Status(http.StatusAccepted).JSON(newAudiobookView(job))
+}
+
+// ListAudiobooks는 /audiobooks GET 요청을 처리합니다.
+func (h *AudiobookHandler) ListAudiobooks(c *fiber.Ctx) error {
+	jobs, err := h.AudiobookService.List(c.UserContext())
+	if err != nil {
+		return audiobookError(c, err)
+	}
+	views := make([]audiobookView, len(jobs))
+	for i := range jobs {
+		views[i] = newAudiobookView(&jobs[i])
+	}
+	return c.Status(http.StatusOK).JSON(fiber.Map{"audiobooks": views})
+}
+
+// GetAudiobook은 /audiobooks/:id GET 요청을 처리합니다.
+func (h *AudiobookHandler) GetAudiobook(c *fiber.Ctx) error {
+	job, err := h.AudiobookService.Get(c.UserContext(), c.Params("id"))
+	if err != nil {
+		return audiobookError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(newAudiobookView(job))
+}
+
+// ResumeAudiobook은 /audiobooks/:id/resume POST 요청을 처리합니다.
+func (h *AudiobookHandler) ResumeAudiobook(c *fiber.Ctx) error {
+	job, err := h.AudiobookService.Resume(c.UserContext(), c.Params("id"))
+	if err != nil {
+		return audiobookError(c, err)
+	}
+	return c.Status(http.StatusAccepted).JSON(newAudiobookView(job))
+}
+
+var audiobookContentTypes = map[string]string{
+	".wav":  "audio/wav",
+	".m3u":  "audio/x-mpegurl",
+	".json": "application/json",
+}
+
+// GetAudiobookFile은 /audiobooks/:id/files/:name GET 요청을 처리합니다. 챕터 WAV, playlist.m3u, chapters.json을 내려받습니다.
+func (h *AudiobookHandler) GetAudiobookFile(c *fiber.Ctx) error {
+	name := c.Params("name")
+	r, size, err := h.AudiobookService.OpenFile(c.UserContext(), c.Params("id"), name)
+	if err != nil {
+		return audiobookError(c, err)
+	}
+
+	contentType, ok := audiobookContentTypes[path.Ext(name)]
+	if !ok {
+		contentType = "application/octet-stream"
+	}
+	c.Set("Content-Type", contentType)
+	c.Set("Content-Disposition", `attachment; filename="`+name+`"`)
+	return c.Status(http.StatusOK).SendStream(r, int(size))
+}
+
+func audiobookError(c *fiber.Ctx, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrInvalidRequest):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrAudiobookNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrAudiobookConflict):
+		status = http.StatusConflict
+	}
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
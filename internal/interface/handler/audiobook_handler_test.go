@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockAudiobookService struct {
+	jobs    map[string]*domain.AudiobookJob
+	files   map[string][]byte
+	lastReq *domain.AudiobookRequest
+}
+
+func (m *mockAudiobookService) Submit(ctx context.Context, req *domain.AudiobookRequest) (*domain.AudiobookJob, error) {
+	if req.Document == "" {
+		return nil, fmt.Errorf("%w: document is required", domain.ErrInvalidRequest)
+	}
+	m.lastReq = req
+	job := &domain.AudiobookJob{ID: "job1", Title: req.Title, Status: domain.AudiobookQueued,
+		Chapters: []domain.AudiobookChapter{{Index: 0, File: "chapter_001.wav", Chunks: 4, CompletedChunks: 1}}}
+	m.jobs[job.ID] = job
+	return job, nil
+}
+
+func (m *mockAudiobookService) Get(ctx context.Context, id string) (*domain.AudiobookJob, error) {
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, domain.ErrAudiobookNotFound
+	}
+	return job, nil
+}
+
+func (m *mockAudiobookService) List(ctx context.Context) ([]domain.AudiobookJob, error) {
+	var out []domain.AudiobookJob
+	for _, job := range m.jobs {
+		out = append(out, *job)
+	}
+	return out, nil
+}
+
+func (m *mockAudiobookService) Resume(ctx context.Context, id string) (*domain.AudiobookJob, error) {
+	job, err := m.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status == domain.AudiobookCompleted {
+		return nil, domain.ErrAudiobookConflict
+	}
+	return job, nil
+}
+
+func (m *mockAudiobookService) OpenFile(ctx context.Context, id, name string) (io.ReadCloser, int64, error) {
+	data, ok := m.files[id+"/"+name]
+	if !ok {
+		return nil, 0, domain.ErrAudiobookNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+func newAudiobookApp() (*fiber.App, *mockAudiobookService) {
+	service := &mockAudiobookService{jobs: map[string]*domain.AudiobookJob{}, files: map[string][]byte{}}
+	h := NewAudiobookHandler(service)
+	app := fiber.New()
+	app.Post("/audiobooks", h.CreateAudiobook)
+	app.Get("/audiobooks", h.ListAudiobooks)
+	app.Get("/audiobooks/:id", h.GetAudiobook)
+	app.Post("/audiobooks/:id/resume", h.ResumeAudiobook)
+	app.Get("/audiobooks/:id/files/:name", h.GetAudiobookFile)
+	return app, service
+}
+
+func TestAudiobookHandler_Create(t *testing.T) {
+	app, service := newAudiobookApp()
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/audiobooks", map[string]interface{}{
+		"title": "책", "document": "# 1장\n본문", "voice_id": "narrator", "style": "calm",
+	}))
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, "calm", service.lastReq.Style)
+
+	var body map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&body)
+	assert.Equal(t, "job1", body["id"])
+	assert.Equal(t, "queued", body["status"])
+	assert.Equal(t, 0.25, body["progress"])
+
+	resp, _ = app.Test(jsonRequest(http.MethodPost, "/audiobooks", map[string]interface{}{"voice_id": "narrator"}))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/audiobooks", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	json.NewDecoder(resp.Body).Decode(&body)
+	assert.Len(t, body["audiobooks"], 1)
+}
+
+func TestAudiobookHandler_GetAndResume(t *testing.T) {
+	app, service := newAudiobookApp()
+	service.jobs["done"] = &domain.AudiobookJob{ID: "done", Status: domain.AudiobookCompleted}
+	service.jobs["failed"] = &domain.AudiobookJob{ID: "failed", Status: domain.AudiobookFailed}
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/audiobooks/done", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/audiobooks/missing", nil))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodPost, "/audiobooks/done/resume", nil))
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodPost, "/audiobooks/failed/resume", nil))
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+}
+
+func TestAudiobookHandler_GetFile(t *testing.T) {
+	app, service := newAudiobookApp()
+	service.files["job1/playlist.m3u"] = []byte("#EXTM3U\n")
+	service.files["job1/chapter_001.wav"] = []byte("WAVDATA")
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/audiobooks/job1/files/playlist.m3u", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "audio/x-mpegurl", resp.Header.Get("Content-Type"))
+	data, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "#EXTM3U\n", string(data))
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/audiobooks/job1/files/chapter_001.wav", nil))
+	assert.Equal(t, "audio/wav", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="chapter_001.wav"`, resp.Header.Get("Content-Disposition"))
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/audiobooks/job1/files/source.txt", nil))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
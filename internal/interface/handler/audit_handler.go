@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+)
+
+// defaultAuditLimit는 limit을 생략했을 때 반환할 최대 기록 수입니다.
+const defaultAuditLimit = 1000
+
+type AuditHandler struct {
+	AuditService domain.AuditService
+}
+
+func NewAuditHandler(auditService domain.AuditService) *AuditHandler {
+	return &AuditHandler{AuditService: auditService}
+}
+
+// QueryAudit은 /admin/audit GET 요청을 처리합니다.
+// user_id, from, to(RFC 3339 또는 날짜), limit 쿼리로 거르며, limit을 넘으면 가장 최근 기록만 반환합니다.
+func (h *AuditHandler) QueryAudit(c *fiber.Ctx) error {
+	q, err := parseAuditQuery(c)
+	if err != nil {
+		return auditError(c, err)
+	}
+	records, err := h.AuditService.Query(c.UserContext(), q)
+	if err != nil {
+		return auditError(c, err)
+	}
+	if records == nil {
+		records = []domain.AuditRecord{}
+	}
+	return c.Status(http.StatusOK).JSON(fiber.Map{"records": records})
+}
+
+// VerifyAudit은 /admin/audit/verify GET 요청을 처리합니다. 사슬이 끊어져 있어도 200과 함께 valid=false를 반환합니다.
+func (h *AuditHandler) VerifyAudit(c *fiber.Ctx) error {
+	result, err := h.AuditService.Verify(c.UserContext())
+	if err != nil {
+		return auditError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(result)
+}
+
+func parseAuditQuery(c *fiber.Ctx) (domain.AuditQuery, error) {
+	q := domain.AuditQuery{UserID: c.Query("user_id"), Limit: defaultAuditLimit}
+	var err error
+	if q.From, err = queryTime(c, "from"); err != nil {
+		return q, err
+	}
+	if q.To, err = queryTime(c, "to"); err != nil {
+		return q, err
+	}
+	if v := c.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return q, fmt.Errorf("%w: limit must be an integer", domain.ErrInvalidRequest)
+		}
+		q.Limit = limit
+	}
+	return q, nil
+}
+
+// queryTime은 RFC 3339 시각이나 날짜(2006-01-02, UTC 자정)로 된 쿼리 값을 읽습니다. 값이 없으면 zero time입니다.
+func queryTime(c *fiber.Ctx, name string) (time.Time, error) {
+	v := c.Query(name)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, v); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%w: %s must be an RFC 3339 time or a date (YYYY-MM-DD)", domain.ErrInvalidRequest, name)
+}
+
+func auditError(c *fiber.Ctx, err error) error {
+	status := http.StatusInternalServerError
+	if errors.Is(err, domain.ErrInvalidRequest) {
+		status = http.StatusBadRequest
+	}
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockAuditService struct {
+	query domain.AuditQuery
+}
+
+func (m *mockAuditService) Query(ctx context.Context, q domain.AuditQuery) ([]domain.AuditRecord, error) {
+	m.query = q
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidRequest)
+	}
+	return []domain.AuditRecord{{Seq: 1, UserID: q.UserID, Outcome: domain.AuditSuccess}}, nil
+}
+
+func (m *mockAuditService) Verify(ctx context.Context) (*domain.AuditVerification, error) {
+	return &domain.AuditVerification{Valid: false, Records: 4, File: "audit-000000000001.jsonl", Seq: 5, Message: "expected seq 5"}, nil
+}
+
+func TestAuditHandler_QueryAudit(t *testing.T) {
+	service := &mockAuditService{}
+	h := NewAuditHandler(service)
+	app := fiber.New()
+	app.Get("/admin/audit", h.QueryAudit)
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/admin/audit?user_id=alice&from=2026-10-19T09:00:00Z&to=2026-10-20T00:00:00%2B09:00", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var body struct{ Records []domain.AuditRecord }
+	json.NewDecoder(resp.Body).Decode(&body)
+	assert.Equal(t, "alice", body.Records[0].UserID)
+	assert.Equal(t, "alice", service.query.UserID)
+	assert.True(t, service.query.From.Equal(time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)))
+	assert.True(t, service.query.To.Equal(time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC)))
+	assert.Equal(t, defaultAuditLimit, service.query.Limit)
+
+	for _, query := range []string{"from=yesterday", "limit=ten", "from=2026-10-20T00:00:00Z&to=2026-10-19T00:00:00Z"} {
+		resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/admin/audit?"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+	}
+}
+
+func TestAuditHandler_VerifyAudit(t *testing.T) {
+	h := NewAuditHandler(&mockAuditService{})
+	app := fiber.New()
+	app.Get("/admin/audit/verify", h.VerifyAudit)
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/admin/audit/verify", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var result domain.AuditVerification
+	json.NewDecoder(resp.Body).Decode(&result)
+	assert.False(t, result.Valid)
+	assert.Equal(t, int64(5), result.Seq)
+}
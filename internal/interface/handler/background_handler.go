@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"tts_proxy/internal/domain"
+)
+
+type BackgroundHandler struct {
+	BackgroundService domain.BackgroundService
+}
+
+func NewBackgroundHandler(backgroundService domain.BackgroundService) *BackgroundHandler {
+	return &BackgroundHandler{BackgroundService: backgroundService}
+}
+
+// UploadBackground는 /admin/backgrounds/:name PUT 요청을 처리합니다.
+// 본문은 WAV 자체이거나, multipart/form-data의 file 필드입니다.
+func (h *BackgroundHandler) UploadBackground(c *fiber.Ctx) error {
+	wav := c.Body()
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		data, err := formFile(c, "file")
+		if err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "file field is required"})
+		}
+		wav = data
+	}
+
+	track, err := h.BackgroundService.Upload(c.UserContext(), utils.CopyString(c.Params("name")), wav)
+	if err != nil {
+		return backgroundError(c, err)
+	}
+	return c.Status(http.StatusCreated).JSON(track)
+}
+
+// ListBackgrounds는 /backgrounds, /admin/backgrounds GET 요청을 처리합니다.
+func (h *BackgroundHandler) ListBackgrounds(c *fiber.Ctx) error {
+	tracks, err := h.BackgroundService.List(c.UserContext())
+	if err != nil {
+		return backgroundError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(fiber.Map{"backgrounds": tracks})
+}
+
+// DeleteBackground는 /admin/backgrounds/:name DELETE 요청을 처리합니다.
+func (h *BackgroundHandler) DeleteBackground(c *fiber.Ctx) error {
+	if err := h.BackgroundService.Delete(c.UserContext(), c.Params("name")); err != nil {
+		return backgroundError(c, err)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// formFile은 multipart 요청에서 field 파일의 내용을 읽습니다.
+func formFile(c *fiber.Ctx, field string) ([]byte, error) {
+	header, err := c.FormFile(field)
+	if err != nil {
+		return nil, err
+	}
+	f, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func backgroundError(c *fiber.Ctx, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrInvalidBackground):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrBackgroundNotFound):
+		status = http.StatusNotFound
+	}
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockBackgroundService struct {
+	tracks map[string][]byte
+}
+
+func (m *mockBackgroundService) Upload(ctx context.Context, name string, wav []byte) (*domain.BackgroundTrack, error) {
+	if !bytes.HasPrefix(wav, []byte("RIFF")) {
+		return nil, domain.ErrInvalidBackground
+	}
+	m.tracks[name] = append([]byte(nil), wav...)
+	return &domain.BackgroundTrack{Name: name, Size: len(wav)}, nil
+}
+
+func (m *mockBackgroundService) List(ctx context.Context) ([]domain.BackgroundTrack, error) {
+	tracks := []domain.BackgroundTrack{}
+	for name, wav := range m.tracks {
+		tracks = append(tracks, domain.BackgroundTrack{Name: name, Size: len(wav)})
+	}
+	return tracks, nil
+}
+
+func (m *mockBackgroundService) Delete(ctx context.Context, name string) error {
+	if _, ok := m.tracks[name]; !ok {
+		return domain.ErrBackgroundNotFound
+	}
+	delete(m.tracks, name)
+	return nil
+}
+
+func newBackgroundApp() (*fiber.App, *mockBackgroundService) {
+	service := &mockBackgroundService{tracks: map[string][]byte{}}
+	h := NewBackgroundHandler(service)
+	app := fiber.New()
+	app.Get("/backgrounds", h.ListBackgrounds)
+	app.Put("/backgrounds/:name", h.UploadBackground)
+	app.Delete("/backgrounds/:name", h.DeleteBackground)
+	return app, service
+}
+
+func TestBackgroundHandler_RawUpload(t *testing.T) {
+	app, service := newBackgroundApp()
+
+	req := httptest.NewRequest(http.MethodPut, "/backgrounds/rain", bytes.NewReader([]byte("RIFFDATA")))
+	req.Header.Set("Content-Type", "audio/wav")
+	resp, _ := app.Test(req)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "RIFFDATA", string(service.tracks["rain"]))
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/backgrounds", nil))
+	var body struct {
+		Backgrounds []domain.BackgroundTrack `json:"backgrounds"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "rain", body.Backgrounds[0].Name)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodDelete, "/backgrounds/rain", nil))
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	resp, _ = app.Test(httptest.NewRequest(http.MethodDelete, "/backgrounds/rain", nil))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestBackgroundHandler_MultipartUpload(t *testing.T) {
+	app, service := newBackgroundApp()
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	part, _ := w.CreateFormFile("file", "piano.wav")
+	part.Write([]byte("RIFFPIANO"))
+	w.Close()
+	req := httptest.NewRequest(http.MethodPut, "/backgrounds/piano", &buf)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	resp, _ := app.Test(req)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "RIFFPIANO", string(service.tracks["piano"]))
+
+	// file 필드가 없으면 잘못된 요청
+	buf.Reset()
+	w = multipart.NewWriter(&buf)
+	w.WriteField("name", "piano")
+	w.Close()
+	req = httptest.NewRequest(http.MethodPut, "/backgrounds/piano", &buf)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	resp, _ = app.Test(req)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestBackgroundHandler_InvalidAudio(t *testing.T) {
+	app, _ := newBackgroundApp()
+	resp, _ := app.Test(httptest.NewRequest(http.MethodPut, "/backgrounds/noise", bytes.NewReader([]byte("MP3"))))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,59 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+)
+
+type DialogueHandler struct {
+	DialogueService domain.DialogueService
+}
+
+func NewDialogueHandler(dialogueService domain.DialogueService) *DialogueHandler {
+	return &DialogueHandler{DialogueService: dialogueService}
+}
+
+// HandleDialogue는 /dialogues POST 요청을 처리합니다. 믹스된 WAV와 타임라인 JSON을 multipart(기본) 또는 JSON으로 반환합니다.
+func (h *DialogueHandler) HandleDialogue(c *fiber.Ctx) error {
+	var req domain.DialogueRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+	switch req.Delivery {
+	case "", domain.DeliveryMultipart, domain.DeliveryJSON:
+	default:
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "unsupported delivery: " + req.Delivery})
+	}
+
+	result, err := h.DialogueService.Render(c.UserContext(), &req)
+	if errors.Is(err, domain.ErrInvalidRequest) || errors.Is(err, domain.ErrPresetNotFound) {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+	if errors.Is(err, domain.ErrUpstreamUnavailable) {
+		return c.Status(http.StatusServiceUnavailable).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	timeline := fiber.Map{"duration": result.Duration.Seconds(), "lines": result.Timeline}
+	if req.Delivery == domain.DeliveryJSON {
+		timeline["audio"] = base64.StdEncoding.EncodeToString(result.Audio)
+		timeline["content_type"] = "audio/wav"
+		return c.Status(http.StatusOK).JSON(timeline)
+	}
+
+	data, err := json.Marshal(timeline)
+	if err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return sendMultipart(c, []filePart{
+		{Name: "audio", Filename: "dialogue.wav", ContentType: "audio/wav", Data: result.Audio},
+		{Name: "timeline", Filename: "timeline.json", ContentType: "application/json", Data: data},
+	})
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockDialogueService struct {
+	RenderFunc func(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error)
+}
+
+func (m *mockDialogueService) Render(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error) {
+	return m.RenderFunc(ctx, req)
+}
+
+func dialogueApp(render func(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error)) *fiber.App {
+	app := fiber.New()
+	app.Post("/dialogues", NewDialogueHandler(&mockDialogueService{RenderFunc: render}).HandleDialogue)
+	return app
+}
+
+func renderOK(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error) {
+	return &domain.DialogueResult{
+		Audio:    []byte("WAVDATA"),
+		Duration: 1500 * time.Millisecond,
+		Timeline: []domain.DialogueTimelineEntry{
+			{Index: 0, Speaker: "A", VoiceID: "v1", Text: "Hello", Start: 0, End: 700 * time.Millisecond},
+			{Index: 1, Speaker: "B", VoiceID: "v2", Text: "Hi", Start: 900 * time.Millisecond, End: 1500 * time.Millisecond},
+		},
+	}, nil
+}
+
+func TestHandleDialogue_Multipart(t *testing.T) {
+	resp, _ := dialogueApp(renderOK).Test(jsonRequest(http.MethodPost, "/dialogues", domain.DialogueRequest{
+		Lines: []domain.DialogueLine{{Speaker: "A", VoiceID: "v1", Text: "Hello"}, {Speaker: "B", VoiceID: "v2", Text: "Hi"}},
+	}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	r := multipart.NewReader(resp.Body, params["boundary"])
+
+	audioPart, err := r.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "dialogue.wav", audioPart.FileName())
+
+	timelinePart, err := r.NextPart()
+	assert.NoError(t, err)
+	data, _ := io.ReadAll(timelinePart)
+	assert.JSONEq(t, `{"duration": 1.5, "lines": [
+		{"index": 0, "speaker": "A", "voice_id": "v1", "text": "Hello", "start": 0, "end": 0.7},
+		{"index": 1, "speaker": "B", "voice_id": "v2", "text": "Hi", "start": 0.9, "end": 1.5}
+	]}`, string(data))
+}
+
+func TestHandleDialogue_JSON(t *testing.T) {
+	resp, _ := dialogueApp(renderOK).Test(jsonRequest(http.MethodPost, "/dialogues", domain.DialogueRequest{
+		Lines:    []domain.DialogueLine{{Speaker: "A", VoiceID: "v1", Text: "Hello"}},
+		Delivery: domain.DeliveryJSON,
+	}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "V0FWREFUQQ==", body["audio"])
+	assert.Len(t, body["lines"], 2)
+}
+
+func TestHandleDialogue_Errors(t *testing.T) {
+	invalid := dialogueApp(func(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error) {
+		return nil, fmt.Errorf("%w: lines are required", domain.ErrInvalidRequest)
+	})
+	resp, _ := invalid.Test(jsonRequest(http.MethodPost, "/dialogues", domain.DialogueRequest{}))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = dialogueApp(renderOK).Test(jsonRequest(http.MethodPost, "/dialogues", domain.DialogueRequest{Delivery: "zip"}))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	failing := dialogueApp(func(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error) {
+		return nil, fmt.Errorf("line 0: upstream failed")
+	})
+	resp, _ = failing.Test(jsonRequest(http.MethodPost, "/dialogues", domain.DialogueRequest{}))
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	// 회로가 열려 있으면 503
+	unavailable := dialogueApp(func(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error) {
+		return nil, fmt.Errorf("line 0: %w", domain.ErrUpstreamUnavailable)
+	})
+	resp, _ = unavailable.Test(jsonRequest(http.MethodPost, "/dialogues", domain.DialogueRequest{}))
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+)
+
+type HealthHandler struct {
+	HealthService domain.HealthService
+}
+
+func NewHealthHandler(healthService domain.HealthService) *HealthHandler {
+	return &HealthHandler{HealthService: healthService}
+}
+
+// Healthz는 GET /healthz 요청을 처리합니다. 프로세스가 살아 있으면 200을 반환합니다.
+func (h *HealthHandler) Healthz(c *fiber.Ctx) error {
+	return sendHealthReport(c, h.HealthService.Live(c.UserContext()))
+}
+
+// Readyz는 GET /readyz 요청을 처리합니다. 트래픽을 받을 준비가 되지 않았으면 503을 반환합니다.
+func (h *HealthHandler) Readyz(c *fiber.Ctx) error {
+	return sendHealthReport(c, h.HealthService.Ready(c.UserContext()))
+}
+
+// DeepHealth는 GET /health/deep 요청을 처리합니다. 카나리 합성까지 확인합니다.
+func (h *HealthHandler) DeepHealth(c *fiber.Ctx) error {
+	return sendHealthReport(c, h.HealthService.Deep(c.UserContext()))
+}
+
+func sendHealthReport(c *fiber.Ctx, report *domain.HealthReport) error {
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	status := http.StatusOK
+	if !report.OK() {
+		status = http.StatusServiceUnavailable
+	}
+	return c.Status(status).JSON(report)
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockHealthService struct {
+	ready bool
+}
+
+func (m *mockHealthService) Live(ctx context.Context) *domain.HealthReport {
+	return &domain.HealthReport{Status: domain.HealthOK, Checks: []domain.HealthCheck{{Name: "process", Status: domain.HealthOK}}}
+}
+
+func (m *mockHealthService) Ready(ctx context.Context) *domain.HealthReport {
+	if m.ready {
+		return &domain.HealthReport{Status: domain.HealthOK}
+	}
+	return &domain.HealthReport{Status: domain.HealthFail, Checks: []domain.HealthCheck{
+		{Name: "circuit", Status: domain.HealthFail, Message: "upstream circuit is open"},
+	}}
+}
+
+func (m *mockHealthService) Deep(ctx context.Context) *domain.HealthReport {
+	return m.Ready(ctx)
+}
+
+func (m *mockHealthService) Drain() { m.ready = false }
+
+func TestHealthHandler(t *testing.T) {
+	service := &mockHealthService{}
+	h := NewHealthHandler(service)
+	app := fiber.New()
+	app.Get("/healthz", h.Healthz)
+	app.Get("/readyz", h.Readyz)
+	app.Get("/health/deep", h.DeepHealth)
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "no-store", resp.Header.Get("Cache-Control"))
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	var report map[string]any
+	json.NewDecoder(resp.Body).Decode(&report)
+	assert.Equal(t, "fail", report["status"])
+	assert.Equal(t, []any{map[string]any{"name": "circuit", "status": "fail", "message": "upstream circuit is open", "duration_ms": float64(0)}}, report["checks"])
+
+	service.ready = true
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/health/deep", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestHandleTTS_UpstreamUnavailable(t *testing.T) {
+	app := fiber.New()
+	handler := NewTTSHandler(&mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return nil, domain.ErrUpstreamUnavailable
+		},
+	}, &mockAuthService{})
+	app.Post("/tts/:voiceId", handler.HandleTTS)
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/tts/voice-123", domain.TTSRequest{Text: "hi"}))
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/pls"
+)
+
+const plsContentType = "application/pls+xml"
+
+type LexiconHandler struct {
+	LexiconService domain.LexiconService
+}
+
+func NewLexiconHandler(lexiconService domain.LexiconService) *LexiconHandler {
+	return &LexiconHandler{LexiconService: lexiconService}
+}
+
+// CreateLexicon은 /lexicons POST 요청을 처리합니다.
+func (h *LexiconHandler) CreateLexicon(c *fiber.Ctx) error {
+	var lexicon domain.Lexicon
+	if err := c.BodyParser(&lexicon); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	created, err := h.LexiconService.Create(c.UserContext(), lexicon)
+	if err != nil {
+		return lexiconError(c, err)
+	}
+	return c.Status(http.StatusCreated).JSON(created)
+}
+
+// ListLexicons는 /lexicons GET 요청을 처리합니다.
+func (h *LexiconHandler) ListLexicons(c *fiber.Ctx) error {
+	lexicons, err := h.LexiconService.List(c.UserContext())
+	if err != nil {
+		return lexiconError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(fiber.Map{"lexicons": lexicons})
+}
+
+// GetLexicon은 /lexicons/:name GET 요청을 처리합니다.
+func (h *LexiconHandler) GetLexicon(c *fiber.Ctx) error {
+	lexicon, err := h.LexiconService.Get(c.UserContext(), c.Params("name"))
+	if err != nil {
+		return lexiconError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(lexicon)
+}
+
+// PutLexicon은 /lexicons/:name PUT 요청을 처리합니다. 사전이 없으면 생성합니다.
+func (h *LexiconHandler) PutLexicon(c *fiber.Ctx) error {
+	var lexicon domain.Lexicon
+	if err := c.BodyParser(&lexicon); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+	lexicon.Name = utils.CopyString(c.Params("name")) // 저장되는 값이므로 요청 버퍼와 분리
+
+	saved, err := h.LexiconService.Put(c.UserContext(), lexicon)
+	if err != nil {
+		return lexiconError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(saved)
+}
+
+// DeleteLexicon은 /lexicons/:name DELETE 요청을 처리합니다.
+func (h *LexiconHandler) DeleteLexicon(c *fiber.Ctx) error {
+	if err := h.LexiconService.Delete(c.UserContext(), c.Params("name")); err != nil {
+		return lexiconError(c, err)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// ImportLexicon은 /lexicons/:name/import POST 요청을 처리합니다. 본문의 PLS XML로 사전을 생성하거나 교체합니다.
+func (h *LexiconHandler) ImportLexicon(c *fiber.Ctx) error {
+	doc, err := pls.Decode(bytes.NewReader(c.Body()))
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	lexicon, skipped := domain.LexiconFromPLS(utils.CopyString(c.Params("name")), doc)
+	saved, err := h.LexiconService.Put(c.UserContext(), lexicon)
+	if err != nil {
+		return lexiconError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(fiber.Map{"lexicon": saved, "skipped": skipped})
+}
+
+// ExportLexicon은 /lexicons/:name/export GET 요청을 처리합니다. 사전을 PLS XML로 반환합니다.
+func (h *LexiconHandler) ExportLexicon(c *fiber.Ctx) error {
+	lexicon, err := h.LexiconService.Get(c.UserContext(), c.Params("name"))
+	if err != nil {
+		return lexiconError(c, err)
+	}
+
+	var buf bytes.Buffer
+	if err := pls.Encode(&buf, domain.LexiconToPLS(*lexicon)); err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	c.Set("Content-Type", plsContentType)
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
+
+func lexiconError(c *fiber.Ctx, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrInvalidLexicon):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrLexiconNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrLexiconExists):
+		status = http.StatusConflict
+	}
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockLexiconService struct {
+	lexicons map[string]domain.Lexicon
+}
+
+func (m *mockLexiconService) Create(ctx context.Context, lexicon domain.Lexicon) (*domain.Lexicon, error) {
+	if _, ok := m.lexicons[lexicon.Name]; ok {
+		return nil, domain.ErrLexiconExists
+	}
+	return m.Put(ctx, lexicon)
+}
+
+func (m *mockLexiconService) Get(ctx context.Context, name string) (*domain.Lexicon, error) {
+	lexicon, ok := m.lexicons[name]
+	if !ok {
+		return nil, domain.ErrLexiconNotFound
+	}
+	return &lexicon, nil
+}
+
+func (m *mockLexiconService) List(ctx context.Context) ([]domain.Lexicon, error) {
+	lexicons := []domain.Lexicon{}
+	for _, l := range m.lexicons {
+		lexicons = append(lexicons, l)
+	}
+	return lexicons, nil
+}
+
+func (m *mockLexiconService) Put(ctx context.Context, lexicon domain.Lexicon) (*domain.Lexicon, error) {
+	if lexicon.Name == "" {
+		return nil, domain.ErrInvalidLexicon
+	}
+	m.lexicons[lexicon.Name] = lexicon
+	return &lexicon, nil
+}
+
+func (m *mockLexiconService) Delete(ctx context.Context, name string) error {
+	if _, ok := m.lexicons[name]; !ok {
+		return domain.ErrLexiconNotFound
+	}
+	delete(m.lexicons, name)
+	return nil
+}
+
+func newLexiconApp(service *mockLexiconService) *fiber.App {
+	app := fiber.New()
+	handler := NewLexiconHandler(service)
+	app.Post("/lexicons", handler.CreateLexicon)
+	app.Get("/lexicons", handler.ListLexicons)
+	app.Get("/lexicons/:name", handler.GetLexicon)
+	app.Put("/lexicons/:name", handler.PutLexicon)
+	app.Delete("/lexicons/:name", handler.DeleteLexicon)
+	app.Post("/lexicons/:name/import", handler.ImportLexicon)
+	app.Get("/lexicons/:name/export", handler.ExportLexicon)
+	return app
+}
+
+func TestLexiconHandler_CRUD(t *testing.T) {
+	app := newLexiconApp(&mockLexiconService{lexicons: map[string]domain.Lexicon{}})
+	lexicon := domain.Lexicon{Name: "brand", Entries: []domain.LexiconEntry{{Grapheme: "API", Replacement: "에이피아이"}}}
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/lexicons", lexicon))
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, _ = app.Test(jsonRequest(http.MethodPost, "/lexicons", lexicon))
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, _ = app.Test(jsonRequest(http.MethodPut, "/lexicons/units", domain.Lexicon{}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/lexicons", nil))
+	var body struct {
+		Lexicons []domain.Lexicon `json:"lexicons"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Len(t, body.Lexicons, 2)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodDelete, "/lexicons/brand", nil))
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/lexicons/brand", nil))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestLexiconHandler_ImportExport(t *testing.T) {
+	service := &mockLexiconService{lexicons: map[string]domain.Lexicon{}}
+	app := newLexiconApp(service)
+
+	doc := `<?xml version="1.0"?>
+<lexicon version="1.0" xmlns="http://www.w3.org/2005/01/pronunciation-lexicon" alphabet="ipa" xml:lang="ko">
+  <lexeme><grapheme>Supertone</grapheme><alias>수퍼톤</alias></lexeme>
+  <lexeme><grapheme>W3C</grapheme><phoneme>x</phoneme></lexeme>
+</lexicon>`
+	req := httptest.NewRequest(http.MethodPost, "/lexicons/brand/import", strings.NewReader(doc))
+	req.Header.Set("Content-Type", plsContentType)
+	resp, _ := app.Test(req)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Skipped int `json:"skipped"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, 1, body.Skipped)
+	assert.Equal(t, "수퍼톤", service.lexicons["brand"].Entries[0].Replacement)
+	assert.Equal(t, "ko", service.lexicons["brand"].Language)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/lexicons/brand/export", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, plsContentType, resp.Header.Get("Content-Type"))
+	exported, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(exported), "<grapheme>Supertone</grapheme>")
+	assert.Contains(t, string(exported), "<alias>수퍼톤</alias>")
+
+	req = httptest.NewRequest(http.MethodPost, "/lexicons/brand/import", bytes.NewReader([]byte("not xml")))
+	resp, _ = app.Test(req)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
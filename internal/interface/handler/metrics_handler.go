@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/pkg/metrics"
+)
+
+type MetricsHandler struct {
+	Registry *metrics.Registry
+}
+
+func NewMetricsHandler(registry *metrics.Registry) *MetricsHandler {
+	return &MetricsHandler{Registry: registry}
+}
+
+// ServeMetrics는 GET /metrics 요청에 Prometheus 텍스트 형식으로 지표를 응답합니다.
+func (h *MetricsHandler) ServeMetrics(c *fiber.Ctx) error {
+	c.Set(fiber.HeaderContentType, metrics.ContentType)
+	return h.Registry.WriteText(c.Response().BodyWriter())
+}
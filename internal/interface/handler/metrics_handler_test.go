@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/pkg/metrics"
+)
+
+func TestServeMetrics(t *testing.T) {
+	reg := metrics.NewRegistry()
+	reg.NewCounter("tts_proxy_test_total", "Test counter.", "voice").Add(3, "v1")
+
+	app := fiber.New()
+	app.Get("/metrics", NewMetricsHandler(reg).ServeMetrics)
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, metrics.ContentType, resp.Header.Get("Content-Type"))
+	body, _ := io.ReadAll(resp.Body)
+	assert.Contains(t, string(body), "# TYPE tts_proxy_test_total counter\ntts_proxy_test_total{voice=\"v1\"} 3\n")
+}
@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// filePart는 multipart/mixed 응답의 파트 하나입니다.
+type filePart struct {
+	Name        string
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// sendMultipart는 여러 파일을 하나의 multipart/mixed 응답으로 보냅니다.
+func sendMultipart(c *fiber.Ctx, parts []filePart) error {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for _, p := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", p.ContentType)
+		header.Set("Content-Disposition", fmt.Sprintf(`attachment; name=%q; filename=%q`, p.Name, p.Filename))
+		pw, err := w.CreatePart(header)
+		if err != nil {
+			return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+		if _, err := pw.Write(p.Data); err != nil {
+			return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		}
+	}
+	if err := w.Close(); err != nil {
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	c.Set("Content-Type", "multipart/mixed; boundary="+w.Boundary())
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
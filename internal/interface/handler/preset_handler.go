@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"tts_proxy/internal/domain"
+)
+
+type PresetHandler struct {
+	PresetService domain.PresetService
+}
+
+func NewPresetHandler(presetService domain.PresetService) *PresetHandler {
+	return &PresetHandler{PresetService: presetService}
+}
+
+// CreatePreset은 /presets POST 요청을 처리합니다.
+func (h *PresetHandler) CreatePreset(c *fiber.Ctx) error {
+	var preset domain.Preset
+	if err := c.BodyParser(&preset); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	created, err := h.PresetService.Create(c.UserContext(), preset)
+	if err != nil {
+		return presetError(c, err)
+	}
+	return c.Status(http.StatusCreated).JSON(created)
+}
+
+// ListPresets는 /presets GET 요청을 처리합니다.
+func (h *PresetHandler) ListPresets(c *fiber.Ctx) error {
+	presets, err := h.PresetService.List(c.UserContext())
+	if err != nil {
+		return presetError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(fiber.Map{"presets": presets})
+}
+
+// GetPreset은 /presets/:name GET 요청을 처리합니다.
+func (h *PresetHandler) GetPreset(c *fiber.Ctx) error {
+	preset, err := h.PresetService.Get(c.UserContext(), c.Params("name"))
+	if err != nil {
+		return presetError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(preset)
+}
+
+// UpdatePreset은 /presets/:name PUT 요청을 처리합니다.
+func (h *PresetHandler) UpdatePreset(c *fiber.Ctx) error {
+	var preset domain.Preset
+	if err := c.BodyParser(&preset); err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+	}
+
+	updated, err := h.PresetService.Update(c.UserContext(), utils.CopyString(c.Params("name")), preset)
+	if err != nil {
+		return presetError(c, err)
+	}
+	return c.Status(http.StatusOK).JSON(updated)
+}
+
+// DeletePreset은 /presets/:name DELETE 요청을 처리합니다.
+func (h *PresetHandler) DeletePreset(c *fiber.Ctx) error {
+	if err := h.PresetService.Delete(c.UserContext(), c.Params("name")); err != nil {
+		return presetError(c, err)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func presetError(c *fiber.Ctx, err error) error {
+	status := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domain.ErrInvalidPreset):
+		status = http.StatusBadRequest
+	case errors.Is(err, domain.ErrPresetNotFound):
+		status = http.StatusNotFound
+	case errors.Is(err, domain.ErrPresetExists):
+		status = http.StatusConflict
+	}
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
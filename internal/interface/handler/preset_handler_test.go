@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockPresetService struct {
+	presets map[string]domain.Preset
+}
+
+func (m *mockPresetService) Create(ctx context.Context, preset domain.Preset) (*domain.Preset, error) {
+	if preset.Name == "" {
+		return nil, domain.ErrInvalidPreset
+	}
+	if _, ok := m.presets[preset.Name]; ok {
+		return nil, domain.ErrPresetExists
+	}
+	m.presets[preset.Name] = preset
+	return &preset, nil
+}
+
+func (m *mockPresetService) Get(ctx context.Context, name string) (*domain.Preset, error) {
+	preset, ok := m.presets[name]
+	if !ok {
+		return nil, domain.ErrPresetNotFound
+	}
+	return &preset, nil
+}
+
+func (m *mockPresetService) List(ctx context.Context) ([]domain.Preset, error) {
+	presets := []domain.Preset{}
+	for _, p := range m.presets {
+		presets = append(presets, p)
+	}
+	return presets, nil
+}
+
+func (m *mockPresetService) Update(ctx context.Context, name string, preset domain.Preset) (*domain.Preset, error) {
+	if _, ok := m.presets[name]; !ok {
+		return nil, domain.ErrPresetNotFound
+	}
+	preset.Name = name
+	m.presets[name] = preset
+	return &preset, nil
+}
+
+func (m *mockPresetService) Delete(ctx context.Context, name string) error {
+	if _, ok := m.presets[name]; !ok {
+		return domain.ErrPresetNotFound
+	}
+	delete(m.presets, name)
+	return nil
+}
+
+func newPresetApp() *fiber.App {
+	app := fiber.New()
+	handler := NewPresetHandler(&mockPresetService{presets: map[string]domain.Preset{}})
+	app.Post("/presets", handler.CreatePreset)
+	app.Get("/presets", handler.ListPresets)
+	app.Get("/presets/:name", handler.GetPreset)
+	app.Put("/presets/:name", handler.UpdatePreset)
+	app.Delete("/presets/:name", handler.DeletePreset)
+	return app
+}
+
+func jsonRequest(method, target string, v interface{}) *http.Request {
+	body, _ := json.Marshal(v)
+	req := httptest.NewRequest(method, target, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestPresetHandler_CRUD(t *testing.T) {
+	app := newPresetApp()
+	preset := map[string]interface{}{"name": "calm", "voice_id": "v1", "style": "neutral", "voice_settings": map[string]interface{}{"speed": 0.9}}
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/presets", preset))
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp, _ = app.Test(jsonRequest(http.MethodPost, "/presets", preset))
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/presets/calm", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var got domain.Preset
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	assert.Equal(t, "neutral", got.Style)
+	assert.Equal(t, 0.9, got.VoiceSettings["speed"])
+
+	resp, _ = app.Test(jsonRequest(http.MethodPut, "/presets/calm", map[string]interface{}{"voice_id": "v2"}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodDelete, "/presets/calm", nil))
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/presets/calm", nil))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestPresetHandler_Invalid(t *testing.T) {
+	app := newPresetApp()
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/presets", map[string]interface{}{"voice_id": "v1"}))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/subtitle"
+)
+
+// maxCueRunes보다 긴 문장은 단어 경계에서 여러 큐로 나눕니다. (방송 자막 한 줄 권장 길이)
+const maxCueRunes = 42
+
+var subtitleContentTypes = map[string]string{
+	domain.SubtitleSRT:  "application/x-subrip",
+	domain.SubtitleVTT:  "text/vtt; charset=utf-8",
+	domain.SubtitleJSON: "application/json",
+}
+
+// sendWithSubtitles는 합성 오디오와 자막을 요청한 방식(multipart 또는 JSON+base64)으로 응답합니다.
+func sendWithSubtitles(c *fiber.Ctx, resp *domain.TTSResponse, opts *domain.SubtitleOptions) error {
+	subtitles, err := encodeSubtitles(opts.Format, resp.Timings)
+	if err != nil {
+		return ttsError(c, http.StatusInternalServerError, err.Error())
+	}
+
+	if opts.Delivery == domain.DeliveryJSON {
+		body := fiber.Map{
+			"audio":           base64.StdEncoding.EncodeToString(resp.Audio),
+			"content_type":    audioContentType(resp),
+			"subtitle_format": opts.Format,
+			"subtitles":       string(subtitles),
+		}
+		if opts.Format == domain.SubtitleJSON {
+			body["subtitles"] = json.RawMessage(subtitles)
+		}
+		return c.Status(http.StatusOK).JSON(body)
+	}
+
+	return sendMultipart(c, []filePart{
+		{Name: "audio", Filename: "speech." + resp.Format, ContentType: audioContentType(resp), Data: resp.Audio},
+		{Name: "subtitles", Filename: "speech." + opts.Format, ContentType: subtitleContentTypes[opts.Format], Data: subtitles},
+	})
+}
+
+func encodeSubtitles(format string, timings []domain.SentenceTiming) ([]byte, error) {
+	switch format {
+	case domain.SubtitleSRT:
+		return subtitle.EncodeSRT(subtitleCues(timings)), nil
+	case domain.SubtitleVTT:
+		return subtitle.EncodeVTT(subtitleCues(timings)), nil
+	case domain.SubtitleJSON:
+		if timings == nil {
+			timings = []domain.SentenceTiming{}
+		}
+		return json.Marshal(fiber.Map{"sentences": timings})
+	}
+	return nil, fmt.Errorf("unsupported subtitle format %q", format)
+}
+
+// subtitleCues는 문장마다 큐를 만들고, 긴 문장은 단어 타이밍을 이용해 maxCueRunes 이하의 큐로 나눕니다.
+func subtitleCues(timings []domain.SentenceTiming) []subtitle.Cue {
+	var cues []subtitle.Cue
+	for _, s := range timings {
+		if utf8.RuneCountInString(s.Text) <= maxCueRunes || len(s.Words) == 0 {
+			cues = append(cues, subtitle.Cue{Start: s.Start, End: s.End, Text: s.Text})
+			continue
+		}
+
+		var words []string
+		var cue subtitle.Cue
+		for _, w := range s.Words {
+			if len(words) > 0 && utf8.RuneCountInString(strings.Join(append(words, w.Text), " ")) > maxCueRunes {
+				cue.Text = strings.Join(words, " ")
+				cues = append(cues, cue)
+				words = nil
+			}
+			if len(words) == 0 {
+				cue.Start = w.Start
+			}
+			words = append(words, w.Text)
+			cue.End = w.End
+		}
+		cue.Text = strings.Join(words, " ")
+		cues = append(cues, cue)
+	}
+	return cues
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+func subtitleApp() *fiber.App {
+	app := fiber.New()
+	service := &mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav", Timings: []domain.SentenceTiming{{
+				Text:  "안녕하세요.",
+				Start: 100 * time.Millisecond,
+				End:   900 * time.Millisecond,
+				Words: []domain.WordTiming{{Text: "안녕하세요.", Start: 100 * time.Millisecond, End: 900 * time.Millisecond}},
+			}}}, nil
+		},
+	}
+	app.Post("/tts/:voiceId", NewTTSHandler(service, &mockAuthService{}).HandleTTS)
+	return app
+}
+
+func TestHandleTTS_SubtitlesMultipart(t *testing.T) {
+	resp, _ := subtitleApp().Test(jsonRequest(http.MethodPost, "/tts/voice-123", domain.TTSRequest{
+		Text:      "안녕하세요.",
+		Subtitles: &domain.SubtitleOptions{Format: domain.SubtitleSRT},
+	}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/mixed", mediaType)
+
+	r := multipart.NewReader(resp.Body, params["boundary"])
+	audioPart, err := r.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "audio/wav", audioPart.Header.Get("Content-Type"))
+	data, _ := io.ReadAll(audioPart)
+	assert.Equal(t, "WAVDATA", string(data))
+
+	subPart, err := r.NextPart()
+	assert.NoError(t, err)
+	assert.Equal(t, "speech.srt", subPart.FileName())
+	data, _ = io.ReadAll(subPart)
+	assert.Equal(t, "1\n00:00:00,100 --> 00:00:00,900\n안녕하세요.\n\n", string(data))
+}
+
+func TestHandleTTS_SubtitlesJSON(t *testing.T) {
+	resp, _ := subtitleApp().Test(jsonRequest(http.MethodPost, "/tts/voice-123", domain.TTSRequest{
+		Text:      "안녕하세요.",
+		Subtitles: &domain.SubtitleOptions{Format: domain.SubtitleJSON, Delivery: domain.DeliveryJSON},
+	}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Audio     string `json:"audio"`
+		Subtitles struct {
+			Sentences []struct {
+				Text  string  `json:"text"`
+				Start float64 `json:"start"`
+				End   float64 `json:"end"`
+				Words []struct {
+					Text string `json:"text"`
+				} `json:"words"`
+			} `json:"sentences"`
+		} `json:"subtitles"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	audio, _ := base64.StdEncoding.DecodeString(body.Audio)
+	assert.Equal(t, "WAVDATA", string(audio))
+	assert.Len(t, body.Subtitles.Sentences, 1)
+	assert.Equal(t, 0.1, body.Subtitles.Sentences[0].Start)
+	assert.Equal(t, 0.9, body.Subtitles.Sentences[0].End)
+	assert.Equal(t, "안녕하세요.", body.Subtitles.Sentences[0].Words[0].Text)
+}
+
+func TestHandleTTS_SubtitlesInvalidFormat(t *testing.T) {
+	resp, _ := subtitleApp().Test(jsonRequest(http.MethodPost, "/tts/voice-123", domain.TTSRequest{
+		Text:      "hi",
+		Subtitles: &domain.SubtitleOptions{Format: "ass"},
+	}))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSubtitleCues_SplitsLongSentences(t *testing.T) {
+	var words []domain.WordTiming
+	for i := 0; i < 12; i++ {
+		words = append(words, domain.WordTiming{Text: "word", Start: time.Duration(i) * time.Second, End: time.Duration(i+1) * time.Second})
+	}
+	text := strings.TrimSpace(strings.Repeat("word ", 12))
+
+	cues := subtitleCues([]domain.SentenceTiming{{Text: text, Start: 0, End: 12 * time.Second, Words: words}})
+	// "word" 8개 = 39자, 9개면 44자
+	assert.Len(t, cues, 2)
+	assert.Equal(t, strings.TrimSpace(strings.Repeat("word ", 8)), cues[0].Text)
+	assert.Equal(t, 8*time.Second, cues[0].End)
+	assert.Equal(t, 8*time.Second, cues[1].Start)
+	assert.Equal(t, "word word word word", cues[1].Text)
+}
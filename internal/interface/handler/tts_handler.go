@@ -1,15 +1,21 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"unicode/utf8"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
 	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/logging"
 )
 
 type TTSHandler struct {
 	TTSService  domain.TTSService
 	AuthService domain.AuthService // 현재는 목업/미사용
+	VoiceAliases domain.VoiceAliasRegistry // nil이면 별칭을 사용하지 않음
 }
 
 func NewTTSHandler(ttsService domain.TTSService, authService domain.AuthService) *TTSHandler {
@@ -21,22 +27,81 @@ func NewTTSHandler(ttsService domain.TTSService, authService domain.AuthService)
 
 // HandleTTS는 /tts/:voiceId POST 요청을 처리합니다.
 func (h *TTSHandler) HandleTTS(c *fiber.Ctx) error {
-	// URL 경로에서 voiceID 추출
-	voiceID := c.Params("voiceId")
-	if voiceID == "" {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "voice_id is required in URL path"})
-	}
-
 	var req domain.TTSRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid request"})
+		return ttsError(c, http.StatusBadRequest, "invalid request")
+	}
+	if req.Subtitles != nil {
+		if err := req.Subtitles.Validate(); err != nil {
+			return ttsError(c, http.StatusBadRequest, err.Error())
+		}
 	}
 
-	resp, err := h.TTSService.Synthesize(&req, voiceID)
+	// URL 경로에서 voiceID 추출 (프리셋을 지정하면 프리셋의 voice_id를 사용할 수 있음)
+	voiceID := utils.CopyString(c.Params("voiceId")) // 사용량, 감사 로그에 남으므로 요청 버퍼와 분리
+	if voiceID == "" && req.Preset == "" {
+		return ttsError(c, http.StatusBadRequest, "voice_id is required in URL path")
+	}
+
+	// 별칭이면 실제 Voice ID로 바꾸고, 클라이언트가 지정하지 않은 필드는 별칭 기본값으로 채움
+	if h.VoiceAliases != nil {
+		if alias, ok := h.VoiceAliases.Resolve(voiceID); ok {
+			voiceID = alias.VoiceID
+			req.ApplyDefaults(alias.Defaults)
+		}
+	}
+
+	ctx := logging.With(c.UserContext(), "voice_id", voiceID, "chars", utf8.RuneCountInString(req.Text))
+	c.SetUserContext(ctx) // 접근 로그에도 남도록 Fiber 컨텍스트에 저장
+	resp, err := h.TTSService.Synthesize(ctx, &req, voiceID)
+	if errors.Is(err, domain.ErrPresetNotFound) || errors.Is(err, domain.ErrInvalidRequest) {
+		return ttsError(c, http.StatusBadRequest, err.Error())
+	}
+	if errors.Is(err, domain.ErrUpstreamUnavailable) {
+		return ttsError(c, http.StatusServiceUnavailable, err.Error())
+	}
 	if err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return ttsError(c, http.StatusInternalServerError, err.Error())
+	}
+	if resp.RequestID != "" {
+		c.Set(domain.RequestIDHeader, resp.RequestID)
+		c.Set(domain.WatermarkedHeader, "true")
+	}
+
+	if req.Subtitles != nil {
+		return sendWithSubtitles(c, resp, req.Subtitles)
 	}
 
-	c.Set("Content-Type", "audio/wav")
+	c.Set("Content-Type", audioContentType(resp))
 	return c.Status(http.StatusOK).Send(resp.Audio)
-} 
\ No newline at end of file
+}
+
+// ttsError는 오류 JSON을 응답합니다. 요청 ID가 있으면 함께 담아 지원 문의를 로그와 대조할 수 있게 합니다.
+func ttsError(c *fiber.Ctx, status int, message string) error {
+	body := fiber.Map{"error": message}
+	if requestID := domain.RequestIDFromContext(c.UserContext()); requestID != "" {
+		body["request_id"] = requestID
+	}
+	return c.Status(status).JSON(body)
+}
+
+var audioMIMETypes = map[string]string{
+	domain.FormatWAV:   "audio/wav",
+	domain.FormatMP3:   "audio/mpeg",
+	domain.FormatFLAC:  "audio/flac",
+	domain.FormatPCM:   "audio/pcm",  // s16le
+	domain.FormatMuLaw: "audio/PCMU", // RFC 4856
+	domain.FormatALaw:  "audio/PCMA",
+}
+
+// audioContentType은 응답 형식의 MIME 타입을 반환합니다. 헤더가 없는 형식은 rate, channels 파라미터를 붙입니다.
+func audioContentType(resp *domain.TTSResponse) string {
+	mime, ok := audioMIMETypes[resp.Format]
+	if !ok {
+		return "application/octet-stream"
+	}
+	if resp.SampleRate > 0 {
+		mime += fmt.Sprintf(";rate=%d;channels=%d", resp.SampleRate, resp.Channels)
+	}
+	return mime
+}
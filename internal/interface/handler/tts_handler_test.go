@@ -2,7 +2,9 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -13,20 +15,21 @@ import (
 )
 
 type mockTTSService struct {
-	SynthesizeFunc func(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error)
+	SynthesizeFunc func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error)
 }
 
-func (m *mockTTSService) Synthesize(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
-	return m.SynthesizeFunc(req, voiceID)
+func (m *mockTTSService) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	return m.SynthesizeFunc(ctx, req, voiceID)
 }
 
 type mockAuthService struct{}
+
 func (m *mockAuthService) ValidateToken(token string) (string, error) { return "", nil }
 
 func TestHandleTTS_Success(t *testing.T) {
 	app := fiber.New()
 	mockService := &mockTTSService{
-		SynthesizeFunc: func(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
 			return &domain.TTSResponse{Audio: []byte("MP3DATA"), Format: "mp3"}, nil
 		},
 	}
@@ -81,4 +84,196 @@ func TestHandleTTS_MissingVoiceID(t *testing.T) {
 	resp, _ := app.Test(req)
 
 	assert.Equal(t, http.StatusNotFound, resp.StatusCode) // Fiber는 경로가 없으면 404 반환
-} 
\ No newline at end of file
+}
+
+type mockVoiceAliasRegistry map[string]domain.VoiceAlias
+
+func (m mockVoiceAliasRegistry) Resolve(name string) (*domain.VoiceAlias, bool) {
+	alias, ok := m[name]
+	return &alias, ok
+}
+
+func TestHandleTTS_VoiceAlias(t *testing.T) {
+	app := fiber.New()
+	var gotReq *domain.TTSRequest
+	var gotVoiceID string
+	mockService := &mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			gotReq, gotVoiceID = req, voiceID
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	handler := NewTTSHandler(mockService, &mockAuthService{})
+	handler.VoiceAliases = mockVoiceAliasRegistry{
+		"narrator": {
+			Name:    "narrator",
+			VoiceID: "voice-123",
+			Defaults: domain.TTSDefaults{
+				Language:      "ko",
+				Style:         "neutral",
+				Model:         "sona_speech_1",
+				VoiceSettings: map[string]interface{}{"speed": 0.9, "pitch_shift": 1.0},
+			},
+		},
+	}
+	app.Post("/tts/:voiceId", handler.HandleTTS)
+
+	body, _ := json.Marshal(domain.TTSRequest{
+		Text:          "hi",
+		Style:         "happy",
+		VoiceSettings: map[string]interface{}{"speed": 1.1},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/tts/narrator", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := app.Test(req)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "voice-123", gotVoiceID)
+	assert.Equal(t, "ko", gotReq.Language)
+	assert.Equal(t, "happy", gotReq.Style)
+	assert.Equal(t, "sona_speech_1", gotReq.Model)
+	assert.Equal(t, map[string]interface{}{"speed": 1.1, "pitch_shift": 1.0}, gotReq.VoiceSettings)
+}
+
+func TestHandleTTS_UnknownAliasPassesThrough(t *testing.T) {
+	app := fiber.New()
+	var gotVoiceID string
+	mockService := &mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			gotVoiceID = voiceID
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	handler := NewTTSHandler(mockService, &mockAuthService{})
+	handler.VoiceAliases = mockVoiceAliasRegistry{}
+	app.Post("/tts/:voiceId", handler.HandleTTS)
+
+	body, _ := json.Marshal(domain.TTSRequest{Text: "hi", Language: "en"})
+	req := httptest.NewRequest(http.MethodPost, "/tts/raw-voice-id", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ := app.Test(req)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "raw-voice-id", gotVoiceID)
+}
+
+func TestHandleTTS_PresetWithoutVoiceID(t *testing.T) {
+	app := fiber.New()
+	var gotReq *domain.TTSRequest
+	mockService := &mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			gotReq = req
+			if req.Preset == "missing" {
+				return nil, domain.ErrPresetNotFound
+			}
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	handler := NewTTSHandler(mockService, &mockAuthService{})
+	app.Post("/tts/:voiceId?", handler.HandleTTS)
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/tts", domain.TTSRequest{Text: "hi", Preset: "calm"}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "calm", gotReq.Preset)
+
+	resp, _ = app.Test(jsonRequest(http.MethodPost, "/tts", domain.TTSRequest{Text: "hi", Preset: "missing"}))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp, _ = app.Test(jsonRequest(http.MethodPost, "/tts", domain.TTSRequest{Text: "hi"}))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestHandleTTS_OutputFormatContentType(t *testing.T) {
+	for _, tc := range []struct {
+		resp domain.TTSResponse
+		want string
+	}{
+		{domain.TTSResponse{Format: "wav"}, "audio/wav"},
+		{domain.TTSResponse{Format: "flac"}, "audio/flac"},
+		{domain.TTSResponse{Format: "pcm", SampleRate: 24000, Channels: 1}, "audio/pcm;rate=24000;channels=1"},
+		{domain.TTSResponse{Format: "mulaw", SampleRate: 8000, Channels: 1}, "audio/PCMU;rate=8000;channels=1"},
+		{domain.TTSResponse{Format: "alaw", SampleRate: 8000, Channels: 1}, "audio/PCMA;rate=8000;channels=1"},
+	} {
+		app := fiber.New()
+		resp := tc.resp
+		resp.Audio = []byte("AUDIO")
+		handler := NewTTSHandler(&mockTTSService{
+			SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+				return &resp, nil
+			},
+		}, &mockAuthService{})
+		app.Post("/tts/:voiceId", handler.HandleTTS)
+
+		body, _ := json.Marshal(domain.TTSRequest{Text: "hi", OutputFormat: tc.resp.Format})
+		req := httptest.NewRequest(http.MethodPost, "/tts/voice-123", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		res, _ := app.Test(req)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, tc.want, res.Header.Get("Content-Type"))
+	}
+}
+
+func TestHandleTTS_WatermarkRequestID(t *testing.T) {
+	app := fiber.New()
+	handler := NewTTSHandler(&mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return &domain.TTSResponse{Audio: []byte("RIFF"), Format: "wav", RequestID: "0123456789abcdef"}, nil
+		},
+	}, &mockAuthService{})
+	app.Post("/tts/:voiceId", handler.HandleTTS)
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/tts/voice-123", domain.TTSRequest{
+		Text: "hi", PostProcessing: &domain.PostProcessingOptions{Watermark: true},
+	}))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "0123456789abcdef", resp.Header.Get(domain.RequestIDHeader))
+	assert.Equal(t, "true", resp.Header.Get(domain.WatermarkedHeader))
+}
+
+func TestHandleTTS_ErrorIncludesRequestID(t *testing.T) {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.SetUserContext(domain.WithRequestID(c.UserContext(), "req-123"))
+		return c.Next()
+	})
+	handler := NewTTSHandler(&mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return nil, errors.New("TTS API error: 503 Service Unavailable")
+		},
+	}, &mockAuthService{})
+	app.Post("/tts/:voiceId", handler.HandleTTS)
+
+	resp, _ := app.Test(jsonRequest(http.MethodPost, "/tts/voice-123", domain.TTSRequest{Text: "hi"}))
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	var body map[string]string
+	json.NewDecoder(resp.Body).Decode(&body)
+	assert.Equal(t, map[string]string{"error": "TTS API error: 503 Service Unavailable", "request_id": "req-123"}, body)
+
+	req := httptest.NewRequest(http.MethodPost, "/tts/voice-123", bytes.NewReader([]byte("notjson")))
+	req.Header.Set("Content-Type", "application/json")
+	resp, _ = app.Test(req)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	json.NewDecoder(resp.Body).Decode(&body)
+	assert.Equal(t, "req-123", body["request_id"])
+}
+
+func TestHandleTTS_VoiceIDOutlivesRequest(t *testing.T) {
+	app := fiber.New()
+	// 사용량 집계처럼 요청이 끝난 뒤에도 Voice ID를 키로 보관
+	requests := map[string]int{}
+	mockService := &mockTTSService{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			requests[voiceID]++
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	handler := NewTTSHandler(mockService, &mockAuthService{})
+	app.Post("/tts/:voiceId", handler.HandleTTS)
+
+	for _, voiceID := range []string{"voice-ccaaaaaa", "vo", "voice-b", "vo", "voice-ccaaaaaa"} {
+		resp, _ := app.Test(jsonRequest(http.MethodPost, "/tts/"+voiceID, domain.TTSRequest{Text: "hi"}))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	assert.Equal(t, map[string]int{"voice-ccaaaaaa": 2, "vo": 2, "voice-b": 1}, requests)
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+)
+
+type UsageHandler struct {
+	UsageService domain.UsageService
+	now          func() time.Time
+}
+
+func NewUsageHandler(usageService domain.UsageService) *UsageHandler {
+	return &UsageHandler{UsageService: usageService, now: time.Now}
+}
+
+// GetUsage는 /admin/usage GET 요청을 처리합니다.
+// from, to(RFC 3339 또는 날짜)를 생략하면 이번 달 1일(UTC)부터 지금까지이고, group_by는 user, voice, model 중 하나(기본 user)입니다.
+// format=csv이거나 Accept가 text/csv이면 CSV로 응답합니다.
+func (h *UsageHandler) GetUsage(c *fiber.Ctx) error {
+	q := domain.UsageQuery{GroupBy: c.Query("group_by", domain.UsageByUser)}
+	var err error
+	if q.From, err = queryTime(c, "from"); err != nil {
+		return usageError(c, err)
+	}
+	if q.To, err = queryTime(c, "to"); err != nil {
+		return usageError(c, err)
+	}
+	if q.To.IsZero() {
+		q.To = h.now().UTC()
+	}
+	if q.From.IsZero() {
+		q.From = time.Date(q.To.Year(), q.To.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	report, err := h.UsageService.Report(c.UserContext(), q)
+	if err != nil {
+		return usageError(c, err)
+	}
+	if c.Query("format") == "csv" || strings.Contains(c.Get(fiber.HeaderAccept), "text/csv") {
+		return sendUsageCSV(c, report)
+	}
+	return c.Status(http.StatusOK).JSON(report)
+}
+
+// sendUsageCSV는 보고서의 행을 CSV로 보냅니다. 첫 열 이름은 group_by 값입니다.
+func sendUsageCSV(c *fiber.Ctx, report *domain.UsageReport) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{report.GroupBy, "requests", "characters", "audio_seconds", "estimated_cost"})
+	for _, row := range report.Rows {
+		w.Write([]string{
+			row.Key,
+			strconv.FormatInt(row.Requests, 10),
+			strconv.FormatInt(row.Characters, 10),
+			strconv.FormatFloat(row.AudioSeconds, 'f', 3, 64),
+			strconv.FormatFloat(row.EstimatedCost, 'f', 4, 64),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return err
+	}
+
+	filename := fmt.Sprintf("usage-%s-%s-by-%s.csv", report.From.Format("20060102"), report.To.Format("20060102"), report.GroupBy)
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	return c.Status(http.StatusOK).Send(buf.Bytes())
+}
+
+func usageError(c *fiber.Ctx, err error) error {
+	status := http.StatusInternalServerError
+	if errors.Is(err, domain.ErrInvalidRequest) {
+		status = http.StatusBadRequest
+	}
+	return c.Status(status).JSON(fiber.Map{"error": err.Error()})
+}
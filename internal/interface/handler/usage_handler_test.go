@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockUsageService struct {
+	query domain.UsageQuery
+}
+
+func (m *mockUsageService) Report(ctx context.Context, q domain.UsageQuery) (*domain.UsageReport, error) {
+	m.query = q
+	if q.GroupBy != domain.UsageByUser && q.GroupBy != domain.UsageByVoice && q.GroupBy != domain.UsageByModel {
+		return nil, fmt.Errorf("%w: group_by must be user, voice or model", domain.ErrInvalidRequest)
+	}
+	return &domain.UsageReport{
+		From: q.From, To: q.To, GroupBy: q.GroupBy, Currency: "USD",
+		Rows: []domain.UsageRow{
+			{Key: "alice", Requests: 3, Characters: 3000, AudioSeconds: 90, EstimatedCost: 0.51},
+			{Key: "bob, inc", Requests: 1, Characters: 500, AudioSeconds: 15.25, EstimatedCost: 0.1},
+		},
+		Total: domain.UsageRow{Requests: 4, Characters: 3500, AudioSeconds: 105.25, EstimatedCost: 0.61},
+	}, nil
+}
+
+func newUsageApp(service domain.UsageService) *fiber.App {
+	h := NewUsageHandler(service)
+	h.now = func() time.Time { return time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC) }
+	app := fiber.New()
+	app.Get("/admin/usage", h.GetUsage)
+	return app
+}
+
+func TestUsageHandler_JSON(t *testing.T) {
+	service := &mockUsageService{}
+	app := newUsageApp(service)
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/admin/usage", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var report domain.UsageReport
+	json.NewDecoder(resp.Body).Decode(&report)
+	assert.Equal(t, "user", report.GroupBy)
+	assert.Len(t, report.Rows, 2)
+	// 기본 기간은 이번 달 1일부터 지금까지
+	assert.Equal(t, time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), service.query.From)
+	assert.Equal(t, time.Date(2026, 10, 19, 9, 30, 0, 0, time.UTC), service.query.To)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/admin/usage?from=2026-09-01&to=2026-10-01T00:00:00Z&group_by=model", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "model", service.query.GroupBy)
+	assert.Equal(t, time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC), service.query.From)
+
+	for _, query := range []string{"group_by=region", "from=last-month"} {
+		resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/admin/usage?"+query, nil))
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, query)
+	}
+}
+
+func TestUsageHandler_CSV(t *testing.T) {
+	app := newUsageApp(&mockUsageService{})
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/admin/usage?from=2026-09-01&to=2026-10-01&group_by=user&format=csv", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+	assert.Equal(t, `attachment; filename="usage-20260901-20261001-by-user.csv"`, resp.Header.Get("Content-Disposition"))
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "user,requests,characters,audio_seconds,estimated_cost\n"+
+		"alice,3,3000,90.000,0.5100\n"+
+		"\"bob, inc\",1,500,15.250,0.1000\n", string(body))
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/usage", nil)
+	req.Header.Set("Accept", "text/csv")
+	resp, _ = app.Test(req)
+	assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+}
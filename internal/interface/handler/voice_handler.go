@@ -0,0 +1,48 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+)
+
+type VoiceHandler struct {
+	VoiceService domain.VoiceService
+}
+
+func NewVoiceHandler(voiceService domain.VoiceService) *VoiceHandler {
+	return &VoiceHandler{VoiceService: voiceService}
+}
+
+// ListVoices는 /voices GET 요청을 처리합니다. language, gender, age, style, model 쿼리로 필터링합니다.
+func (h *VoiceHandler) ListVoices(c *fiber.Ctx) error {
+	filter := domain.VoiceFilter{
+		Language: c.Query("language"),
+		Gender:   c.Query("gender"),
+		Age:      c.Query("age"),
+		Style:    c.Query("style"),
+		Model:    c.Query("model"),
+	}
+
+	voices, err := h.VoiceService.ListVoices(c.UserContext(), filter)
+	if err != nil {
+		return c.Status(http.StatusBadGateway).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{"voices": voices, "total": len(voices)})
+}
+
+// GetVoice는 /voices/:id GET 요청을 처리합니다.
+func (h *VoiceHandler) GetVoice(c *fiber.Ctx) error {
+	voice, err := h.VoiceService.GetVoice(c.UserContext(), c.Params("id"))
+	if errors.Is(err, domain.ErrVoiceNotFound) {
+		return c.Status(http.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	}
+	if err != nil {
+		return c.Status(http.StatusBadGateway).JSON(fiber.Map{"error": err.Error()})
+	}
+
+	return c.Status(http.StatusOK).JSON(voice)
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockVoiceService struct {
+	ListVoicesFunc func(ctx context.Context, filter domain.VoiceFilter) ([]domain.Voice, error)
+	GetVoiceFunc   func(ctx context.Context, id string) (*domain.Voice, error)
+}
+
+func (m *mockVoiceService) ListVoices(ctx context.Context, filter domain.VoiceFilter) ([]domain.Voice, error) {
+	return m.ListVoicesFunc(ctx, filter)
+}
+
+func (m *mockVoiceService) GetVoice(ctx context.Context, id string) (*domain.Voice, error) {
+	return m.GetVoiceFunc(ctx, id)
+}
+
+func TestListVoices_Filter(t *testing.T) {
+	app := fiber.New()
+	var got domain.VoiceFilter
+	handler := NewVoiceHandler(&mockVoiceService{
+		ListVoicesFunc: func(ctx context.Context, filter domain.VoiceFilter) ([]domain.Voice, error) {
+			got = filter
+			return []domain.Voice{{ID: "v1", Name: "Agatha"}}, nil
+		},
+	})
+	app.Get("/voices", handler.ListVoices)
+
+	req := httptest.NewRequest(http.MethodGet, "/voices?language=ko&gender=female&style=neutral", nil)
+	resp, _ := app.Test(req)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, domain.VoiceFilter{Language: "ko", Gender: "female", Style: "neutral"}, got)
+
+	var body struct {
+		Voices []domain.Voice `json:"voices"`
+		Total  int            `json:"total"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, 1, body.Total)
+	assert.Equal(t, "v1", body.Voices[0].ID)
+}
+
+func TestListVoices_UpstreamError(t *testing.T) {
+	app := fiber.New()
+	handler := NewVoiceHandler(&mockVoiceService{
+		ListVoicesFunc: func(ctx context.Context, filter domain.VoiceFilter) ([]domain.Voice, error) {
+			return nil, errors.New("upstream down")
+		},
+	})
+	app.Get("/voices", handler.ListVoices)
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/voices", nil))
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+}
+
+func TestGetVoice(t *testing.T) {
+	app := fiber.New()
+	handler := NewVoiceHandler(&mockVoiceService{
+		GetVoiceFunc: func(ctx context.Context, id string) (*domain.Voice, error) {
+			if id == "v1" {
+				return &domain.Voice{ID: "v1", Name: "Agatha"}, nil
+			}
+			return nil, domain.ErrVoiceNotFound
+		},
+	})
+	app.Get("/voices/:id", handler.GetVoice)
+
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/voices/v1", nil))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/voices/missing", nil))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
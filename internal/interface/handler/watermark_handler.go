@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"tts_proxy/internal/domain"
+)
+
+type WatermarkHandler struct {
+	WatermarkService domain.WatermarkService
+}
+
+func NewWatermarkHandler(watermarkService domain.WatermarkService) *WatermarkHandler {
+	return &WatermarkHandler{WatermarkService: watermarkService}
+}
+
+// DetectWatermark는 /watermark/detect POST 요청을 처리합니다.
+// 본문은 WAV 자체이거나 multipart/form-data의 file 필드이며, user_id(쿼리 또는 폼 필드)를 주면 사용자 지문 일치 여부도 확인합니다.
+func (h *WatermarkHandler) DetectWatermark(c *fiber.Ctx) error {
+	wav := c.Body()
+	userID := c.Query("user_id")
+	if strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEMultipartForm) {
+		data, err := formFile(c, "file")
+		if err != nil {
+			return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "file field is required"})
+		}
+		wav = data
+		if v := c.FormValue("user_id"); v != "" {
+			userID = v
+		}
+	}
+
+	detection, err := h.WatermarkService.Detect(c.UserContext(), wav, userID)
+	switch {
+	case errors.Is(err, domain.ErrInvalidRequest):
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": err.Error()})
+	case errors.Is(err, domain.ErrWatermarkNotFound):
+		return c.Status(http.StatusNotFound).JSON(fiber.Map{"error": err.Error()})
+	case err != nil:
+		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+	}
+	return c.Status(http.StatusOK).JSON(detection)
+}
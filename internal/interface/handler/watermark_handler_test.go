@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockWatermarkService struct {
+	userID string
+}
+
+func (m *mockWatermarkService) Detect(ctx context.Context, wav []byte, userID string) (*domain.WatermarkDetection, error) {
+	m.userID = userID
+	switch string(wav) {
+	case "RIFFMARKED":
+		return &domain.WatermarkDetection{RequestID: "0123456789abcdef", UserFingerprint: "deadbeef", Confidence: 1, Copies: 2}, nil
+	case "RIFFCLEAN":
+		return nil, domain.ErrWatermarkNotFound
+	}
+	return nil, domain.ErrInvalidRequest
+}
+
+func newWatermarkApp() (*fiber.App, *mockWatermarkService) {
+	service := &mockWatermarkService{}
+	app := fiber.New()
+	app.Post("/watermark/detect", NewWatermarkHandler(service).DetectWatermark)
+	return app, service
+}
+
+func TestDetectWatermark_Raw(t *testing.T) {
+	app, service := newWatermarkApp()
+
+	req := httptest.NewRequest(http.MethodPost, "/watermark/detect?user_id=alice", bytes.NewReader([]byte("RIFFMARKED")))
+	req.Header.Set("Content-Type", "audio/wav")
+	resp, _ := app.Test(req)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "alice", service.userID)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "0123456789abcdef", body["request_id"])
+	assert.Equal(t, "deadbeef", body["user_fingerprint"])
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodPost, "/watermark/detect", bytes.NewReader([]byte("RIFFCLEAN"))))
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodPost, "/watermark/detect", bytes.NewReader([]byte("MP3"))))
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestDetectWatermark_Multipart(t *testing.T) {
+	app, service := newWatermarkApp()
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	w.WriteField("user_id", "bob")
+	part, _ := w.CreateFormFile("file", "leak.wav")
+	part.Write([]byte("RIFFMARKED"))
+	w.Close()
+	req := httptest.NewRequest(http.MethodPost, "/watermark/detect", &buf)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	resp, _ := app.Test(req)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "bob", service.userID)
+}
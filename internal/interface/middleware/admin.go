@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminKeyHeader는 관리자 API 키를 담는 요청 헤더입니다.
+const AdminKeyHeader = "X-Admin-Key"
+
+type AdminMiddleware struct {
+	Key string
+}
+
+// NewAdminMiddleware는 key가 비어 있으면 모든 관리자 요청을 거부하는 미들웨어를 생성합니다.
+func NewAdminMiddleware(key string) *AdminMiddleware {
+	return &AdminMiddleware{Key: key}
+}
+
+// Handle은 X-Admin-Key 헤더가 관리자 키와 일치하는 요청만 통과시킵니다.
+func (m *AdminMiddleware) Handle(c *fiber.Ctx) error {
+	if m.Key == "" {
+		return c.Status(http.StatusForbidden).JSON(fiber.Map{"error": "admin API is disabled"})
+	}
+	if subtle.ConstantTimeCompare([]byte(c.Get(AdminKeyHeader)), []byte(m.Key)) != 1 {
+		return c.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": "invalid admin key"})
+	}
+	return c.Next()
+}
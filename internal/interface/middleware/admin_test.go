@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAdminApp(key string) *fiber.App {
+	app := fiber.New()
+	app.Use(NewAdminMiddleware(key).Handle)
+	app.Get("/admin", func(c *fiber.Ctx) error { return c.SendString("ok") })
+	return app
+}
+
+func adminRequest(key string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/admin", nil)
+	if key != "" {
+		req.Header.Set(AdminKeyHeader, key)
+	}
+	return req
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	resp, _ := newAdminApp("secret").Test(adminRequest("secret"))
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, _ = newAdminApp("secret").Test(adminRequest("wrong"))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	resp, _ = newAdminApp("secret").Test(adminRequest(""))
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestAdminMiddleware_Disabled(t *testing.T) {
+	resp, _ := newAdminApp("").Test(adminRequest(""))
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
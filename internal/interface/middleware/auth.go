@@ -1,8 +1,12 @@
 package middleware
 
 import (
+	"net/http"
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
 	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/logging"
 )
 
 type AuthMiddleware struct {
@@ -13,11 +17,19 @@ func NewAuthMiddleware(authService domain.AuthService) *AuthMiddleware {
 	return &AuthMiddleware{AuthService: authService}
 }
 
-// Handle은 인증 미들웨어(목업)로, 실제 인증은 미구현입니다.
+// Handle은 Authorization 헤더가 있으면 토큰을 검증하고 사용자 ID를 요청 컨텍스트에 저장합니다.
+// 헤더가 없는 요청은 익명 사용자로 통과시킵니다. 감사 기록을 위해 클라이언트 IP도 함께 저장합니다.
 func (m *AuthMiddleware) Handle(c *fiber.Ctx) error {
-	// 향후: Authorization 헤더에서 토큰 추출 및 검증
-	// token := c.Get("Authorization")
-	// userID, err := m.AuthService.ValidateToken(token)
-	// if err != nil { ... }
+	c.SetUserContext(domain.WithClientIP(c.UserContext(), c.IP()))
+	token := strings.TrimSpace(strings.TrimPrefix(c.Get(fiber.HeaderAuthorization), "Bearer "))
+	if token == "" {
+		return c.Next()
+	}
+
+	userID, err := m.AuthService.ValidateToken(token)
+	if err != nil {
+		return c.Status(http.StatusUnauthorized).JSON(fiber.Map{"error": "invalid token"})
+	}
+	c.SetUserContext(logging.With(domain.WithUserID(c.UserContext(), userID), "user_id", userID))
 	return c.Next()
-} 
\ No newline at end of file
+}
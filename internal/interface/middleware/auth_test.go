@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockAuthService struct{}
+
+func (m *mockAuthService) ValidateToken(token string) (string, error) {
+	if token == "good-token" {
+		return "alice", nil
+	}
+	return "", errors.New("invalid token")
+}
+
+func newAuthApp() *fiber.App {
+	app := fiber.New()
+	app.Use(NewAuthMiddleware(&mockAuthService{}).Handle)
+	app.Get("/whoami", func(c *fiber.Ctx) error {
+		return c.SendString(domain.UserIDFromContext(c.UserContext()))
+	})
+	return app
+}
+
+func TestAuthMiddleware_ValidToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set("Authorization", "Bearer good-token")
+	resp, _ := newAuthApp().Test(req)
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body := make([]byte, 5)
+	resp.Body.Read(body)
+	assert.Equal(t, "alice", string(body))
+}
+
+func TestAuthMiddleware_Anonymous(t *testing.T) {
+	resp, _ := newAuthApp().Test(httptest.NewRequest(http.MethodGet, "/whoami", nil))
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAuthMiddleware_ClientIP(t *testing.T) {
+	app := fiber.New()
+	app.Use(NewAuthMiddleware(&mockAuthService{}).Handle)
+	app.Get("/ip", func(c *fiber.Ctx) error {
+		return c.SendString(domain.ClientIPFromContext(c.UserContext()))
+	})
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/ip", nil))
+
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "0.0.0.0", string(body))
+}
+
+func TestAuthMiddleware_InvalidToken(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/whoami", nil)
+	req.Header.Set("Authorization", "Bearer bad-token")
+	resp, _ := newAuthApp().Test(req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type LoggingMiddleware struct {
+	Logger *slog.Logger
+}
+
+// NewLoggingMiddleware는 요청마다 접근 로그를 남기는 미들웨어를 생성합니다. logger가 nil이면 slog.Default()를 사용합니다.
+func NewLoggingMiddleware(logger *slog.Logger) *LoggingMiddleware {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &LoggingMiddleware{Logger: logger}
+}
+
+// Handle은 응답 후 상태 코드와 처리 시간을 접근 로그로 기록합니다. 앞선 미들웨어와 핸들러가 logging.With로
+// 추가한 필드(request_id, user_id, voice_id 등)도 접근 로그에 함께 남습니다.
+func (m *LoggingMiddleware) Handle(c *fiber.Ctx) error {
+	start := time.Now()
+	err := c.Next()
+
+	status := responseStatus(c, err)
+	level := slog.LevelInfo
+	if status >= fiber.StatusInternalServerError {
+		level = slog.LevelError
+	}
+	m.Logger.LogAttrs(c.UserContext(), level, "request",
+		slog.String("method", c.Method()),
+		slog.String("path", c.Path()),
+		slog.String("route", c.Route().Path),
+		slog.Int("status", status),
+		slog.Duration("latency", time.Since(start)),
+		slog.Int("bytes", len(c.Response().Body())),
+	)
+	return err
+}
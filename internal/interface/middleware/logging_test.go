@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/logging"
+)
+
+func TestLoggingMiddleware(t *testing.T) {
+	var buf bytes.Buffer
+	logger, _ := logging.New(&buf, logging.Options{})
+
+	app := fiber.New()
+	app.Use(NewRequestIDMiddleware().Handle)
+	app.Use(NewLoggingMiddleware(logger).Handle)
+	app.Use(NewAuthMiddleware(&mockAuthService{}).Handle)
+	var requestID string
+	app.Get("/voices/:id", func(c *fiber.Ctx) error {
+		requestID = domain.RequestIDFromContext(c.UserContext())
+		c.SetUserContext(logging.With(c.UserContext(), "voice_id", c.Params("id")))
+		logger.InfoContext(c.UserContext(), "inside handler")
+		return c.SendString("ok")
+	})
+	app.Get("/boom", func(c *fiber.Ctx) error {
+		return fiber.NewError(http.StatusServiceUnavailable, "upstream down")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/voices/v1", nil)
+	req.Header.Set("Authorization", "Bearer good-token")
+	resp, _ := app.Test(req)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, requestID, 16)
+
+	resp, _ = app.Test(httptest.NewRequest(http.MethodGet, "/boom", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	var lines []map[string]any
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var line map[string]any
+		require.NoError(t, dec.Decode(&line))
+		lines = append(lines, line)
+	}
+	require.Len(t, lines, 3)
+
+	assert.Equal(t, "inside handler", lines[0]["msg"])
+	assert.Equal(t, requestID, lines[0]["request_id"])
+	assert.Equal(t, "alice", lines[0]["user_id"])
+
+	access := lines[1]
+	assert.Equal(t, "request", access["msg"])
+	assert.Equal(t, requestID, access["request_id"])
+	assert.Equal(t, "alice", access["user_id"])
+	assert.Equal(t, "v1", access["voice_id"])
+	assert.Equal(t, "/voices/:id", access["route"])
+	assert.Equal(t, float64(200), access["status"])
+	assert.Contains(t, access, "latency")
+
+	assert.Equal(t, "ERROR", lines[2]["level"])
+	assert.Equal(t, float64(503), lines[2]["status"])
+	assert.NotEqual(t, requestID, lines[2]["request_id"])
+}
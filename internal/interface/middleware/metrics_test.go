@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/pkg/metrics"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	m := NewMetricsMiddleware(metrics.NewRegistry())
+	app := fiber.New()
+	app.Use(m.Handle)
+	app.Post("/tts/:voiceId", func(c *fiber.Ctx) error {
+		assert.Equal(t, 1.0, m.inFlight.Value())
+		c.Set(fiber.HeaderContentType, "audio/pcm;rate=24000;channels=1")
+		return c.Send([]byte("AUDIODATA"))
+	})
+	app.Get("/fail", func(c *fiber.Ctx) error { return errors.New("boom") })
+
+	for _, voice := range []string{"v1", "v2"} {
+		resp, _ := app.Test(httptest.NewRequest(http.MethodPost, "/tts/"+voice, nil))
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	app.Test(httptest.NewRequest(http.MethodGet, "/fail", nil))
+	app.Test(httptest.NewRequest(http.MethodGet, "/nope", nil))
+
+	assert.Equal(t, 2.0, m.requests.Value("POST", "/tts/:voiceId", "200")) // 경로 파라미터별로 나뉘지 않음
+	assert.Equal(t, 2.0, m.duration.Count("POST", "/tts/:voiceId", "200"))
+	assert.Equal(t, 1.0, m.requests.Value("GET", "/fail", "500"))
+	assert.Equal(t, 1.0, m.requests.Value("GET", "unmatched", "404"))
+	assert.Equal(t, 18.0, m.audioSent.Value("audio/pcm"))
+	assert.Equal(t, 0.0, m.inFlight.Value())
+}
+
+func TestMetricsMiddleware_MethodLabelOutlivesRequest(t *testing.T) {
+	reg := metrics.NewRegistry()
+	app := fiber.New()
+	app.Use(NewMetricsMiddleware(reg).Handle)
+	app.Put("/presets/:name", func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) })
+	app.Delete("/presets/:name", func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) })
+
+	app.Test(httptest.NewRequest(http.MethodPut, "/presets/calm", nil))
+	app.Test(httptest.NewRequest(http.MethodDelete, "/presets/calm", nil))
+
+	var out strings.Builder
+	assert.NoError(t, reg.WriteText(&out))
+	assert.Contains(t, out.String(), `tts_proxy_http_requests_total{method="PUT",route="/presets/:name",status="200"} 1`)
+	assert.Contains(t, out.String(), `tts_proxy_http_requests_total{method="DELETE",route="/presets/:name",status="200"} 1`)
+}
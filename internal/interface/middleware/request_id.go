@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/logging"
+)
+
+// RequestIDLocal은 요청 ID를 저장하는 Fiber Locals 키입니다.
+const RequestIDLocal = "request_id"
+
+// maxRequestIDLength는 클라이언트가 보낸 요청 ID로 받아들일 최대 길이입니다.
+const maxRequestIDLength = 128
+
+type RequestIDMiddleware struct{}
+
+func NewRequestIDMiddleware() *RequestIDMiddleware {
+	return &RequestIDMiddleware{}
+}
+
+// Handle은 X-Request-ID 헤더의 요청 ID를 받아들이거나 새로 만들어 Fiber 컨텍스트와 요청 컨텍스트에 저장하고 응답 헤더로 돌려줍니다.
+// 요청 컨텍스트의 ID는 모든 로그의 request_id 필드, TTS 오류 응답, 업스트림 호출 헤더와 워터마크에 사용됩니다.
+func (m *RequestIDMiddleware) Handle(c *fiber.Ctx) error {
+	// 요청 ID는 스팬 내보내기, 감사 로그처럼 요청이 끝난 뒤에도 쓰이므로 요청 버퍼와 분리
+	requestID := utils.CopyString(c.Get(domain.RequestIDHeader))
+	if !validRequestID(requestID) {
+		var err error
+		if requestID, err = domain.NewRequestID(); err != nil {
+			return err
+		}
+	}
+	c.Locals(RequestIDLocal, requestID)
+	c.SetUserContext(logging.With(domain.WithRequestID(c.UserContext(), requestID), "request_id", requestID))
+	c.Set(domain.RequestIDHeader, requestID)
+	return c.Next()
+}
+
+// validRequestID는 로그와 헤더에 그대로 써도 안전한 요청 ID인지 확인합니다 (영문, 숫자, -, _, ., : 1~128자).
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/logging"
+)
+
+func newRequestIDApp(t *testing.T) *fiber.App {
+	app := fiber.New()
+	app.Use(NewRequestIDMiddleware().Handle)
+	app.Get("/id", func(c *fiber.Ctx) error {
+		ctxID := domain.RequestIDFromContext(c.UserContext())
+		assert.Equal(t, ctxID, c.Locals(RequestIDLocal))
+		assert.Equal(t, "request_id="+ctxID, logging.Attrs(c.UserContext())[0].String())
+		return c.SendString(ctxID)
+	})
+	app.Get("/fail", func(c *fiber.Ctx) error { return fiber.ErrBadGateway })
+	return app
+}
+
+func TestRequestIDMiddleware_Accept(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/id", nil)
+	req.Header.Set(domain.RequestIDHeader, "support-ticket-42:retry.1")
+	resp, _ := newRequestIDApp(t).Test(req)
+
+	body := make([]byte, 64)
+	n, _ := resp.Body.Read(body)
+	assert.Equal(t, "support-ticket-42:retry.1", string(body[:n]))
+	assert.Equal(t, "support-ticket-42:retry.1", resp.Header.Get(domain.RequestIDHeader))
+}
+
+func TestRequestIDMiddleware_Generate(t *testing.T) {
+	app := newRequestIDApp(t)
+	for _, incoming := range []string{"", "has space", "line\nbreak", "<script>", strings.Repeat("a", 129)} {
+		req := httptest.NewRequest(http.MethodGet, "/id", nil)
+		if incoming != "" {
+			req.Header.Set(domain.RequestIDHeader, incoming)
+		}
+		resp, _ := app.Test(req)
+		id := resp.Header.Get(domain.RequestIDHeader)
+		assert.Len(t, id, 16, incoming)
+		assert.NotEqual(t, incoming, id)
+	}
+
+	// 오류 응답에도 요청 ID를 돌려줌
+	resp, _ := app.Test(httptest.NewRequest(http.MethodGet, "/fail", nil))
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Len(t, resp.Header.Get(domain.RequestIDHeader), 16)
+}
+
+func TestRequestIDMiddleware_IDOutlivesRequest(t *testing.T) {
+	app := fiber.New()
+	app.Use(NewRequestIDMiddleware().Handle)
+	// 감사 로그처럼 요청이 끝난 뒤에도 요청 ID를 보관
+	var kept []string
+	app.Get("/id", func(c *fiber.Ctx) error {
+		kept = append(kept, domain.RequestIDFromContext(c.UserContext()))
+		return c.SendStatus(http.StatusOK)
+	})
+
+	incoming := []string{"request-aaaaaaaaaaaa", "req-b", "request-cc"}
+	for _, id := range incoming {
+		req := httptest.NewRequest(http.MethodGet, "/id", nil)
+		req.Header.Set(domain.RequestIDHeader, id)
+		app.Test(req)
+	}
+
+	assert.Equal(t, incoming, kept)
+}
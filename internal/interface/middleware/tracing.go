@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+	"tts_proxy/pkg/logging"
+	"tts_proxy/pkg/tracing"
+)
+
+type TracingMiddleware struct {
+	Tracer *tracing.Tracer
+}
+
+// NewTracingMiddleware는 요청마다 서버 스팬을 만드는 미들웨어를 생성합니다. tracer가 nil이면 tracing.Default()를 사용합니다.
+func NewTracingMiddleware(tracer *tracing.Tracer) *TracingMiddleware {
+	if tracer == nil {
+		tracer = tracing.Default()
+	}
+	return &TracingMiddleware{Tracer: tracer}
+}
+
+// Handle은 들어온 traceparent 헤더를 부모로 서버 스팬을 시작하고 컨텍스트에 저장합니다.
+// 유즈케이스와 업스트림 호출의 스팬은 이 스팬의 자식이 되며, 추적 ID는 로그의 trace_id 필드로 남습니다.
+func (m *TracingMiddleware) Handle(c *fiber.Ctx) error {
+	// 스팬은 요청이 끝난 뒤 내보내므로 Fiber가 재사용하는 요청 버퍼의 문자열을 복사해 둠
+	method, path := utils.CopyString(c.Method()), utils.CopyString(c.Path())
+	ctx := tracing.Extract(c.UserContext(), c.Get(tracing.TraceparentHeader))
+	ctx, span := m.Tracer.Start(ctx, "HTTP "+method, tracing.KindServer,
+		tracing.String("http.request.method", method),
+		tracing.String("url.path", path),
+	)
+	if span != nil {
+		ctx = logging.With(ctx, "trace_id", span.SpanContext().TraceID.String())
+	}
+	c.SetUserContext(ctx)
+
+	err := c.Next()
+
+	status := responseStatus(c, err)
+	route := c.Route().Path
+	span.SetName("HTTP " + method + " " + route)
+	span.SetAttributes(
+		tracing.String("http.route", route),
+		tracing.Int("http.response.status_code", status),
+	)
+	if status >= fiber.StatusInternalServerError {
+		span.SetStatus(tracing.StatusError, "")
+	}
+	span.End()
+	return err
+}
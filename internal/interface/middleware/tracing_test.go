@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/pkg/logging"
+	"tts_proxy/pkg/tracing"
+)
+
+type memorySpanExporter struct{ spans []tracing.SpanData }
+
+func (m *memorySpanExporter) Export(ctx context.Context, spans []tracing.SpanData) error {
+	m.spans = append(m.spans, spans...)
+	return nil
+}
+
+func TestTracingMiddleware(t *testing.T) {
+	exporter := &memorySpanExporter{}
+	tracer := tracing.NewTracer(exporter, tracing.TracerOptions{SampleRatio: 1})
+
+	app := fiber.New()
+	app.Use(NewTracingMiddleware(tracer).Handle)
+	var upstream http.Header
+	var logAttrs string
+	app.Post("/tts/:voiceId", func(c *fiber.Ctx) error {
+		ctx, span := tracer.Start(c.UserContext(), "upstream", tracing.KindClient)
+		defer span.End()
+		upstream = http.Header{}
+		tracing.Inject(ctx, upstream)
+		for _, a := range logging.Attrs(ctx) {
+			logAttrs += a.String()
+		}
+		return c.SendStatus(http.StatusBadGateway)
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/tts/v1", nil)
+	req.Header.Set(tracing.TraceparentHeader, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	resp, _ := app.Test(req)
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.NoError(t, tracer.Shutdown(context.Background()))
+
+	require.Len(t, exporter.spans, 2)
+	client, server := exporter.spans[0], exporter.spans[1]
+	assert.Equal(t, "HTTP POST /tts/:voiceId", server.Name)
+	assert.Equal(t, tracing.KindServer, server.Kind)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", server.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", server.Parent.String())
+	assert.Equal(t, tracing.StatusError, server.Status)
+	assert.Contains(t, server.Attributes, tracing.Int("http.response.status_code", 502))
+	assert.Contains(t, server.Attributes, tracing.String("http.route", "/tts/:voiceId"))
+
+	assert.Equal(t, server.SpanID, client.Parent)
+	assert.Equal(t, client.SpanContext.Traceparent(), upstream.Get(tracing.TraceparentHeader))
+	assert.Equal(t, "trace_id=4bf92f3577b34da6a3ce929d0e0e4736", logAttrs)
+}
+
+func TestTracingMiddleware_AttributesOutliveRequest(t *testing.T) {
+	exporter := &memorySpanExporter{}
+	tracer := tracing.NewTracer(exporter, tracing.TracerOptions{SampleRatio: 1})
+
+	app := fiber.New()
+	app.Use(NewTracingMiddleware(tracer).Handle)
+	app.Put("/presets/:name", func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) })
+	app.Delete("/presets/:name", func(c *fiber.Ctx) error { return c.SendStatus(http.StatusOK) })
+
+	// 스팬은 요청이 모두 끝난 뒤에 내보내짐
+	app.Test(httptest.NewRequest(http.MethodPut, "/presets/calm-and-slow", nil))
+	app.Test(httptest.NewRequest(http.MethodDelete, "/presets/x", nil))
+	require.NoError(t, tracer.Shutdown(context.Background()))
+
+	require.Len(t, exporter.spans, 2)
+	assert.Equal(t, "HTTP PUT /presets/:name", exporter.spans[0].Name)
+	assert.Contains(t, exporter.spans[0].Attributes, tracing.String("http.request.method", "PUT"))
+	assert.Contains(t, exporter.spans[0].Attributes, tracing.String("url.path", "/presets/calm-and-slow"))
+	assert.Equal(t, "HTTP DELETE /presets/:name", exporter.spans[1].Name)
+	assert.Contains(t, exporter.spans[1].Attributes, tracing.String("url.path", "/presets/x"))
+}
@@ -0,0 +1,532 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+	"tts_proxy/pkg/document"
+	"tts_proxy/pkg/sentence"
+)
+
+const (
+	audiobookChunkRunes = 300     // 한 번에 합성할 최대 글자 수
+	maxAudiobookRunes   = 1 << 20 // 문서 최대 글자 수
+	audiobookChunkPause = 250 * time.Millisecond
+	audiobookTitlePause = time.Second
+
+	// audiobookCancelGrace는 종료 기한이 지나 작업을 취소한 뒤 작업이 상태를 저장하고 멈추기를 기다리는 시간입니다.
+	audiobookCancelGrace = 5 * time.Second
+
+	audiobookSourceFile   = "source.txt"
+	audiobookPlaylistFile = "playlist.m3u"
+	audiobookChaptersFile = "chapters.json"
+)
+
+// AudiobookService는 domain.AudiobookService 구현체입니다. 작업은 최대 workers개까지 동시에 백그라운드에서 실행되며,
+// 청크 오디오를 저장소에 남기므로 재개하면 완료된 청크는 다시 합성하지 않습니다.
+type AudiobookService struct {
+	tts     domain.TTSService
+	repo    domain.AudiobookRepository
+	aliases domain.VoiceAliasRegistry
+
+	sem        chan struct{}
+	mu         sync.Mutex
+	active     map[string]bool
+	closing    chan struct{} // Shutdown이 닫음, 이후 대기 중인 작업은 시작하지 않음
+	closed     bool
+	jobCtx     context.Context // 작업 실행 컨텍스트, 종료 기한이 지나면 Shutdown이 취소
+	cancelJobs context.CancelFunc
+	wg         sync.WaitGroup
+	now        func() time.Time
+}
+
+// NewAudiobookService는 AudiobookService를 생성합니다. aliases가 nil이면 voice_id를 그대로 Voice ID로 사용합니다.
+func NewAudiobookService(tts domain.TTSService, repo domain.AudiobookRepository, aliases domain.VoiceAliasRegistry, workers int) *AudiobookService {
+	if workers < 1 {
+		workers = 1
+	}
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	return &AudiobookService{
+		tts:        tts,
+		repo:       repo,
+		aliases:    aliases,
+		sem:        make(chan struct{}, workers),
+		active:     map[string]bool{},
+		closing:    make(chan struct{}),
+		jobCtx:     jobCtx,
+		cancelJobs: cancelJobs,
+		now:        time.Now,
+	}
+}
+
+// audiobookChapterPlan은 챕터 하나를 합성할 청크 목록입니다. 제목이 있으면 첫 청크가 제목입니다.
+type audiobookChapterPlan struct {
+	title  string
+	chunks []string
+}
+
+// planAudiobook은 문서를 챕터와 청크로 나눕니다. 같은 입력이면 항상 같은 결과를 내므로 재개할 때 다시 계산합니다.
+func planAudiobook(doc, format string) []audiobookChapterPlan {
+	var plans []audiobookChapterPlan
+	for _, ch := range document.Parse(doc, format) {
+		chunks := sentence.Chunk(ch.Text, audiobookChunkRunes)
+		if ch.Title != "" {
+			chunks = append(sentence.Chunk(ch.Title, audiobookChunkRunes), chunks...)
+		}
+		if len(chunks) > 0 {
+			plans = append(plans, audiobookChapterPlan{title: ch.Title, chunks: chunks})
+		}
+	}
+	return plans
+}
+
+func chapterFile(chapter int) string {
+	return fmt.Sprintf("chapter_%03d.wav", chapter+1)
+}
+
+func chunkFile(chapter, chunk int) string {
+	return fmt.Sprintf("chunk_%03d_%04d.wav", chapter+1, chunk+1)
+}
+
+// Submit은 요청을 검증해 작업과 원문을 저장한 뒤 백그라운드에서 실행합니다.
+func (s *AudiobookService) Submit(ctx context.Context, req *domain.AudiobookRequest) (*domain.AudiobookJob, error) {
+	if strings.TrimSpace(req.Document) == "" {
+		return nil, fmt.Errorf("%w: document is required", domain.ErrInvalidRequest)
+	}
+	if utf8.RuneCountInString(req.Document) > maxAudiobookRunes {
+		return nil, fmt.Errorf("%w: document exceeds %d characters", domain.ErrInvalidRequest, maxAudiobookRunes)
+	}
+	if !utf8.ValidString(req.Document) {
+		return nil, fmt.Errorf("%w: document must be UTF-8", domain.ErrInvalidRequest)
+	}
+	if req.VoiceID == "" {
+		return nil, fmt.Errorf("%w: voice_id is required", domain.ErrInvalidRequest)
+	}
+	format := req.Format
+	switch format {
+	case "":
+		format = document.DetectFormat(req.Document)
+	case document.FormatMarkdown, document.FormatText:
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", domain.ErrInvalidRequest, req.Format)
+	}
+
+	plans := planAudiobook(req.Document, format)
+	if len(plans) == 0 {
+		return nil, fmt.Errorf("%w: document has no readable text", domain.ErrInvalidRequest)
+	}
+
+	id, err := domain.NewRequestID()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate job id: %w", err)
+	}
+	now := s.now()
+	job := domain.AudiobookJob{
+		ID:        id,
+		UserID:    domain.UserIDFromContext(ctx),
+		Title:     req.Title,
+		Format:    format,
+		VoiceID:   req.VoiceID,
+		Settings:  req.TTSDefaults,
+		Status:    domain.AudiobookQueued,
+		Chapters:  make([]domain.AudiobookChapter, len(plans)),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if job.Title == "" {
+		job.Title = plans[0].title
+	}
+	for i, plan := range plans {
+		job.Chapters[i] = domain.AudiobookChapter{Index: i, Title: plan.title, File: chapterFile(i), Chunks: len(plan.chunks)}
+	}
+
+	if err := s.repo.WriteFile(job.ID, audiobookSourceFile, []byte(req.Document)); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(job); err != nil {
+		return nil, err
+	}
+	s.start(job.ID)
+	return &job, nil
+}
+
+func (s *AudiobookService) Get(ctx context.Context, id string) (*domain.AudiobookJob, error) {
+	job, err := s.repo.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if job.UserID != domain.UserIDFromContext(ctx) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrAudiobookNotFound, id)
+	}
+	return job, nil
+}
+
+func (s *AudiobookService) List(ctx context.Context) ([]domain.AudiobookJob, error) {
+	return s.repo.List(domain.UserIDFromContext(ctx))
+}
+
+// Resume은 완료되지 않은 작업을 다시 대기열에 넣습니다. 이미 실행 중이거나 완료된 작업이면 ErrAudiobookConflict를 반환합니다.
+func (s *AudiobookService) Resume(ctx context.Context, id string) (*domain.AudiobookJob, error) {
+	job, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status == domain.AudiobookCompleted {
+		return nil, fmt.Errorf("%w: job %s is already completed", domain.ErrAudiobookConflict, id)
+	}
+	// 상태를 저장하는 동안 같은 작업을 재개하는 다른 요청이 작업을 두 번 시작하지 않도록 먼저 예약
+	if !s.reserve(id) {
+		return nil, fmt.Errorf("%w: job %s is already running", domain.ErrAudiobookConflict, id)
+	}
+
+	job.Status = domain.AudiobookQueued
+	job.Error = ""
+	job.UpdatedAt = s.now()
+	if err := s.repo.Save(*job); err != nil {
+		s.release(id)
+		return nil, err
+	}
+	s.launch(id)
+	return job, nil
+}
+
+// OpenFile은 작업 결과 파일만 열 수 있게 합니다. 원문과 청크 파일은 내려받을 수 없습니다.
+func (s *AudiobookService) OpenFile(ctx context.Context, id, name string) (io.ReadCloser, int64, error) {
+	job, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	allowed := name == audiobookPlaylistFile || name == audiobookChaptersFile
+	for _, ch := range job.Chapters {
+		allowed = allowed || name == ch.File
+	}
+	if !allowed {
+		return nil, 0, fmt.Errorf("%w: %s/%s", domain.ErrAudiobookNotFound, id, name)
+	}
+	return s.repo.OpenFile(id, name)
+}
+
+// ResumePending은 대기 중이거나 실행 중에 서버가 종료된 작업을 다시 시작합니다. 서버 시작 시 호출합니다.
+func (s *AudiobookService) ResumePending() (int, error) {
+	jobs, err := s.repo.ListAll()
+	if err != nil {
+		return 0, err
+	}
+	resumed := 0
+	for _, job := range jobs {
+		if job.Status == domain.AudiobookQueued || job.Status == domain.AudiobookRunning {
+			s.start(job.ID)
+			resumed++
+		}
+	}
+	return resumed, nil
+}
+
+// Wait는 실행 중인 모든 작업이 끝날 때까지 기다립니다.
+func (s *AudiobookService) Wait() {
+	s.wg.Wait()
+}
+
+// Shutdown은 새 작업을 시작하지 않게 하고 실행 중인 작업이 끝나기를 ctx가 끝날 때까지 기다립니다.
+// 기한이 지나면 실행 중인 작업을 취소하고 작업이 멈추기를 audiobookCancelGrace만큼 더 기다린 뒤 ctx의 오류를 반환합니다.
+// 시작하지 못한 작업은 queued, 취소된 작업은 running 상태로 남아 다음 시작 때 ResumePending으로 재개됩니다.
+func (s *AudiobookService) Shutdown(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.closing)
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+	}
+	s.cancelJobs()
+	select {
+	case <-done:
+	case <-time.After(audiobookCancelGrace):
+	}
+	return ctx.Err()
+}
+
+// start는 작업이 실행 중이 아니면 백그라운드에서 실행합니다.
+func (s *AudiobookService) start(id string) {
+	if s.reserve(id) {
+		s.launch(id)
+	}
+}
+
+// reserve는 작업을 실행 중으로 표시합니다. 이미 실행 중이면 false를 반환합니다.
+func (s *AudiobookService) reserve(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.active[id] {
+		return false
+	}
+	s.active[id] = true
+	return true
+}
+
+func (s *AudiobookService) release(id string) {
+	s.mu.Lock()
+	delete(s.active, id)
+	s.mu.Unlock()
+}
+
+// launch는 reserve한 작업을 실행할 고루틴을 시작합니다. 종료 중이면 예약만 풉니다.
+func (s *AudiobookService) launch(id string) {
+	s.mu.Lock()
+	if s.closed {
+		delete(s.active, id)
+		s.mu.Unlock()
+		return
+	}
+	s.wg.Add(1)
+	s.mu.Unlock()
+
+	go func() {
+		defer s.wg.Done()
+		defer s.release(id)
+
+		select {
+		case s.sem <- struct{}{}:
+		case <-s.closing:
+			return
+		}
+		defer func() { <-s.sem }()
+		select {
+		case <-s.closing: // 자리를 얻는 사이에 종료가 시작됨
+			return
+		default:
+		}
+		if err := s.run(s.jobCtx, id); err != nil {
+			if s.jobCtx.Err() != nil {
+				slog.Warn("audiobook job interrupted by shutdown, it will resume on next start", "job_id", id, "error", err)
+				return
+			}
+			slog.Error("audiobook job failed", "job_id", id, "error", err)
+		}
+	}()
+}
+
+// run은 작업을 끝까지 실행합니다. 실패하면 작업 상태를 failed로 저장하고 오류를 반환합니다.
+func (s *AudiobookService) run(ctx context.Context, id string) error {
+	job, err := s.repo.Get(id)
+	if err != nil {
+		return err
+	}
+	if err := s.process(domain.WithUserID(ctx, job.UserID), job); err != nil {
+		if ctx.Err() != nil {
+			return err // 종료로 취소됨, 상태를 running으로 남겨 재개되게 함
+		}
+		job.Status = domain.AudiobookFailed
+		job.Error = err.Error()
+		job.UpdatedAt = s.now()
+		if saveErr := s.repo.Save(*job); saveErr != nil {
+			return errors.Join(err, saveErr)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *AudiobookService) process(ctx context.Context, job *domain.AudiobookJob) error {
+	source, err := s.repo.ReadFile(job.ID, audiobookSourceFile)
+	if err != nil {
+		return err
+	}
+	plans := planAudiobook(string(source), job.Format)
+	if len(plans) != len(job.Chapters) {
+		return fmt.Errorf("document plan changed: %d chapters, expected %d", len(plans), len(job.Chapters))
+	}
+
+	job.Status = domain.AudiobookRunning
+	job.Error = ""
+	s.countCompleted(job, plans)
+	if err := s.save(job); err != nil {
+		return err
+	}
+
+	for ci, plan := range plans {
+		ch := &job.Chapters[ci]
+		if s.repo.FileExists(job.ID, ch.File) {
+			continue
+		}
+		for i, text := range plan.chunks {
+			name := chunkFile(ci, i)
+			if s.repo.FileExists(job.ID, name) {
+				continue
+			}
+			data, err := s.synthesize(ctx, job, text)
+			if err != nil {
+				return fmt.Errorf("chapter %d chunk %d: %w", ci+1, i+1, err)
+			}
+			if err := s.repo.WriteFile(job.ID, name, data); err != nil {
+				return err
+			}
+			ch.CompletedChunks++
+			if err := s.save(job); err != nil {
+				return err
+			}
+		}
+
+		duration, err := s.assembleChapter(job.ID, ci, plan)
+		if err != nil {
+			return fmt.Errorf("chapter %d: %w", ci+1, err)
+		}
+		ch.Duration = duration.Seconds()
+		if err := s.save(job); err != nil {
+			return err
+		}
+	}
+
+	if err := s.writeIndex(job); err != nil {
+		return err
+	}
+	job.Status = domain.AudiobookCompleted
+	return s.save(job)
+}
+
+// countCompleted는 저장소에 남아 있는 청크 파일로 진행 상황을 다시 계산합니다.
+func (s *AudiobookService) countCompleted(job *domain.AudiobookJob, plans []audiobookChapterPlan) {
+	for ci, plan := range plans {
+		ch := &job.Chapters[ci]
+		ch.Chunks = len(plan.chunks)
+		ch.CompletedChunks = 0
+		if s.repo.FileExists(job.ID, ch.File) {
+			ch.CompletedChunks = ch.Chunks
+			continue
+		}
+		for i := range plan.chunks {
+			if s.repo.FileExists(job.ID, chunkFile(ci, i)) {
+				ch.CompletedChunks++
+			}
+		}
+	}
+}
+
+func (s *AudiobookService) save(job *domain.AudiobookJob) error {
+	job.UpdatedAt = s.now()
+	return s.repo.Save(*job)
+}
+
+func (s *AudiobookService) synthesize(ctx context.Context, job *domain.AudiobookJob, text string) ([]byte, error) {
+	req := &domain.TTSRequest{Text: text}
+	req.ApplyDefaults(job.Settings)
+	voiceID := job.VoiceID
+	if s.aliases != nil {
+		if alias, ok := s.aliases.Resolve(voiceID); ok {
+			voiceID = alias.VoiceID
+			req.ApplyDefaults(alias.Defaults)
+		}
+	}
+
+	resp, err := s.tts.Synthesize(ctx, req, voiceID)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Audio, nil
+}
+
+// assembleChapter는 청크 오디오를 쉼을 넣어 이어 붙여 챕터 WAV를 만듭니다. 제목 뒤에는 더 길게 쉽니다.
+func (s *AudiobookService) assembleChapter(id string, chapter int, plan audiobookChapterPlan) (time.Duration, error) {
+	titleChunks := 0
+	if plan.title != "" {
+		titleChunks = len(sentence.Chunk(plan.title, audiobookChunkRunes))
+	}
+
+	var parts []*audio.PCM
+	for i := range plan.chunks {
+		data, err := s.repo.ReadFile(id, chunkFile(chapter, i))
+		if err != nil {
+			return 0, err
+		}
+		pcm, err := audio.DecodeWAV(data)
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode chunk %d: %w", i+1, err)
+		}
+		if len(parts) > 0 {
+			pause := audiobookChunkPause
+			if i == titleChunks {
+				pause = audiobookTitlePause
+			}
+			parts = append(parts, audio.Silence(pcm.SampleRate, pcm.Channels, pause))
+		}
+		parts = append(parts, pcm)
+	}
+
+	joined, err := audio.Concat(parts...)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.repo.WriteFile(id, chapterFile(chapter), audio.EncodeWAV(joined)); err != nil {
+		return 0, err
+	}
+	return joined.Duration(), nil
+}
+
+// m3uLine은 제목의 줄바꿈을 공백으로 바꿉니다. 줄바꿈이 남으면 제목으로 재생 목록에 임의의 줄을 끼워 넣을 수 있습니다.
+var m3uLine = strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ")
+
+// writeIndex는 확장 M3U 재생 목록과 챕터 메타데이터를 기록합니다.
+func (s *AudiobookService) writeIndex(job *domain.AudiobookJob) error {
+	var m3u strings.Builder
+	m3u.WriteString("#EXTM3U\n")
+	if job.Title != "" {
+		fmt.Fprintf(&m3u, "#PLAYLIST:%s\n", m3uLine.Replace(job.Title))
+	}
+
+	type chapterMeta struct {
+		Index    int     `json:"index"`
+		Title    string  `json:"title"`
+		File     string  `json:"file"`
+		Start    float64 `json:"start"`
+		Duration float64 `json:"duration"`
+	}
+	chapters := make([]chapterMeta, len(job.Chapters))
+	var start float64
+	for i, ch := range job.Chapters {
+		title := ch.Title
+		if title == "" {
+			title = fmt.Sprintf("Chapter %d", i+1)
+		}
+		fmt.Fprintf(&m3u, "#EXTINF:%d,%s\n%s\n", int(math.Round(ch.Duration)), m3uLine.Replace(title), ch.File)
+		chapters[i] = chapterMeta{Index: i, Title: title, File: ch.File, Start: round3(start), Duration: round3(ch.Duration)}
+		start += ch.Duration
+	}
+
+	meta, err := json.MarshalIndent(map[string]interface{}{
+		"title":    job.Title,
+		"duration": round3(start),
+		"chapters": chapters,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := s.repo.WriteFile(job.ID, audiobookPlaylistFile, []byte(m3u.String())); err != nil {
+		return err
+	}
+	return s.repo.WriteFile(job.ID, audiobookChaptersFile, meta)
+}
+
+func round3(v float64) float64 {
+	return math.Round(v*1000) / 1000
+}
@@ -0,0 +1,383 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+type memoryAudiobookRepository struct {
+	mu    sync.Mutex
+	jobs  map[string]domain.AudiobookJob
+	files map[string][]byte
+}
+
+func newMemoryAudiobookRepository() *memoryAudiobookRepository {
+	return &memoryAudiobookRepository{jobs: map[string]domain.AudiobookJob{}, files: map[string][]byte{}}
+}
+
+func (m *memoryAudiobookRepository) Get(id string) (*domain.AudiobookJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	job, ok := m.jobs[id]
+	if !ok {
+		return nil, domain.ErrAudiobookNotFound
+	}
+	var copied domain.AudiobookJob
+	data, _ := json.Marshal(job)
+	_ = json.Unmarshal(data, &copied)
+	return &copied, nil
+}
+
+func (m *memoryAudiobookRepository) List(userID string) ([]domain.AudiobookJob, error) {
+	all, _ := m.ListAll()
+	var out []domain.AudiobookJob
+	for _, job := range all {
+		if job.UserID == userID {
+			out = append(out, job)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryAudiobookRepository) ListAll() ([]domain.AudiobookJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make([]domain.AudiobookJob, 0, len(m.jobs))
+	for _, job := range m.jobs {
+		out = append(out, job)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID < out[j].ID })
+	return out, nil
+}
+
+func (m *memoryAudiobookRepository) Save(job domain.AudiobookJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.jobs[job.ID] = job
+	return nil
+}
+
+func (m *memoryAudiobookRepository) WriteFile(id, name string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.files[id+"/"+name] = append([]byte(nil), data...)
+	return nil
+}
+
+func (m *memoryAudiobookRepository) ReadFile(id, name string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.files[id+"/"+name]
+	if !ok {
+		return nil, domain.ErrAudiobookNotFound
+	}
+	return data, nil
+}
+
+func (m *memoryAudiobookRepository) FileExists(id, name string) bool {
+	_, err := m.ReadFile(id, name)
+	return err == nil
+}
+
+func (m *memoryAudiobookRepository) OpenFile(id, name string) (io.ReadCloser, int64, error) {
+	data, err := m.ReadFile(id, name)
+	if err != nil {
+		return nil, 0, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), int64(len(data)), nil
+}
+
+// bookTTSService는 요청마다 0.5초 WAV를 반환합니다. fail에 포함된 텍스트는 실패시킵니다.
+type bookTTSService struct {
+	mu       sync.Mutex
+	texts    []string
+	voiceIDs []string
+	fail     string
+}
+
+func (s *bookTTSService) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.fail != "" && strings.Contains(req.Text, s.fail) {
+		return nil, errors.New("upstream failed")
+	}
+	s.texts = append(s.texts, req.Text)
+	s.voiceIDs = append(s.voiceIDs, voiceID)
+	pcm := audio.Silence(1000, 1, 500*time.Millisecond)
+	for i := range pcm.Samples {
+		pcm.Samples[i] = 0.2
+	}
+	return &domain.TTSResponse{Audio: audio.EncodeWAV(pcm), Format: "wav"}, nil
+}
+
+const testBook = "# 첫째 장\n\n첫 문단입니다.\n\n# 둘째 장\n\n둘째 문단입니다.\n"
+
+func TestAudiobookService_Submit(t *testing.T) {
+	tts := &bookTTSService{}
+	repo := newMemoryAudiobookRepository()
+	aliases := NewVoiceAliasRegistry([]domain.VoiceAlias{{Name: "narrator", VoiceID: "narrator-voice"}})
+	service := NewAudiobookService(tts, repo, aliases, 2)
+	ctx := domain.WithUserID(context.Background(), "alice")
+
+	job, err := service.Submit(ctx, &domain.AudiobookRequest{Document: testBook, VoiceID: "narrator"})
+	require.NoError(t, err)
+	assert.Equal(t, "첫째 장", job.Title)
+	assert.Equal(t, "markdown", job.Format)
+	assert.Len(t, job.Chapters, 2)
+	service.Wait()
+
+	done, err := service.Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AudiobookCompleted, done.Status)
+	assert.Equal(t, 1.0, done.Progress())
+	assert.Equal(t, []string{"narrator-voice", "narrator-voice", "narrator-voice", "narrator-voice"}, tts.voiceIDs)
+
+	// 제목 0.5초 + 쉼 1초 + 본문 0.5초
+	assert.InDelta(t, 2.0, done.Chapters[0].Duration, 0.01)
+	wav, _ := repo.ReadFile(job.ID, "chapter_001.wav")
+	pcm, err := audio.DecodeWAV(wav)
+	require.NoError(t, err)
+	assert.InDelta(t, 2.0, pcm.Duration().Seconds(), 0.01)
+
+	playlist, _ := repo.ReadFile(job.ID, "playlist.m3u")
+	assert.Equal(t, "#EXTM3U\n#PLAYLIST:첫째 장\n#EXTINF:2,첫째 장\nchapter_001.wav\n#EXTINF:2,둘째 장\nchapter_002.wav\n", string(playlist))
+
+	var meta struct {
+		Duration float64 `json:"duration"`
+		Chapters []struct {
+			Start float64 `json:"start"`
+		} `json:"chapters"`
+	}
+	data, _ := repo.ReadFile(job.ID, "chapters.json")
+	require.NoError(t, json.Unmarshal(data, &meta))
+	assert.InDelta(t, 4.0, meta.Duration, 0.01)
+	assert.InDelta(t, 2.0, meta.Chapters[1].Start, 0.01)
+
+	// 다른 사용자는 작업을 볼 수 없음
+	_, err = service.Get(domain.WithUserID(context.Background(), "bob"), job.ID)
+	assert.ErrorIs(t, err, domain.ErrAudiobookNotFound)
+
+	// 원문과 청크 파일은 내려받을 수 없음
+	_, _, err = service.OpenFile(ctx, job.ID, "source.txt")
+	assert.ErrorIs(t, err, domain.ErrAudiobookNotFound)
+	r, size, err := service.OpenFile(ctx, job.ID, "playlist.m3u")
+	require.NoError(t, err)
+	r.Close()
+	assert.Equal(t, int64(len(playlist)), size)
+
+	_, err = service.Resume(ctx, job.ID)
+	assert.ErrorIs(t, err, domain.ErrAudiobookConflict)
+}
+
+func TestAudiobookService_PlaylistTitleNewline(t *testing.T) {
+	repo := newMemoryAudiobookRepository()
+	service := NewAudiobookService(&bookTTSService{}, repo, nil, 1)
+
+	job, err := service.Submit(context.Background(), &domain.AudiobookRequest{
+		Title: "책\r\nhttp://evil.example/x.wav", Document: "본문입니다.", VoiceID: "voice-1",
+	})
+	require.NoError(t, err)
+	service.Wait()
+
+	// 제목의 줄바꿈으로 재생 목록에 줄을 끼워 넣을 수 없음
+	playlist, _ := repo.ReadFile(job.ID, "playlist.m3u")
+	assert.Equal(t, "#EXTM3U\n#PLAYLIST:책 http://evil.example/x.wav\n#EXTINF:1,Chapter 1\nchapter_001.wav\n", string(playlist))
+}
+
+func TestAudiobookService_Resume(t *testing.T) {
+	tts := &bookTTSService{fail: "둘째 문단"}
+	repo := newMemoryAudiobookRepository()
+	service := NewAudiobookService(tts, repo, nil, 1)
+	ctx := context.Background()
+
+	job, err := service.Submit(ctx, &domain.AudiobookRequest{Title: "책", Document: testBook, VoiceID: "voice-1"})
+	require.NoError(t, err)
+	service.Wait()
+
+	failed, err := service.Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AudiobookFailed, failed.Status)
+	assert.Contains(t, failed.Error, "upstream failed")
+	assert.Equal(t, 2, failed.Chapters[0].CompletedChunks)
+	assert.Equal(t, 1, failed.Chapters[1].CompletedChunks)
+	assert.Equal(t, 0.75, failed.Progress())
+
+	// 재개하면 실패한 청크만 다시 합성
+	tts.fail = ""
+	tts.texts = nil
+	_, err = service.Resume(ctx, job.ID)
+	require.NoError(t, err)
+	service.Wait()
+
+	done, err := service.Get(ctx, job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AudiobookCompleted, done.Status)
+	assert.Empty(t, done.Error)
+	assert.Equal(t, []string{"둘째 문단입니다."}, tts.texts)
+}
+
+func TestAudiobookService_UpstreamUnavailable(t *testing.T) {
+	tts := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return nil, domain.ErrUpstreamUnavailable
+		},
+	}
+	repo := newMemoryAudiobookRepository()
+	service := NewAudiobookService(tts, repo, nil, 1)
+
+	job, err := service.Submit(context.Background(), &domain.AudiobookRequest{Document: testBook, VoiceID: "voice-1"})
+	require.NoError(t, err)
+	service.Wait()
+
+	// 회로가 열려 있어 실패했음을 오류에서 알 수 있음
+	failed, err := service.Get(context.Background(), job.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.AudiobookFailed, failed.Status)
+	assert.Equal(t, "chapter 1 chunk 1: upstream TTS provider is unavailable", failed.Error)
+}
+
+func TestAudiobookService_ResumePending(t *testing.T) {
+	tts := &bookTTSService{}
+	repo := newMemoryAudiobookRepository()
+	first := NewAudiobookService(tts, repo, nil, 1)
+
+	job, err := first.Submit(context.Background(), &domain.AudiobookRequest{Document: "본문만 있습니다.", VoiceID: "voice-1"})
+	require.NoError(t, err)
+	first.Wait()
+
+	// 서버가 실행 중에 종료된 것처럼 상태를 되돌림
+	stale, _ := repo.Get(job.ID)
+	stale.Status = domain.AudiobookRunning
+	repo.Save(*stale)
+
+	second := NewAudiobookService(tts, repo, nil, 1)
+	n, err := second.ResumePending()
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	second.Wait()
+
+	done, _ := repo.Get(job.ID)
+	assert.Equal(t, domain.AudiobookCompleted, done.Status)
+	assert.Len(t, tts.texts, 1)
+}
+
+func TestAudiobookService_Submit_Invalid(t *testing.T) {
+	service := NewAudiobookService(&bookTTSService{}, newMemoryAudiobookRepository(), nil, 1)
+
+	for name, req := range map[string]domain.AudiobookRequest{
+		"empty document": {Document: "  ", VoiceID: "voice-1"},
+		"missing voice":  {Document: "본문"},
+		"bad format":     {Document: "본문", VoiceID: "voice-1", Format: "pdf"},
+		"no text":        {Document: "```\ncode\n```", VoiceID: "voice-1", Format: "markdown"},
+	} {
+		_, err := service.Submit(context.Background(), &req)
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest, name)
+	}
+}
+
+// blockingTTSService는 release가 닫히거나 ctx가 취소될 때까지 합성을 멈춥니다.
+type blockingTTSService struct {
+	bookTTSService
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *blockingTTSService) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	s.started <- struct{}{}
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return s.bookTTSService.Synthesize(ctx, req, voiceID)
+}
+
+func TestAudiobookService_Shutdown(t *testing.T) {
+	tts := &blockingTTSService{started: make(chan struct{}, 1), release: make(chan struct{})}
+	repo := newMemoryAudiobookRepository()
+	service := NewAudiobookService(tts, repo, nil, 1)
+
+	running, err := service.Submit(context.Background(), &domain.AudiobookRequest{Document: "첫 작업입니다.", VoiceID: "voice-1"})
+	require.NoError(t, err)
+	<-tts.started
+	queued, err := service.Submit(context.Background(), &domain.AudiobookRequest{Document: "둘째 작업입니다.", VoiceID: "voice-1"})
+	require.NoError(t, err)
+
+	// 실행 중인 작업이 끝나지 않으면 기한이 지나 작업을 취소하고 반환
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, service.Shutdown(ctx), context.DeadlineExceeded)
+	require.NoError(t, service.Shutdown(context.Background())) // 취소된 작업은 이미 멈춤
+
+	// 취소된 작업은 실패로 기록하지 않고 running으로 남아, 대기 중이던 작업과 함께 다음 실행에서 재개
+	interrupted, _ := repo.Get(running.ID)
+	assert.Equal(t, domain.AudiobookRunning, interrupted.Status)
+	assert.Empty(t, interrupted.Error)
+	pending, _ := repo.Get(queued.ID)
+	assert.Equal(t, domain.AudiobookQueued, pending.Status)
+	assert.Empty(t, tts.texts)
+
+	next := NewAudiobookService(&bookTTSService{}, repo, nil, 1)
+	n, err := next.ResumePending()
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	next.Wait()
+	done, _ := repo.Get(running.ID)
+	assert.Equal(t, domain.AudiobookCompleted, done.Status)
+}
+
+func TestAudiobookService_ResumeConcurrent(t *testing.T) {
+	tts := &blockingTTSService{
+		bookTTSService: bookTTSService{fail: "본문"},
+		started:        make(chan struct{}, 1),
+		release:        make(chan struct{}),
+	}
+	repo := newMemoryAudiobookRepository()
+	service := NewAudiobookService(tts, repo, nil, 1)
+
+	job, err := service.Submit(context.Background(), &domain.AudiobookRequest{Document: "본문입니다.", VoiceID: "voice-1"})
+	require.NoError(t, err)
+	<-tts.started
+	close(tts.release) // 첫 실행은 실패
+	service.Wait()
+	tts.fail = ""
+	tts.release = make(chan struct{})
+
+	// 동시에 재개해도 작업은 한 번만 시작
+	var wg sync.WaitGroup
+	var conflicts, resumed atomic.Int32
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := service.Resume(context.Background(), job.ID)
+			if errors.Is(err, domain.ErrAudiobookConflict) {
+				conflicts.Add(1)
+			} else if assert.NoError(t, err) {
+				resumed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	<-tts.started
+	close(tts.release)
+	service.Wait()
+
+	assert.Equal(t, int32(1), resumed.Load())
+	assert.Equal(t, int32(7), conflicts.Load())
+	assert.Equal(t, []string{"본문입니다."}, tts.texts)
+}
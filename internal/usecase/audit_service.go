@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"time"
+	"unicode/utf8"
+
+	"tts_proxy/internal/domain"
+)
+
+// auditService는 AuditService의 실제 구현체입니다.
+type auditService struct {
+	repo domain.AuditRepository
+}
+
+// NewAuditService는 AuditService 구현체를 생성합니다.
+func NewAuditService(repo domain.AuditRepository) domain.AuditService {
+	return &auditService{repo: repo}
+}
+
+// Query는 조건을 검증한 뒤 감사 기록을 조회합니다.
+func (s *auditService) Query(ctx context.Context, q domain.AuditQuery) ([]domain.AuditRecord, error) {
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidRequest)
+	}
+	if q.Limit < 0 {
+		return nil, fmt.Errorf("%w: limit must not be negative", domain.ErrInvalidRequest)
+	}
+	return s.repo.Query(q)
+}
+
+// Verify는 감사 로그의 해시 사슬을 검증합니다.
+func (s *auditService) Verify(ctx context.Context) (*domain.AuditVerification, error) {
+	return s.repo.Verify()
+}
+
+// recordAudit은 합성 요청 하나의 감사 기록을 남깁니다. text는 발음 사전과 정규화를 적용하기 전의 원문입니다.
+func (s *ttsService) recordAudit(ctx context.Context, text, voiceID string, req *domain.TTSRequest, resp *domain.TTSResponse, synthErr error) error {
+	record := &domain.AuditRecord{
+		Time:      time.Now(),
+		RequestID: domain.RequestIDFromContext(ctx),
+		UserID:    domain.UserIDFromContext(ctx),
+		ClientIP:  domain.ClientIPFromContext(ctx),
+		VoiceID:   voiceID,
+		Model:     req.Model,
+		Provider:  s.auditProvider,
+		TextHash:  sha256Hex([]byte(text)),
+		Chars:     utf8.RuneCountInString(text),
+		Outcome:   domain.AuditSuccess,
+	}
+	if s.auditText {
+		record.Text = text
+	}
+	if synthErr != nil {
+		record.Outcome, record.Error = domain.AuditFailure, synthErr.Error()
+	} else {
+		record.AudioHash = sha256Hex(resp.Audio)
+		if resp.RequestID != "" {
+			record.RequestID = resp.RequestID
+		}
+	}
+
+	if err := s.audit.Append(record); err != nil {
+		slog.ErrorContext(ctx, "failed to write audit record", "error", err)
+		return fmt.Errorf("failed to write audit record: %w", err)
+	}
+	return nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+)
+
+type memoryAuditRepository struct {
+	records []domain.AuditRecord
+	err     error
+}
+
+func (m *memoryAuditRepository) Append(record *domain.AuditRecord) error {
+	if m.err != nil {
+		return m.err
+	}
+	record.Seq = int64(len(m.records) + 1)
+	m.records = append(m.records, *record)
+	return nil
+}
+
+func (m *memoryAuditRepository) Query(q domain.AuditQuery) ([]domain.AuditRecord, error) {
+	var out []domain.AuditRecord
+	for _, r := range m.records {
+		if q.Match(&r) {
+			out = append(out, r)
+		}
+	}
+	return out, nil
+}
+
+func (m *memoryAuditRepository) Verify() (*domain.AuditVerification, error) {
+	return &domain.AuditVerification{Valid: true, Records: int64(len(m.records))}, nil
+}
+
+func TestTTSService_Synthesize_Audit(t *testing.T) {
+	adapter := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			if req.Text == "fail" {
+				return nil, errors.New("TTS API error: 500 Internal Server Error")
+			}
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	audit := &memoryAuditRepository{}
+	global := []domain.Lexicon{{Name: "global", Entries: []domain.LexiconEntry{
+		{Grapheme: "API", Replacement: "에이피아이", Match: domain.MatchWord},
+	}}}
+	service := NewTTSService(adapter, WithLexicons(nil, global), WithAudit(audit, "supertone", false))
+	ctx := domain.WithClientIP(domain.WithRequestID(domain.WithUserID(context.Background(), "alice"), "req-1"), "203.0.113.7")
+
+	_, err := service.Synthesize(ctx, &domain.TTSRequest{Text: "API 문서", Language: "ko", Model: "sona_speech_1"}, "voice-123")
+	require.NoError(t, err)
+	_, err = service.Synthesize(ctx, &domain.TTSRequest{Text: "fail", Language: "en"}, "voice-123")
+	require.Error(t, err)
+
+	require.Len(t, audit.records, 2)
+	ok := audit.records[0]
+	assert.Equal(t, "alice", ok.UserID)
+	assert.Equal(t, "203.0.113.7", ok.ClientIP)
+	assert.Equal(t, "req-1", ok.RequestID)
+	assert.Equal(t, "voice-123", ok.VoiceID)
+	assert.Equal(t, "sona_speech_1", ok.Model)
+	assert.Equal(t, "supertone", ok.Provider)
+	assert.Equal(t, sha256Hex([]byte("API 문서")), ok.TextHash) // 사전 적용 전 원문
+	assert.Equal(t, 6, ok.Chars)
+	assert.Empty(t, ok.Text)
+	assert.Equal(t, domain.AuditSuccess, ok.Outcome)
+	assert.Equal(t, sha256Hex([]byte("WAVDATA")), ok.AudioHash)
+	assert.False(t, ok.Time.IsZero())
+
+	failed := audit.records[1]
+	assert.Equal(t, domain.AuditFailure, failed.Outcome)
+	assert.Equal(t, "TTS API error: 500 Internal Server Error", failed.Error)
+	assert.Empty(t, failed.AudioHash)
+}
+
+func TestTTSService_Synthesize_AuditText(t *testing.T) {
+	adapter := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	audit := &memoryAuditRepository{}
+	service := NewTTSService(adapter, WithAudit(audit, "supertone", true))
+
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hello", Language: "en"}, "voice-123")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", audit.records[0].Text)
+
+	// 감사 기록을 남기지 못하면 결과를 돌려주지 않음
+	audit.err = errors.New("disk full")
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hello", Language: "en"}, "voice-123")
+	assert.ErrorContains(t, err, "disk full")
+	assert.Nil(t, resp)
+}
+
+func TestAuditService_Query(t *testing.T) {
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	repo := &memoryAuditRepository{}
+	repo.Append(&domain.AuditRecord{UserID: "alice", Time: start})
+	repo.Append(&domain.AuditRecord{UserID: "bob", Time: start.Add(time.Hour)})
+	service := NewAuditService(repo)
+
+	records, err := service.Query(context.Background(), domain.AuditQuery{UserID: "bob"})
+	require.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	_, err = service.Query(context.Background(), domain.AuditQuery{From: start.Add(time.Hour), To: start})
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	_, err = service.Query(context.Background(), domain.AuditQuery{Limit: -1})
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+
+	result, err := service.Verify(context.Background())
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+}
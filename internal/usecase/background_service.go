@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+var backgroundNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// maxCachedBedBytes는 디코딩해 둘 배경 트랙 표본의 최대 크기입니다.
+const maxCachedBedBytes = 256 << 20
+
+// backgroundService는 BackgroundService의 실제 구현체로, 모든 사용자가 공유하는 배경 트랙을 관리합니다.
+type backgroundService struct {
+	repo  domain.BackgroundRepository
+	cache *BedCache
+	now   func() time.Time
+}
+
+// NewBackgroundService는 BackgroundService 구현체를 생성합니다. 트랙을 올리거나 지우면 cache에서 해당 트랙을 버리므로,
+// 합성에 쓰는 WithBackgrounds와 같은 cache를 넘겨야 합니다.
+func NewBackgroundService(repo domain.BackgroundRepository, cache *BedCache) domain.BackgroundService {
+	return &backgroundService{repo: repo, cache: cache, now: time.Now}
+}
+
+// Upload는 WAV로 디코딩할 수 있는지 확인한 뒤 메타데이터와 함께 저장합니다.
+func (s *backgroundService) Upload(ctx context.Context, name string, wav []byte) (*domain.BackgroundTrack, error) {
+	if !backgroundNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("%w: name must be 1-64 characters of letters, digits, '-' or '_'", domain.ErrInvalidBackground)
+	}
+	pcm, err := audio.DecodeWAV(wav)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidBackground, err)
+	}
+	if pcm.Frames() == 0 {
+		return nil, fmt.Errorf("%w: audio is empty", domain.ErrInvalidBackground)
+	}
+
+	track := domain.BackgroundTrack{
+		Name:       name,
+		SampleRate: pcm.SampleRate,
+		Channels:   pcm.Channels,
+		Duration:   pcm.Duration().Seconds(),
+		Size:       len(wav),
+		CreatedAt:  s.now(),
+	}
+	if err := s.repo.Save(track, wav); err != nil {
+		return nil, err
+	}
+	s.cache.invalidate(name)
+	return &track, nil
+}
+
+// List는 등록된 배경 트랙 목록을 반환합니다.
+func (s *backgroundService) List(ctx context.Context) ([]domain.BackgroundTrack, error) {
+	return s.repo.List()
+}
+
+// Delete는 배경 트랙을 삭제합니다.
+func (s *backgroundService) Delete(ctx context.Context, name string) error {
+	if err := s.repo.Delete(name); err != nil {
+		return err
+	}
+	s.cache.invalidate(name)
+	return nil
+}
+
+// BedCache는 디코딩해 출력 형식으로 변환한 배경 트랙을 트랙 이름과 형식별로 보관하는 LRU 캐시입니다.
+// 요청마다 WAV를 읽어 디코딩하고 리샘플링하지 않도록 하며, 표본 크기의 합이 maxCachedBedBytes를 넘지 않게 합니다.
+type BedCache struct {
+	mu    sync.Mutex
+	max   int
+	size  int
+	gen   map[string]int // 트랙별로 invalidate할 때마다 증가. 디코딩하는 동안 바뀐 트랙은 캐시하지 않음
+	order *list.List     // 앞쪽일수록 최근에 사용, 값은 *bedCacheEntry
+	items map[bedKey]*list.Element
+}
+
+type bedKey struct {
+	name     string
+	rate     int
+	channels int
+}
+
+type bedCacheEntry struct {
+	key bedKey
+	pcm *audio.PCM
+}
+
+// NewBedCache는 빈 배경 트랙 캐시를 생성합니다.
+func NewBedCache() *BedCache {
+	return newBedCache(maxCachedBedBytes)
+}
+
+func newBedCache(maxBytes int) *BedCache {
+	return &BedCache{max: maxBytes, gen: make(map[string]int), order: list.New(), items: make(map[bedKey]*list.Element)}
+}
+
+// get은 name 트랙을 rate, channels 형식으로 반환합니다. 캐시에 없으면 repo에서 읽어 디코딩하고 변환합니다.
+// 반환한 PCM은 다른 요청과 공유하므로 고치면 안 됩니다.
+func (c *BedCache) get(repo domain.BackgroundRepository, name string, rate, channels int) (*audio.PCM, error) {
+	key := bedKey{name: name, rate: rate, channels: channels}
+	c.mu.Lock()
+	if el, ok := c.items[key]; ok {
+		c.order.MoveToFront(el)
+		c.mu.Unlock()
+		return el.Value.(*bedCacheEntry).pcm, nil
+	}
+	gen := c.gen[name]
+	c.mu.Unlock()
+
+	// 디코딩하는 동안 다른 요청이 기다리지 않도록 잠금 밖에서 읽고 변환
+	data, err := repo.Audio(name)
+	if err != nil {
+		return nil, err
+	}
+	bed, err := audio.DecodeWAV(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode background track %s: %w", name, err)
+	}
+	bed = audio.Convert(bed, rate, channels)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := len(bed.Samples) * 8
+	if c.gen[name] != gen || n > c.max {
+		return bed, nil
+	}
+	if el, ok := c.items[key]; ok {
+		c.order.MoveToFront(el)
+		return el.Value.(*bedCacheEntry).pcm, nil
+	}
+	c.items[key] = c.order.PushFront(&bedCacheEntry{key: key, pcm: bed})
+	c.size += n
+	for c.size > c.max {
+		c.remove(c.order.Back())
+	}
+	return bed, nil
+}
+
+// invalidate는 name 트랙을 모든 형식에서 버립니다.
+func (c *BedCache) invalidate(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen[name]++
+	for key, el := range c.items {
+		if key.name == name {
+			c.remove(el)
+		}
+	}
+}
+
+// remove는 항목 하나를 버립니다. 호출자가 잠금을 보유해야 합니다.
+func (c *BedCache) remove(el *list.Element) {
+	entry := c.order.Remove(el).(*bedCacheEntry)
+	delete(c.items, entry.key)
+	c.size -= len(entry.pcm.Samples) * 8
+}
+
+// mixBackground는 요청한 배경 트랙을 음성 아래에 깝니다. 음성 앞에 배경만 나오는 구간이 생기면 타이밍 추정값을 그만큼 늦춥니다.
+func (s *ttsService) mixBackground(pcm *audio.PCM, timings []domain.SentenceTiming, opts *domain.BackgroundOptions) (*audio.PCM, []domain.SentenceTiming, error) {
+	if s.backgrounds == nil {
+		return nil, nil, fmt.Errorf("%w: background mixing is not configured", domain.ErrInvalidRequest)
+	}
+	bed, err := s.beds.get(s.backgrounds, opts.Track, pcm.SampleRate, pcm.Channels)
+	if errors.Is(err, domain.ErrBackgroundNotFound) {
+		return nil, nil, fmt.Errorf("%w: unknown background track %q", domain.ErrInvalidRequest, opts.Track)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leadIn := time.Duration(opts.LeadInMS) * time.Millisecond
+	mixed, err := audio.MixBed(pcm, bed, audio.BedOptions{
+		GainDB:    opts.Gain(),
+		DuckingDB: opts.Ducking(),
+		FadeIn:    time.Duration(opts.FadeInMS) * time.Millisecond,
+		FadeOut:   time.Duration(opts.FadeOutMS) * time.Millisecond,
+		LeadIn:    leadIn,
+		Tail:      time.Duration(opts.TailMS) * time.Millisecond,
+		Loop:      opts.LoopEnabled(),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to mix background track %s: %w", opts.Track, err)
+	}
+	return mixed, shiftTimings(timings, -leadIn, mixed.Duration()), nil
+}
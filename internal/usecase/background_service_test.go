@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+type memoryBackgroundRepository struct {
+	tracks map[string]domain.BackgroundTrack
+	audio  map[string][]byte
+}
+
+func newMemoryBackgroundRepository() *memoryBackgroundRepository {
+	return &memoryBackgroundRepository{tracks: map[string]domain.BackgroundTrack{}, audio: map[string][]byte{}}
+}
+
+func (m *memoryBackgroundRepository) Get(name string) (*domain.BackgroundTrack, error) {
+	track, ok := m.tracks[name]
+	if !ok {
+		return nil, domain.ErrBackgroundNotFound
+	}
+	return &track, nil
+}
+
+func (m *memoryBackgroundRepository) List() ([]domain.BackgroundTrack, error) {
+	tracks := []domain.BackgroundTrack{}
+	for _, t := range m.tracks {
+		tracks = append(tracks, t)
+	}
+	sort.Slice(tracks, func(i, j int) bool { return tracks[i].Name < tracks[j].Name })
+	return tracks, nil
+}
+
+func (m *memoryBackgroundRepository) Audio(name string) ([]byte, error) {
+	data, ok := m.audio[name]
+	if !ok {
+		return nil, domain.ErrBackgroundNotFound
+	}
+	return data, nil
+}
+
+func (m *memoryBackgroundRepository) Save(track domain.BackgroundTrack, wav []byte) error {
+	m.tracks[track.Name] = track
+	m.audio[track.Name] = wav
+	return nil
+}
+
+func (m *memoryBackgroundRepository) Delete(name string) error {
+	if _, ok := m.tracks[name]; !ok {
+		return domain.ErrBackgroundNotFound
+	}
+	delete(m.tracks, name)
+	delete(m.audio, name)
+	return nil
+}
+
+func TestBackgroundService_Upload(t *testing.T) {
+	repo := newMemoryBackgroundRepository()
+	service := NewBackgroundService(repo, NewBedCache())
+	ctx := context.Background()
+
+	wav := audio.EncodeWAV(audio.Silence(44100, 2, 1500*time.Millisecond))
+	track, err := service.Upload(ctx, "rain", wav)
+	require.NoError(t, err)
+	assert.Equal(t, 44100, track.SampleRate)
+	assert.Equal(t, 2, track.Channels)
+	assert.InDelta(t, 1.5, track.Duration, 1e-9)
+	assert.Equal(t, len(wav), track.Size)
+
+	tracks, err := service.List(ctx)
+	require.NoError(t, err)
+	assert.Len(t, tracks, 1)
+
+	_, err = service.Upload(ctx, "../rain", wav)
+	assert.ErrorIs(t, err, domain.ErrInvalidBackground)
+	_, err = service.Upload(ctx, "noise", []byte("not a wav"))
+	assert.ErrorIs(t, err, domain.ErrInvalidBackground)
+	_, err = service.Upload(ctx, "empty", audio.EncodeWAV(audio.Silence(44100, 1, 0)))
+	assert.ErrorIs(t, err, domain.ErrInvalidBackground)
+
+	assert.NoError(t, service.Delete(ctx, "rain"))
+	assert.ErrorIs(t, service.Delete(ctx, "rain"), domain.ErrBackgroundNotFound)
+}
+
+func TestTTSService_Synthesize_Background(t *testing.T) {
+	repo := newMemoryBackgroundRepository()
+	bed := audio.Silence(22050, 2, 300*time.Millisecond)
+	for i := range bed.Samples {
+		bed.Samples[i] = 0.5
+	}
+	require.NoError(t, repo.Save(domain.BackgroundTrack{Name: "hum"}, audio.EncodeWAV(bed)))
+
+	service := NewTTSService(paddedToneAdapter(-6), WithBackgrounds(repo, NewBedCache()))
+	gain, ducking := -6.0, 0.0
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", Language: "en",
+		Background: &domain.BackgroundOptions{Track: "hum", GainDB: &gain, DuckingDB: &ducking, LeadInMS: 500, TailMS: 200},
+	}, "voice-123")
+	require.NoError(t, err)
+	assert.Equal(t, "wav", resp.Format)
+
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, 48000, pcm.SampleRate)
+	assert.Equal(t, 1, pcm.Channels)
+	assert.Equal(t, 2200*time.Millisecond, pcm.Duration()) // 500ms + 1.5초 음성 + 200ms
+	assert.InDelta(t, 0.25, pcm.Samples[12000], 0.01)      // 음성 앞 배경, 반복된 구간
+}
+
+func TestTTSService_Synthesize_BackgroundTimings(t *testing.T) {
+	repo := newMemoryBackgroundRepository()
+	require.NoError(t, repo.Save(domain.BackgroundTrack{Name: "hum"}, audio.EncodeWAV(audio.Silence(48000, 1, time.Second))))
+	service := NewTTSService(paddedToneAdapter(-6), WithBackgrounds(repo, NewBedCache()))
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello world", Language: "en",
+		Subtitles:  &domain.SubtitleOptions{},
+		Background: &domain.BackgroundOptions{Track: "hum", LeadInMS: 1000},
+	}, "voice-123")
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.Timings)
+	assert.Equal(t, 1200*time.Millisecond, resp.Timings[0].Start) // 앞 무음 200ms + 배경만 나오는 1초
+}
+
+// countingBackgroundRepository는 WAV를 몇 번 읽었는지 셉니다.
+type countingBackgroundRepository struct {
+	*memoryBackgroundRepository
+	reads int
+}
+
+func (c *countingBackgroundRepository) Audio(name string) ([]byte, error) {
+	c.reads++
+	return c.memoryBackgroundRepository.Audio(name)
+}
+
+func constantBed(rate int, level float64) []byte {
+	bed := audio.Silence(rate, 1, time.Second)
+	for i := range bed.Samples {
+		bed.Samples[i] = level
+	}
+	return audio.EncodeWAV(bed)
+}
+
+func TestTTSService_Synthesize_BackgroundCache(t *testing.T) {
+	repo := &countingBackgroundRepository{memoryBackgroundRepository: newMemoryBackgroundRepository()}
+	beds := NewBedCache()
+	backgrounds := NewBackgroundService(repo, beds)
+	service := NewTTSService(paddedToneAdapter(-6), WithBackgrounds(repo, beds))
+	ctx := context.Background()
+	gain, ducking := 0.0, 0.0
+	synthesize := func() (*audio.PCM, error) {
+		resp, err := service.Synthesize(ctx, &domain.TTSRequest{
+			Text: "hello", Language: "en",
+			Background: &domain.BackgroundOptions{Track: "hum", GainDB: &gain, DuckingDB: &ducking, LeadInMS: 500},
+		}, "voice-123")
+		if err != nil {
+			return nil, err
+		}
+		return audio.DecodeWAV(resp.Audio)
+	}
+
+	_, err := backgrounds.Upload(ctx, "hum", constantBed(22050, 0.25))
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		pcm, err := synthesize()
+		require.NoError(t, err)
+		assert.InDelta(t, 0.25, pcm.Samples[12000], 0.01)
+	}
+	assert.Equal(t, 1, repo.reads)
+
+	// 같은 이름으로 다시 올리면 새 트랙을 사용
+	_, err = backgrounds.Upload(ctx, "hum", constantBed(22050, 0.5))
+	require.NoError(t, err)
+	pcm, err := synthesize()
+	require.NoError(t, err)
+	assert.InDelta(t, 0.5, pcm.Samples[12000], 0.01)
+	assert.Equal(t, 2, repo.reads)
+
+	// 지운 트랙은 더 이상 쓸 수 없음
+	require.NoError(t, backgrounds.Delete(ctx, "hum"))
+	_, err = synthesize()
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func TestBedCache_EvictsBySize(t *testing.T) {
+	repo := &countingBackgroundRepository{memoryBackgroundRepository: newMemoryBackgroundRepository()}
+	for _, name := range []string{"a", "b"} {
+		require.NoError(t, repo.Save(domain.BackgroundTrack{Name: name}, constantBed(8000, 0.1)))
+	}
+	// 8000Hz 모노 1초 트랙 하나가 64000바이트라 하나만 보관
+	cache := newBedCache(100000)
+
+	_, err := cache.get(repo, "a", 8000, 1)
+	require.NoError(t, err)
+	_, err = cache.get(repo, "b", 8000, 1)
+	require.NoError(t, err)
+	_, err = cache.get(repo, "b", 8000, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, repo.reads)
+
+	_, err = cache.get(repo, "a", 8000, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, repo.reads)
+
+	// 형식이 다르면 따로 변환
+	stereo, err := cache.get(repo, "a", 16000, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 16000, stereo.SampleRate)
+	assert.Equal(t, 2, stereo.Channels)
+	assert.Equal(t, 4, repo.reads)
+}
+
+func TestTTSService_Synthesize_BackgroundErrors(t *testing.T) {
+	req := func(opts *domain.BackgroundOptions) *domain.TTSRequest {
+		return &domain.TTSRequest{Text: "hello", Language: "en", Background: opts}
+	}
+	gain := 3.0
+
+	// 저장소가 없거나 트랙이 없으면 잘못된 요청
+	_, err := NewTTSService(paddedToneAdapter(-6)).Synthesize(context.Background(), req(&domain.BackgroundOptions{Track: "hum"}), "v")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	service := NewTTSService(paddedToneAdapter(-6), WithBackgrounds(newMemoryBackgroundRepository(), NewBedCache()))
+	_, err = service.Synthesize(context.Background(), req(&domain.BackgroundOptions{Track: "hum"}), "v")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+
+	for _, opts := range []*domain.BackgroundOptions{{}, {Track: "hum", GainDB: &gain}, {Track: "hum", FadeInMS: -1}, {Track: "hum", TailMS: 120000}} {
+		_, err = service.Synthesize(context.Background(), req(opts), "v")
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	}
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", OutputFormat: domain.FormatMP3, Background: &domain.BackgroundOptions{Track: "hum"},
+	}, "v")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"tts_proxy/internal/domain"
+)
+
+// CircuitBreaker는 업스트림 호출이 연속으로 실패하면 일정 시간 호출을 막아 장애가 번지지 않게 하는 TTSAdapter 데코레이터입니다.
+// 대기 시간이 지나면 시험 요청 하나를 보내 성공하면 다시 닫고, 실패하면 다시 엽니다.
+type CircuitBreaker struct {
+	next TTSAdapter
+	now  func() time.Time
+
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     domain.CircuitState
+	failures  int
+	openedAt  time.Time
+}
+
+// NewCircuitBreaker는 threshold번 연속 실패하면 cooldown 동안 열리는 회로 차단기를 만듭니다. threshold가 0 이하이면 항상 닫혀 있습니다.
+func NewCircuitBreaker(next TTSAdapter, threshold int, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{next: next, threshold: threshold, cooldown: cooldown, now: time.Now, state: domain.CircuitClosed}
+}
+
+// Synthesize는 회로가 열려 있으면 업스트림을 호출하지 않고 domain.ErrUpstreamUnavailable을 반환합니다.
+func (b *CircuitBreaker) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	if !b.allow() {
+		return nil, domain.ErrUpstreamUnavailable
+	}
+	resp, err := b.next.Synthesize(ctx, req, voiceID)
+	b.record(ctx, err)
+	return resp, err
+}
+
+// State는 현재 회로 상태를 반환합니다. 열린 회로의 대기 시간이 지났으면 half_open입니다.
+func (b *CircuitBreaker) State() domain.CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == domain.CircuitOpen && b.now().Sub(b.openedAt) >= b.cooldown {
+		return domain.CircuitHalfOpen
+	}
+	return b.state
+}
+
+// SetLimits는 실패 기준과 대기 시간을 바꿉니다. 회로를 끄면(threshold 0 이하) 닫힌 상태로 돌아가고,
+// 그렇지 않으면 현재 상태와 연속 실패 수를 유지한 채 다음 판단부터 새 값을 사용합니다.
+func (b *CircuitBreaker) SetLimits(threshold int, cooldown time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.threshold, b.cooldown = threshold, cooldown
+	if threshold <= 0 {
+		b.state, b.failures = domain.CircuitClosed, 0
+	}
+}
+
+func (b *CircuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.threshold <= 0 {
+		return true
+	}
+	switch b.state {
+	case domain.CircuitOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = domain.CircuitHalfOpen // 이 요청이 시험 요청
+		return true
+	case domain.CircuitHalfOpen:
+		return false // 시험 요청 결과를 기다리는 중
+	}
+	return true
+}
+
+func (b *CircuitBreaker) record(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.threshold <= 0 {
+		return
+	}
+	if errors.Is(err, context.Canceled) {
+		// 취소된 요청으로는 업스트림 상태를 알 수 없음. 시험 요청이었다면 다음 요청이 다시 시험하도록 열린 상태로 되돌림
+		if b.state == domain.CircuitHalfOpen {
+			b.state = domain.CircuitOpen
+		}
+		return
+	}
+	if !upstreamFailure(err) {
+		if b.state != domain.CircuitClosed {
+			slog.InfoContext(ctx, "upstream circuit closed")
+		}
+		b.state, b.failures = domain.CircuitClosed, 0
+		return
+	}
+	b.failures++
+	if b.state == domain.CircuitHalfOpen || b.failures >= b.threshold {
+		if b.state != domain.CircuitOpen {
+			slog.WarnContext(ctx, "upstream circuit opened", "failures", b.failures, "cooldown", b.cooldown, "error", err)
+		}
+		b.state, b.openedAt = domain.CircuitOpen, b.now()
+	}
+}
+
+// upstreamFailure는 err가 업스트림 장애로 볼 오류인지 반환합니다. 요청 내용 때문에 거절된 경우는 제외합니다.
+func upstreamFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	var upstream *domain.UpstreamError
+	return !(errors.As(err, &upstream) && upstream.ClientError())
+}
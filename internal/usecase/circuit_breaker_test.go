@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	var upstreamErr error
+	calls := 0
+	breaker := NewCircuitBreaker(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			calls++
+			if upstreamErr != nil {
+				return nil, upstreamErr
+			}
+			return &domain.TTSResponse{Audio: []byte("WAV")}, nil
+		},
+	}, 3, 30*time.Second)
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+	synthesize := func() error {
+		_, err := breaker.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, "v1")
+		return err
+	}
+
+	// 요청 내용 때문에 거절되거나 취소된 요청은 장애로 세지 않음
+	upstreamErr = &domain.UpstreamError{StatusCode: 400, Status: "400 Bad Request"}
+	for i := 0; i < 5; i++ {
+		synthesize()
+	}
+	upstreamErr = context.Canceled
+	synthesize()
+	assert.Equal(t, domain.CircuitClosed, breaker.State())
+
+	upstreamErr = &domain.UpstreamError{StatusCode: 503, Status: "503 Service Unavailable"}
+	synthesize()
+	synthesize()
+	assert.Equal(t, domain.CircuitClosed, breaker.State())
+	synthesize()
+	assert.Equal(t, domain.CircuitOpen, breaker.State())
+
+	calls = 0
+	assert.ErrorIs(t, synthesize(), domain.ErrUpstreamUnavailable)
+	assert.Zero(t, calls)
+
+	// 대기 시간이 지나면 시험 요청 하나만 보내고, 실패하면 다시 엶
+	now = now.Add(30 * time.Second)
+	assert.Equal(t, domain.CircuitHalfOpen, breaker.State())
+	upstreamErr = errors.New("connection refused")
+	assert.EqualError(t, synthesize(), "connection refused")
+	assert.Equal(t, domain.CircuitOpen, breaker.State())
+	assert.ErrorIs(t, synthesize(), domain.ErrUpstreamUnavailable)
+	assert.Equal(t, 1, calls)
+
+	// 시험 요청이 성공하면 닫힘
+	now = now.Add(30 * time.Second)
+	upstreamErr = nil
+	assert.NoError(t, synthesize())
+	assert.Equal(t, domain.CircuitClosed, breaker.State())
+}
+
+func TestCircuitBreaker_CancelledTrial(t *testing.T) {
+	upstreamErr := error(&domain.UpstreamError{StatusCode: 503, Status: "503 Service Unavailable"})
+	breaker := NewCircuitBreaker(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return nil, upstreamErr
+		},
+	}, 2, 30*time.Second)
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+	synthesize := func() error {
+		_, err := breaker.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, "v1")
+		return err
+	}
+
+	// 닫힌 회로에서 취소된 요청은 연속 실패 수를 지우지 않음
+	synthesize()
+	upstreamErr = context.Canceled
+	synthesize()
+	upstreamErr = &domain.UpstreamError{StatusCode: 503, Status: "503 Service Unavailable"}
+	synthesize()
+	assert.Equal(t, domain.CircuitOpen, breaker.State())
+
+	// 시험 요청이 취소되면 회로를 닫지 않고, 다음 요청이 바로 다시 시험함
+	now = now.Add(30 * time.Second)
+	upstreamErr = context.Canceled
+	assert.ErrorIs(t, synthesize(), context.Canceled)
+	assert.Equal(t, domain.CircuitHalfOpen, breaker.State())
+	upstreamErr = errors.New("connection refused")
+	assert.EqualError(t, synthesize(), "connection refused")
+	assert.Equal(t, domain.CircuitOpen, breaker.State())
+}
+
+func TestCircuitBreaker_Disabled(t *testing.T) {
+	breaker := NewCircuitBreaker(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return nil, errors.New("down")
+		},
+	}, 0, time.Second)
+	for i := 0; i < 10; i++ {
+		_, err := breaker.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, "v1")
+		assert.EqualError(t, err, "down")
+	}
+	assert.Equal(t, domain.CircuitClosed, breaker.State())
+}
+
+func TestCircuitBreaker_SetLimits(t *testing.T) {
+	breaker := NewCircuitBreaker(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return nil, errors.New("down")
+		},
+	}, 5, time.Minute)
+	synthesize := func() error {
+		_, err := breaker.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, "v1")
+		return err
+	}
+
+	// 낮춘 기준은 이미 센 실패 수에 바로 적용됨
+	synthesize()
+	breaker.SetLimits(2, time.Minute)
+	synthesize()
+	assert.Equal(t, domain.CircuitOpen, breaker.State())
+
+	// 회로를 끄면 닫힌 상태로 돌아가 모든 요청을 보냄
+	breaker.SetLimits(0, time.Minute)
+	assert.Equal(t, domain.CircuitClosed, breaker.State())
+	assert.EqualError(t, synthesize(), "down")
+}
@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+const (
+	maxDialogueLines = 200
+	maxDialoguePause = 10 * time.Second
+)
+
+// dialogueService는 대화의 각 줄을 TTSService로 병렬 합성한 뒤 순서대로 배치해 하나의 WAV로 믹스합니다.
+type dialogueService struct {
+	tts         domain.TTSService
+	aliases     domain.VoiceAliasRegistry
+	concurrency int
+}
+
+// NewDialogueService는 DialogueService 구현체를 생성합니다. concurrency는 동시에 합성할 최대 줄 수이며,
+// aliases가 nil이면 voice_id를 그대로 Voice ID로 사용합니다.
+func NewDialogueService(tts domain.TTSService, aliases domain.VoiceAliasRegistry, concurrency int) domain.DialogueService {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &dialogueService{tts: tts, aliases: aliases, concurrency: concurrency}
+}
+
+// Render는 대화 스크립트를 합성하고 줄별 시작/끝 시각을 담은 타임라인을 만듭니다.
+func (s *dialogueService) Render(ctx context.Context, req *domain.DialogueRequest) (*domain.DialogueResult, error) {
+	voices, err := dialogueVoices(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	clips, err := s.synthesizeLines(ctx, req, voices)
+	if err != nil {
+		return nil, err
+	}
+
+	// 음성마다 업스트림의 표본화율이나 채널 수가 다를 수 있으므로 첫 줄의 형식으로 맞춘 뒤 믹스
+	audio.ConvertAll(clips)
+
+	tracks := make([]audio.Track, len(clips))
+	timeline := make([]domain.DialogueTimelineEntry, len(clips))
+	var cursor time.Duration
+	for i, line := range req.Lines {
+		start := cursor
+		end := start + clips[i].Duration()
+		tracks[i] = audio.Track{Clip: clips[i], Offset: start}
+		timeline[i] = domain.DialogueTimelineEntry{
+			Index: i, Speaker: line.Speaker, VoiceID: voices[i], Text: line.Text, Start: start, End: end,
+		}
+		// 겹쳐 말하기(음수 간격)라도 다음 줄이 이 줄보다 먼저 시작하지는 않음
+		cursor = max(start, end+time.Duration(line.PauseAfterMs)*time.Millisecond)
+	}
+
+	mixed, err := audio.Mix(tracks...)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.DialogueResult{Audio: audio.EncodeWAV(mixed), Duration: mixed.Duration(), Timeline: timeline}, nil
+}
+
+// synthesizeLines는 최대 concurrency개의 줄을 동시에 합성합니다. 한 줄이라도 실패하면 나머지를 취소합니다.
+func (s *dialogueService) synthesizeLines(ctx context.Context, req *domain.DialogueRequest, voices []string) ([]*audio.PCM, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	clips := make([]*audio.PCM, len(req.Lines))
+	sem := make(chan struct{}, s.concurrency)
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	for i := range req.Lines {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			clip, err := s.synthesizeLine(ctx, req, req.Lines[i], voices[i])
+			if err != nil {
+				once.Do(func() {
+					firstErr = fmt.Errorf("line %d: %w", i, err)
+					cancel()
+				})
+				return
+			}
+			clips[i] = clip
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return clips, nil
+}
+
+func (s *dialogueService) synthesizeLine(ctx context.Context, req *domain.DialogueRequest, line domain.DialogueLine, voice string) (*audio.PCM, error) {
+	ttsReq := &domain.TTSRequest{
+		Text:          line.Text,
+		Language:      req.Language,
+		Style:         line.Style,
+		Model:         req.Model,
+		VoiceSettings: req.VoiceSettings,
+	}
+	voiceID := voice
+	if s.aliases != nil {
+		if alias, ok := s.aliases.Resolve(voice); ok {
+			voiceID = alias.VoiceID
+			ttsReq.ApplyDefaults(alias.Defaults)
+		}
+	}
+
+	resp, err := s.tts.Synthesize(ctx, ttsReq, voiceID)
+	if err != nil {
+		return nil, err
+	}
+	return audio.DecodeWAV(resp.Audio)
+}
+
+// dialogueVoices는 요청을 검증하고 줄마다 사용할 음성을 정합니다. voice_id가 없는 줄은 같은 화자의 앞 줄 음성을 씁니다.
+func dialogueVoices(lines []domain.DialogueLine) ([]string, error) {
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: lines are required", domain.ErrInvalidRequest)
+	}
+	if len(lines) > maxDialogueLines {
+		return nil, fmt.Errorf("%w: too many lines (max %d)", domain.ErrInvalidRequest, maxDialogueLines)
+	}
+
+	voices := make([]string, len(lines))
+	speakerVoices := map[string]string{}
+	for i, line := range lines {
+		if strings.TrimSpace(line.Text) == "" {
+			return nil, fmt.Errorf("%w: line %d: text is required", domain.ErrInvalidRequest, i)
+		}
+		if time.Duration(line.PauseAfterMs)*time.Millisecond > maxDialoguePause {
+			return nil, fmt.Errorf("%w: line %d: pause_after_ms exceeds %d", domain.ErrInvalidRequest, i, maxDialoguePause.Milliseconds())
+		}
+
+		voice := line.VoiceID
+		if voice == "" {
+			voice = speakerVoices[line.Speaker]
+		}
+		if voice == "" {
+			return nil, fmt.Errorf("%w: line %d: voice_id is required for speaker %q", domain.ErrInvalidRequest, i, line.Speaker)
+		}
+		if line.Speaker != "" {
+			speakerVoices[line.Speaker] = voice
+		}
+		voices[i] = voice
+	}
+	return voices, nil
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+// lineTTSService는 텍스트 길이 * 10ms 길이의 WAV를 반환합니다. 앞 줄일수록 늦게 응답해 순서 보존을 확인합니다.
+type lineTTSService struct {
+	mu       sync.Mutex
+	requests map[string]domain.TTSRequest
+	voiceIDs map[string]string
+	active   int32
+	peak     int32
+	fail     string
+}
+
+func (s *lineTTSService) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	n := atomic.AddInt32(&s.active, 1)
+	defer atomic.AddInt32(&s.active, -1)
+	for {
+		p := atomic.LoadInt32(&s.peak)
+		if n <= p || atomic.CompareAndSwapInt32(&s.peak, p, n) {
+			break
+		}
+	}
+
+	if req.Text == s.fail {
+		return nil, errors.New("upstream failed")
+	}
+	time.Sleep(time.Duration(20-len(req.Text)) * time.Millisecond)
+
+	s.mu.Lock()
+	s.requests[req.Text] = *req
+	s.voiceIDs[req.Text] = voiceID
+	s.mu.Unlock()
+
+	pcm := audio.Silence(1000, 1, time.Duration(len(req.Text))*10*time.Millisecond)
+	for i := range pcm.Samples {
+		pcm.Samples[i] = 0.1
+	}
+	return &domain.TTSResponse{Audio: audio.EncodeWAV(pcm), Format: "wav"}, nil
+}
+
+func newLineTTSService() *lineTTSService {
+	return &lineTTSService{requests: map[string]domain.TTSRequest{}, voiceIDs: map[string]string{}}
+}
+
+func TestDialogueService_Render(t *testing.T) {
+	tts := newLineTTSService()
+	aliases := NewVoiceAliasRegistry([]domain.VoiceAlias{{
+		Name: "teacher", VoiceID: "teacher-voice", Defaults: domain.TTSDefaults{Style: "calm"},
+	}})
+	service := NewDialogueService(tts, aliases, 2)
+
+	result, err := service.Render(context.Background(), &domain.DialogueRequest{
+		Language: "en",
+		Lines: []domain.DialogueLine{
+			{Speaker: "A", VoiceID: "teacher", Text: "Hello!", PauseAfterMs: 100},
+			{Speaker: "B", VoiceID: "student-voice", Text: "Hi there", Style: "happy"},
+			{Speaker: "A", Text: "How are you?", PauseAfterMs: -50},
+			{Speaker: "B", Text: "Good"},
+		},
+	})
+	assert.NoError(t, err)
+
+	// "Hello!" 60ms + 100ms 쉼, "Hi there" 80ms, "How are you?" 120ms에서 50ms 겹침, "Good" 40ms
+	assert.Equal(t, []domain.DialogueTimelineEntry{
+		{Index: 0, Speaker: "A", VoiceID: "teacher", Text: "Hello!", Start: 0, End: 60 * time.Millisecond},
+		{Index: 1, Speaker: "B", VoiceID: "student-voice", Text: "Hi there", Start: 160 * time.Millisecond, End: 240 * time.Millisecond},
+		{Index: 2, Speaker: "A", VoiceID: "teacher", Text: "How are you?", Start: 240 * time.Millisecond, End: 360 * time.Millisecond},
+		{Index: 3, Speaker: "B", VoiceID: "student-voice", Text: "Good", Start: 310 * time.Millisecond, End: 350 * time.Millisecond},
+	}, result.Timeline)
+	assert.Equal(t, 360*time.Millisecond, result.Duration)
+
+	pcm, err := audio.DecodeWAV(result.Audio)
+	assert.NoError(t, err)
+	assert.Equal(t, 360, pcm.Frames())
+	assert.InDelta(t, 0.2, pcm.Samples[320], 0.001) // 겹친 구간은 두 줄이 더해짐
+
+	// 별칭은 실제 Voice ID와 기본값으로 해석되고, 줄의 style이 우선함
+	assert.Equal(t, "teacher-voice", tts.voiceIDs["Hello!"])
+	assert.Equal(t, "calm", tts.requests["Hello!"].Style)
+	assert.Equal(t, "happy", tts.requests["Hi there"].Style)
+	assert.Equal(t, "en", tts.requests["Good"].Language)
+	assert.LessOrEqual(t, tts.peak, int32(2))
+}
+
+func TestDialogueService_RenderFailure(t *testing.T) {
+	tts := newLineTTSService()
+	tts.fail = "boom"
+	service := NewDialogueService(tts, nil, 4)
+
+	_, err := service.Render(context.Background(), &domain.DialogueRequest{Lines: []domain.DialogueLine{
+		{Speaker: "A", VoiceID: "v1", Text: "fine"},
+		{Speaker: "B", VoiceID: "v2", Text: "boom"},
+	}})
+	assert.ErrorContains(t, err, "line 1: upstream failed")
+}
+
+func TestDialogueService_RenderValidation(t *testing.T) {
+	service := NewDialogueService(newLineTTSService(), nil, 4)
+
+	tests := []struct {
+		name  string
+		lines []domain.DialogueLine
+	}{
+		{"no lines", nil},
+		{"empty text", []domain.DialogueLine{{Speaker: "A", VoiceID: "v1", Text: " "}}},
+		{"unknown speaker voice", []domain.DialogueLine{{Speaker: "A", Text: "hi"}}},
+		{"pause too long", []domain.DialogueLine{{Speaker: "A", VoiceID: "v1", Text: "hi", PauseAfterMs: 60000}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Render(context.Background(), &domain.DialogueRequest{Lines: tt.lines})
+			assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+		})
+	}
+}
+
+func TestDialogueService_RenderMixedFormats(t *testing.T) {
+	formats := map[string][2]int{"mono-24k": {24000, 1}, "stereo-44k": {44100, 2}, "mono-16k": {16000, 1}}
+	tts := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			f := formats[voiceID]
+			return &domain.TTSResponse{Audio: audio.EncodeWAV(audio.Silence(f[0], f[1], 100*time.Millisecond)), Format: "wav"}, nil
+		},
+	}
+	service := NewDialogueService(tts, nil, 2)
+
+	result, err := service.Render(context.Background(), &domain.DialogueRequest{
+		Lines: []domain.DialogueLine{
+			{Speaker: "A", VoiceID: "mono-24k", Text: "Hello"},
+			{Speaker: "B", VoiceID: "stereo-44k", Text: "Hi"},
+			{Speaker: "C", VoiceID: "mono-16k", Text: "Hey"},
+		},
+	})
+
+	require.NoError(t, err)
+	mixed, err := audio.DecodeWAV(result.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, 24000, mixed.SampleRate) // 첫 줄의 형식
+	assert.Equal(t, 1, mixed.Channels)
+	assert.Equal(t, 300*time.Millisecond, mixed.Duration())
+	assert.Equal(t, 300*time.Millisecond, result.Timeline[2].End)
+}
@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+	"tts_proxy/pkg/langdetect"
+)
+
+// UpstreamProber는 업스트림 API에 연결할 수 있는지 확인합니다.
+type UpstreamProber interface {
+	Ping(ctx context.Context) error
+}
+
+// CircuitStater는 회로 차단기 상태를 알려줍니다.
+type CircuitStater interface {
+	State() domain.CircuitState
+}
+
+// placeholderAPIKey는 env.example의 자리 표시자로, 실제 키가 설정되지 않은 것으로 봅니다.
+const placeholderAPIKey = "use_secret_file"
+
+// HealthConfig는 healthService 설정입니다.
+type HealthConfig struct {
+	APIURL string
+	APIKey string
+	// Credentials가 있으면 APIURL, APIKey 대신 점검할 때마다 호출해 현재 값을 확인합니다 (설정을 다시 읽는 경우).
+	Credentials func() (apiURL, apiKey string)
+
+	Prober       UpstreamProber // nil이면 업스트림 연결을 확인하지 않음
+	ProbeTimeout time.Duration  // 업스트림 연결 확인 제한 시간, 0이면 2초
+	Circuit      CircuitStater  // nil이면 회로 상태를 확인하지 않음
+
+	// Adapter로 CanaryVoiceID 음성의 CanaryText를 합성해 실제 합성 가능 여부를 확인합니다.
+	Adapter       TTSAdapter
+	CanaryVoiceID string        // 비어 있으면 카나리 합성을 건너뜀
+	CanaryText    string        // 비어 있으면 "Health check."
+	CanaryTTL     time.Duration // 성공한 카나리 결과를 캐시할 시간, 0이면 1분 (실패는 최대 10초)
+	CanaryTimeout time.Duration // 0이면 10초
+}
+
+// healthService는 HealthService의 실제 구현체입니다.
+type healthService struct {
+	cfg      HealthConfig
+	now      func() time.Time
+	draining atomic.Bool
+
+	mu       sync.Mutex // 카나리 합성을 한 번에 하나만 실행
+	canary   *domain.HealthCheck
+	canaryAt time.Time
+}
+
+// NewHealthService는 HealthService 구현체를 생성합니다.
+func NewHealthService(cfg HealthConfig) domain.HealthService {
+	if cfg.ProbeTimeout <= 0 {
+		cfg.ProbeTimeout = 2 * time.Second
+	}
+	if cfg.CanaryText == "" {
+		cfg.CanaryText = "Health check."
+	}
+	if cfg.CanaryTTL <= 0 {
+		cfg.CanaryTTL = time.Minute
+	}
+	if cfg.CanaryTimeout <= 0 {
+		cfg.CanaryTimeout = 10 * time.Second
+	}
+	return &healthService{cfg: cfg, now: time.Now}
+}
+
+func (s *healthService) Live(ctx context.Context) *domain.HealthReport {
+	return s.report(domain.HealthCheck{Name: "process", Status: domain.HealthOK})
+}
+
+func (s *healthService) Ready(ctx context.Context) *domain.HealthReport {
+	if s.draining.Load() {
+		return s.report(drainingCheck)
+	}
+	return s.report(s.readyChecks(ctx)...)
+}
+
+func (s *healthService) Deep(ctx context.Context) *domain.HealthReport {
+	if s.draining.Load() {
+		return s.report(drainingCheck)
+	}
+	return s.report(append(s.readyChecks(ctx), s.canaryCheck(ctx))...)
+}
+
+// drainingCheck는 종료 중일 때 Ready와 Deep이 반환하는 점검 결과입니다.
+// 로드 밸런서가 새 요청을 보내지 않도록 업스트림 확인 없이 바로 실패합니다.
+var drainingCheck = domain.HealthCheck{Name: "shutdown", Status: domain.HealthFail, Message: "server is shutting down"}
+
+func (s *healthService) Drain() {
+	s.draining.Store(true)
+}
+
+func (s *healthService) report(checks ...domain.HealthCheck) *domain.HealthReport {
+	status := domain.HealthOK
+	for _, c := range checks {
+		if c.Status == domain.HealthFail {
+			status = domain.HealthFail
+		}
+	}
+	return &domain.HealthReport{Status: status, CheckedAt: s.now().UTC(), Checks: checks}
+}
+
+func (s *healthService) readyChecks(ctx context.Context) []domain.HealthCheck {
+	return []domain.HealthCheck{
+		s.configCheck(),
+		s.secretsCheck(),
+		s.upstreamCheck(ctx),
+		s.circuitCheck(),
+	}
+}
+
+func (s *healthService) credentials() (apiURL, apiKey string) {
+	if s.cfg.Credentials != nil {
+		return s.cfg.Credentials()
+	}
+	return s.cfg.APIURL, s.cfg.APIKey
+}
+
+func (s *healthService) configCheck() domain.HealthCheck {
+	check := domain.HealthCheck{Name: "config", Status: domain.HealthOK}
+	apiURL, _ := s.credentials()
+	u, err := url.Parse(apiURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		check.Status, check.Message = domain.HealthFail, fmt.Sprintf("invalid TTS API URL %q", apiURL)
+	}
+	return check
+}
+
+func (s *healthService) secretsCheck() domain.HealthCheck {
+	check := domain.HealthCheck{Name: "secrets", Status: domain.HealthOK}
+	if _, apiKey := s.credentials(); apiKey == "" || apiKey == placeholderAPIKey {
+		check.Status, check.Message = domain.HealthFail, "TTS API key is not configured"
+	}
+	return check
+}
+
+func (s *healthService) upstreamCheck(ctx context.Context) domain.HealthCheck {
+	check := domain.HealthCheck{Name: "upstream", Status: domain.HealthOK}
+	if s.cfg.Prober == nil {
+		check.Status = domain.HealthSkipped
+		return check
+	}
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.ProbeTimeout)
+	defer cancel()
+	start := s.now()
+	err := s.cfg.Prober.Ping(ctx)
+	check.Duration = s.now().Sub(start).Milliseconds()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		check.Status, check.Message = domain.HealthFail, fmt.Sprintf("no response within %s", s.cfg.ProbeTimeout)
+	} else if err != nil {
+		check.Status, check.Message = domain.HealthFail, err.Error()
+	}
+	return check
+}
+
+func (s *healthService) circuitCheck() domain.HealthCheck {
+	check := domain.HealthCheck{Name: "circuit", Status: domain.HealthOK}
+	if s.cfg.Circuit == nil {
+		check.Status = domain.HealthSkipped
+		return check
+	}
+	state := s.cfg.Circuit.State()
+	check.Details = map[string]any{"state": state}
+	if state == domain.CircuitOpen {
+		check.Status, check.Message = domain.HealthFail, "upstream circuit is open"
+	}
+	return check
+}
+
+// canaryCheck는 캐시가 유효하면 캐시된 결과를, 아니면 새로 카나리 합성을 수행한 결과를 반환합니다.
+func (s *healthService) canaryCheck(ctx context.Context) domain.HealthCheck {
+	if s.cfg.Adapter == nil || s.cfg.CanaryVoiceID == "" {
+		return domain.HealthCheck{Name: "canary", Status: domain.HealthSkipped, Message: "canary voice is not configured"}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ttl := s.cfg.CanaryTTL
+	if s.canary != nil && s.canary.Status == domain.HealthFail {
+		ttl = min(ttl, 10*time.Second) // 실패는 빨리 다시 확인
+	}
+	if s.canary != nil && s.now().Sub(s.canaryAt) < ttl {
+		cached := *s.canary
+		cached.Details = map[string]any{"cached": true, "checked_at": s.canaryAt.UTC()}
+		for k, v := range s.canary.Details {
+			cached.Details[k] = v
+		}
+		return cached
+	}
+
+	check := s.synthesizeCanary(ctx)
+	s.canary, s.canaryAt = &check, s.now()
+	return check
+}
+
+func (s *healthService) synthesizeCanary(ctx context.Context) domain.HealthCheck {
+	check := domain.HealthCheck{Name: "canary", Status: domain.HealthOK}
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.CanaryTimeout)
+	defer cancel()
+
+	start := s.now()
+	resp, err := s.cfg.Adapter.Synthesize(ctx, &domain.TTSRequest{
+		Text:     s.cfg.CanaryText,
+		Language: langdetect.Detect(s.cfg.CanaryText).Language,
+	}, s.cfg.CanaryVoiceID)
+	check.Duration = s.now().Sub(start).Milliseconds()
+	if err != nil {
+		check.Status, check.Message = domain.HealthFail, err.Error()
+		return check
+	}
+	check.Details = map[string]any{"voice_id": s.cfg.CanaryVoiceID, "bytes": len(resp.Audio)}
+	if len(resp.Audio) == 0 {
+		check.Status, check.Message = domain.HealthFail, "canary synthesis returned no audio"
+		return check
+	}
+	if resp.Format == domain.FormatWAV {
+		pcm, err := audio.DecodeWAV(resp.Audio)
+		if err != nil {
+			check.Status, check.Message = domain.HealthFail, fmt.Sprintf("canary synthesis returned invalid wav: %v", err)
+			return check
+		}
+		check.Details["audio_ms"] = pcm.Duration().Milliseconds()
+	}
+	return check
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+)
+
+type mockProber struct {
+	err   error
+	delay time.Duration
+}
+
+func (m *mockProber) Ping(ctx context.Context) error {
+	select {
+	case <-time.After(m.delay):
+		return m.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type fixedCircuit domain.CircuitState
+
+func (c fixedCircuit) State() domain.CircuitState { return domain.CircuitState(c) }
+
+func checksByName(report *domain.HealthReport) map[string]domain.HealthCheck {
+	checks := make(map[string]domain.HealthCheck)
+	for _, c := range report.Checks {
+		checks[c.Name] = c
+	}
+	return checks
+}
+
+func TestHealthService_Ready(t *testing.T) {
+	service := NewHealthService(HealthConfig{
+		APIURL: "https://supertoneapi.com", APIKey: "sk-live",
+		Prober: &mockProber{}, Circuit: fixedCircuit(domain.CircuitClosed),
+	})
+	report := service.Ready(context.Background())
+	assert.True(t, report.OK())
+	assert.Len(t, report.Checks, 4)
+	assert.True(t, service.Live(context.Background()).OK())
+
+	service = NewHealthService(HealthConfig{
+		APIURL: "supertoneapi.com", APIKey: "use_secret_file",
+		Prober: &mockProber{delay: time.Second}, ProbeTimeout: 10 * time.Millisecond,
+		Circuit: fixedCircuit(domain.CircuitOpen),
+	})
+	report = service.Ready(context.Background())
+	assert.False(t, report.OK())
+	checks := checksByName(report)
+	assert.Equal(t, domain.HealthFail, checks["config"].Status)
+	assert.Equal(t, domain.HealthFail, checks["secrets"].Status)
+	assert.Equal(t, "no response within 10ms", checks["upstream"].Message)
+	assert.Equal(t, "upstream circuit is open", checks["circuit"].Message)
+
+	// 연결 확인과 회로 차단기가 없으면 건너뜀
+	report = NewHealthService(HealthConfig{APIURL: "http://localhost:9000", APIKey: "k"}).Ready(context.Background())
+	assert.True(t, report.OK())
+	assert.Equal(t, domain.HealthSkipped, checksByName(report)["upstream"].Status)
+}
+
+func TestHealthService_Credentials(t *testing.T) {
+	apiKey := "use_secret_file"
+	service := NewHealthService(HealthConfig{
+		Credentials: func() (string, string) { return "https://supertoneapi.com", apiKey },
+	})
+	assert.Equal(t, domain.HealthFail, checksByName(service.Ready(context.Background()))["secrets"].Status)
+
+	// 키를 바꾸면 다음 점검부터 반영
+	apiKey = "sk-rotated"
+	assert.True(t, service.Ready(context.Background()).OK())
+}
+
+func TestHealthService_Drain(t *testing.T) {
+	service := NewHealthService(HealthConfig{
+		APIURL: "https://supertoneapi.com", APIKey: "sk-live",
+		Prober: &mockProber{err: errors.New("must not be called")},
+	})
+	service.Drain()
+
+	for _, report := range []*domain.HealthReport{service.Ready(context.Background()), service.Deep(context.Background())} {
+		assert.False(t, report.OK())
+		assert.Equal(t, "server is shutting down", checksByName(report)["shutdown"].Message)
+		assert.Len(t, report.Checks, 1)
+	}
+	assert.True(t, service.Live(context.Background()).OK())
+}
+
+func TestHealthService_DeepCanaryCache(t *testing.T) {
+	calls := 0
+	var failing bool
+	service := NewHealthService(HealthConfig{
+		APIURL: "https://supertoneapi.com", APIKey: "sk-live",
+		Adapter: &mockTTSAdapter{
+			SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+				calls++
+				assert.Equal(t, "canary-voice", voiceID)
+				assert.Equal(t, "en", req.Language)
+				if failing {
+					return nil, errors.New("TTS API error: 503 Service Unavailable")
+				}
+				return (&wavAdapter{}).Synthesize(ctx, req, voiceID)
+			},
+		},
+		CanaryVoiceID: "canary-voice",
+		CanaryTTL:     time.Minute,
+	}).(*healthService)
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	report := service.Deep(context.Background())
+	require.True(t, report.OK())
+	canary := checksByName(report)["canary"]
+	assert.Equal(t, int64(100), canary.Details["audio_ms"])
+	assert.NotContains(t, canary.Details, "cached")
+
+	now = now.Add(30 * time.Second)
+	canary = checksByName(service.Deep(context.Background()))["canary"]
+	assert.Equal(t, true, canary.Details["cached"])
+	assert.Equal(t, 1, calls)
+
+	// 캐시가 만료되면 다시 합성하고, 실패는 10초만 캐시
+	now = now.Add(time.Minute)
+	failing = true
+	report = service.Deep(context.Background())
+	assert.False(t, report.OK())
+	assert.Equal(t, "TTS API error: 503 Service Unavailable", checksByName(report)["canary"].Message)
+	now = now.Add(11 * time.Second)
+	failing = false
+	assert.True(t, service.Deep(context.Background()).OK())
+	assert.Equal(t, 3, calls)
+}
+
+func TestHealthService_DeepWithoutCanary(t *testing.T) {
+	report := NewHealthService(HealthConfig{APIURL: "https://supertoneapi.com", APIKey: "k"}).Deep(context.Background())
+	assert.True(t, report.OK())
+	assert.Equal(t, domain.HealthSkipped, checksByName(report)["canary"].Status)
+}
@@ -0,0 +1,45 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+	"tts_proxy/pkg/langdetect"
+)
+
+// synthesizeLanguages는 언어별로 나눈 텍스트를 각각의 language로 합성한 뒤 하나의 WAV로 이어 붙입니다.
+// 글자가 없어 언어를 정하지 못한 세그먼트는 요청의 language를 사용합니다.
+func (s *ttsService) synthesizeLanguages(ctx context.Context, req *domain.TTSRequest, voiceID string, segments []langdetect.Segment) (*domain.TTSResponse, error) {
+	clips := make([]*audio.PCM, 0, len(segments))
+	for i, seg := range segments {
+		segReq := *req
+		if seg.Language != "" {
+			segReq.Language = seg.Language
+		}
+
+		var err error
+		segReq.Text, err = s.prepareText(ctx, seg.Text, &segReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.adapter.Synthesize(ctx, &segReq, voiceID)
+		if err != nil {
+			return nil, err
+		}
+		pcm, err := audio.DecodeWAV(resp.Audio)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode segment %d: %w", i, err)
+		}
+		clips = append(clips, pcm)
+	}
+
+	audio.ConvertAll(clips)
+	joined, err := audio.Concat(clips...)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.TTSResponse{Audio: audio.EncodeWAV(joined), Format: "wav"}, nil
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+func TestTTSService_Synthesize_DetectsLanguage(t *testing.T) {
+	adapter := &wavAdapter{}
+	service := NewTTSService(adapter)
+
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "사과 3개"}, "voice-123")
+	assert.NoError(t, err)
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "I have 3 apples"}, "voice-123")
+	assert.NoError(t, err)
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "Hello", Language: "ja"}, "voice-123")
+	assert.NoError(t, err)
+
+	assert.Len(t, adapter.requests, 3)
+	assert.Equal(t, "ko", adapter.requests[0].Language)
+	assert.Equal(t, "사과 세 개", adapter.requests[0].Text)
+	assert.Equal(t, "en", adapter.requests[1].Language)
+	assert.Equal(t, "I have three apples", adapter.requests[1].Text)
+	// 명시한 language는 그대로 사용
+	assert.Equal(t, "ja", adapter.requests[2].Language)
+}
+
+func TestTTSService_Synthesize_SplitLanguages(t *testing.T) {
+	adapter := &wavAdapter{}
+	service := NewTTSService(adapter)
+
+	req := &domain.TTSRequest{Text: "안녕하세요. Nice to meet you. こんにちは。", SplitLanguages: true}
+	resp, err := service.Synthesize(context.Background(), req, "voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "wav", resp.Format)
+
+	assert.Len(t, adapter.requests, 3)
+	assert.Equal(t, []string{"ko", "en", "ja"}, []string{
+		adapter.requests[0].Language, adapter.requests[1].Language, adapter.requests[2].Language,
+	})
+	assert.Equal(t, "Nice to meet you.", adapter.requests[1].Text)
+
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	assert.NoError(t, err)
+	assert.Equal(t, 8000*3/10, pcm.Frames())
+}
+
+func TestTTSService_Synthesize_SplitLanguagesMixedFormats(t *testing.T) {
+	// 언어마다 업스트림이 다른 형식을 돌려줘도 첫 구간의 형식으로 이어 붙임
+	formats := map[string][2]int{"ko": {24000, 1}, "en": {44100, 2}}
+	service := NewTTSService(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			f := formats[req.Language]
+			return &domain.TTSResponse{Audio: audio.EncodeWAV(audio.Silence(f[0], f[1], 100*time.Millisecond)), Format: "wav"}, nil
+		},
+	})
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "안녕하세요. Nice to meet you.", SplitLanguages: true}, "voice-123")
+	require.NoError(t, err)
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, 24000, pcm.SampleRate)
+	assert.Equal(t, 1, pcm.Channels)
+	assert.Equal(t, 200*time.Millisecond, pcm.Duration())
+}
+
+func TestTTSService_Synthesize_SplitLanguagesSingleLanguage(t *testing.T) {
+	adapter := &wavAdapter{}
+	service := NewTTSService(adapter)
+
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "Hello. How are you?", SplitLanguages: true}, "voice-123")
+	assert.NoError(t, err)
+	assert.Len(t, adapter.requests, 1)
+	assert.Equal(t, "en", adapter.requests[0].Language)
+}
+
+func TestTTSService_Synthesize_SSMLDetectsLanguage(t *testing.T) {
+	adapter := &wavAdapter{}
+	service := NewTTSService(adapter)
+
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: `<speak>안녕하세요<break time="100ms"/>Good morning</speak>`}, "voice-123")
+	assert.NoError(t, err)
+	assert.Len(t, adapter.requests, 2)
+	assert.Equal(t, "ko", adapter.requests[0].Language)
+	assert.Equal(t, "en", adapter.requests[1].Language)
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"tts_proxy/internal/domain"
+)
+
+// lexiconService는 LexiconService의 실제 구현체입니다.
+type lexiconService struct {
+	repo domain.LexiconRepository
+	now  func() time.Time
+	mu   sync.Mutex
+}
+
+// NewLexiconService는 LexiconService 구현체를 생성합니다.
+func NewLexiconService(repo domain.LexiconRepository) domain.LexiconService {
+	return &lexiconService{repo: repo, now: time.Now}
+}
+
+// Create는 새 발음 사전을 저장합니다. 같은 이름이 있으면 ErrLexiconExists를 반환합니다.
+func (s *lexiconService) Create(ctx context.Context, lexicon domain.Lexicon) (*domain.Lexicon, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	userID := domain.UserIDFromContext(ctx)
+	if _, err := s.repo.Get(userID, lexicon.Name); err == nil {
+		return nil, domain.ErrLexiconExists
+	} else if !errors.Is(err, domain.ErrLexiconNotFound) {
+		return nil, err
+	}
+	return s.save(userID, lexicon)
+}
+
+// Get은 이름으로 발음 사전을 조회합니다.
+func (s *lexiconService) Get(ctx context.Context, name string) (*domain.Lexicon, error) {
+	return s.repo.Get(domain.UserIDFromContext(ctx), name)
+}
+
+// List는 사용자의 발음 사전 목록을 반환합니다.
+func (s *lexiconService) List(ctx context.Context) ([]domain.Lexicon, error) {
+	return s.repo.List(domain.UserIDFromContext(ctx))
+}
+
+// Put은 발음 사전을 생성하거나 같은 이름의 사전을 교체합니다.
+func (s *lexiconService) Put(ctx context.Context, lexicon domain.Lexicon) (*domain.Lexicon, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.save(domain.UserIDFromContext(ctx), lexicon)
+}
+
+// Delete는 발음 사전을 삭제합니다.
+func (s *lexiconService) Delete(ctx context.Context, name string) error {
+	return s.repo.Delete(domain.UserIDFromContext(ctx), name)
+}
+
+func (s *lexiconService) save(userID string, lexicon domain.Lexicon) (*domain.Lexicon, error) {
+	if err := lexicon.Validate(); err != nil {
+		return nil, err
+	}
+	lexicon.UpdatedAt = s.now()
+	if err := s.repo.Save(userID, lexicon); err != nil {
+		return nil, err
+	}
+	return &lexicon, nil
+}
+
+// maxCachedRegexps는 컴파일해 둘 사전 정규식의 최대 개수입니다.
+const maxCachedRegexps = 1024
+
+// regexCache는 사전 정규식을 요청마다 다시 컴파일하지 않도록 보관합니다.
+var regexCache = newRegexpCache(maxCachedRegexps)
+
+// regexpCache는 최근에 쓴 정규식만 보관하는 LRU 캐시입니다.
+// 패턴은 사용자가 정하므로 개수를 제한하지 않으면 사전을 바꿀 때마다 메모리가 늘어납니다.
+type regexpCache struct {
+	mu    sync.Mutex
+	max   int
+	order *list.List // 앞쪽일수록 최근에 사용, 값은 *regexpCacheEntry
+	items map[string]*list.Element
+}
+
+type regexpCacheEntry struct {
+	pattern string
+	re      *regexp.Regexp
+}
+
+func newRegexpCache(max int) *regexpCache {
+	return &regexpCache{max: max, order: list.New(), items: make(map[string]*list.Element)}
+}
+
+// get은 pattern을 컴파일한 정규식을 반환합니다. 캐시가 가득 차면 가장 오래 쓰지 않은 정규식을 버립니다.
+func (c *regexpCache) get(pattern string) (*regexp.Regexp, error) {
+	c.mu.Lock()
+	if el, ok := c.items[pattern]; ok {
+		c.order.MoveToFront(el)
+		c.mu.Unlock()
+		return el.Value.(*regexpCacheEntry).re, nil
+	}
+	c.mu.Unlock()
+
+	// 컴파일하는 동안 다른 요청이 기다리지 않도록 잠금 밖에서 컴파일
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[pattern]; ok {
+		c.order.MoveToFront(el)
+		return el.Value.(*regexpCacheEntry).re, nil
+	}
+	c.items[pattern] = c.order.PushFront(&regexpCacheEntry{pattern: pattern, re: re})
+	if c.order.Len() > c.max {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*regexpCacheEntry).pattern)
+	}
+	return re, nil
+}
+
+// applyLexicons는 사전 순서대로 항목을 적용합니다. 사전 안에서는 긴 표기부터 치환해 부분 일치를 피합니다.
+func applyLexicons(lexicons []domain.Lexicon, text, language string) string {
+	for _, lex := range lexicons {
+		entries := make([]domain.LexiconEntry, 0, len(lex.Entries))
+		for _, e := range lex.Entries {
+			lang := e.Language
+			if lang == "" {
+				lang = lex.Language
+			}
+			if matchLanguage(lang, language) {
+				entries = append(entries, e)
+			}
+		}
+		sort.SliceStable(entries, func(i, j int) bool {
+			return utf8.RuneCountInString(entries[i].Grapheme) > utf8.RuneCountInString(entries[j].Grapheme)
+		})
+
+		for _, e := range entries {
+			text = applyEntry(e, text)
+		}
+	}
+	return text
+}
+
+// matchLanguage는 사전 언어가 비어 있거나 요청 언어와 같은 언어군("ko"와 "ko-KR")이면 true입니다.
+func matchLanguage(lexiconLang, requestLang string) bool {
+	if lexiconLang == "" {
+		return true
+	}
+	base := func(l string) string {
+		l = strings.ToLower(l)
+		if i := strings.IndexAny(l, "-_"); i >= 0 {
+			return l[:i]
+		}
+		return l
+	}
+	return base(lexiconLang) == base(requestLang)
+}
+
+func applyEntry(e domain.LexiconEntry, text string) string {
+	switch e.Match {
+	case domain.MatchRegex:
+		re, err := regexCache.get(e.Grapheme)
+		if err != nil {
+			return text
+		}
+		return re.ReplaceAllString(text, e.Replacement)
+	case domain.MatchSubstring:
+		return strings.ReplaceAll(text, e.Grapheme, e.Replacement)
+	}
+	return replaceWord(text, e.Grapheme, e.Replacement)
+}
+
+// replaceWord는 표기의 양 끝이 영숫자일 때 앞뒤가 영숫자가 아닌 경우에만 치환합니다.
+// 한글 조사가 붙은 "API를" 같은 경우도 일치하도록 ASCII 영숫자만 단어 문자로 봅니다.
+func replaceWord(text, grapheme, replacement string) string {
+	first, _ := utf8.DecodeRuneInString(grapheme)
+	last, _ := utf8.DecodeLastRuneInString(grapheme)
+
+	var b strings.Builder
+	pos := 0
+	for {
+		i := strings.Index(text[pos:], grapheme)
+		if i < 0 {
+			b.WriteString(text[pos:])
+			return b.String()
+		}
+		start := pos + i
+		end := start + len(grapheme)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+
+		ok := !(isASCIIWord(first) && start > 0 && isASCIIWord(before)) &&
+			!(isASCIIWord(last) && end < len(text) && isASCIIWord(after))
+		b.WriteString(text[pos:start])
+		if ok {
+			b.WriteString(replacement)
+		} else {
+			b.WriteString(grapheme)
+		}
+		pos = end
+	}
+}
+
+func isASCIIWord(r rune) bool {
+	return r < utf8.RuneSelf && (r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+}
@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+)
+
+type memoryLexiconRepository map[string]map[string]domain.Lexicon
+
+func (m memoryLexiconRepository) Get(userID, name string) (*domain.Lexicon, error) {
+	lexicon, ok := m[userID][name]
+	if !ok {
+		return nil, domain.ErrLexiconNotFound
+	}
+	return &lexicon, nil
+}
+
+func (m memoryLexiconRepository) List(userID string) ([]domain.Lexicon, error) {
+	lexicons := []domain.Lexicon{}
+	for _, l := range m[userID] {
+		lexicons = append(lexicons, l)
+	}
+	return lexicons, nil
+}
+
+func (m memoryLexiconRepository) Save(userID string, lexicon domain.Lexicon) error {
+	if m[userID] == nil {
+		m[userID] = map[string]domain.Lexicon{}
+	}
+	m[userID][lexicon.Name] = lexicon
+	return nil
+}
+
+func (m memoryLexiconRepository) Delete(userID, name string) error {
+	if _, ok := m[userID][name]; !ok {
+		return domain.ErrLexiconNotFound
+	}
+	delete(m[userID], name)
+	return nil
+}
+
+func TestApplyLexicons(t *testing.T) {
+	lexicons := []domain.Lexicon{{
+		Name:     "brand",
+		Language: "ko",
+		Entries: []domain.LexiconEntry{
+			{Grapheme: "API", Replacement: "에이피아이", Match: domain.MatchWord},
+			{Grapheme: "Supertone", Replacement: "수퍼톤", Match: domain.MatchWord},
+			{Grapheme: "Supertone API", Replacement: "수퍼톤 에이피아이", Match: domain.MatchWord},
+			{Grapheme: `(\d+)kg`, Replacement: "${1}킬로그램", Match: domain.MatchRegex},
+			{Grapheme: "㈜", Replacement: "주식회사", Match: domain.MatchSubstring},
+			{Grapheme: "TTS", Replacement: "티티에스", Language: "en", Match: domain.MatchWord},
+		},
+	}}
+
+	tests := []struct {
+		name     string
+		text     string
+		language string
+		want     string
+	}{
+		{"word with particle", "API를 호출합니다", "ko", "에이피아이를 호출합니다"},
+		{"not inside word", "APIs와 RAPID", "ko", "APIs와 RAPID"},
+		{"longest first", "Supertone API 소개", "ko", "수퍼톤 에이피아이 소개"},
+		{"regex group", "무게는 3kg입니다", "ko", "무게는 3킬로그램입니다"},
+		{"substring", "㈜수퍼톤", "ko-KR", "주식회사수퍼톤"},
+		{"repeated", "API API,API", "ko", "에이피아이 에이피아이,에이피아이"},
+		{"adjacent repeat", "APIAPI", "ko", "APIAPI"},
+		{"entry language mismatch", "TTS", "ko", "TTS"},
+		{"lexicon language mismatch", "API", "en", "API"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, applyLexicons(lexicons, tt.text, tt.language))
+		})
+	}
+}
+
+func TestValidateLexicon(t *testing.T) {
+	lexicon := domain.Lexicon{Name: "brand", Entries: []domain.LexiconEntry{{Grapheme: "API", Replacement: "에이피아이"}}}
+	assert.NoError(t, lexicon.Validate())
+	assert.Equal(t, domain.MatchWord, lexicon.Entries[0].Match)
+
+	invalid := []domain.Lexicon{
+		{Name: ""},
+		{Name: "brand", Entries: []domain.LexiconEntry{{Replacement: "x"}}},
+		{Name: "brand", Entries: []domain.LexiconEntry{{Grapheme: "(", Match: domain.MatchRegex}}},
+		{Name: "brand", Entries: []domain.LexiconEntry{{Grapheme: "a", Match: "fuzzy"}}},
+	}
+	for _, l := range invalid {
+		assert.ErrorIs(t, l.Validate(), domain.ErrInvalidLexicon)
+	}
+}
+
+func TestLexiconService_CRUD(t *testing.T) {
+	service := NewLexiconService(memoryLexiconRepository{})
+	ctx := domain.WithUserID(context.Background(), "alice")
+	lexicon := domain.Lexicon{Name: "brand", Entries: []domain.LexiconEntry{{Grapheme: "API", Replacement: "에이피아이"}}}
+
+	_, err := service.Create(ctx, lexicon)
+	assert.NoError(t, err)
+	_, err = service.Create(ctx, lexicon)
+	assert.ErrorIs(t, err, domain.ErrLexiconExists)
+
+	lexicon.Language = "ko"
+	saved, err := service.Put(ctx, lexicon)
+	assert.NoError(t, err)
+	assert.Equal(t, "ko", saved.Language)
+
+	_, err = service.Get(domain.WithUserID(context.Background(), "bob"), "brand")
+	assert.ErrorIs(t, err, domain.ErrLexiconNotFound)
+
+	assert.NoError(t, service.Delete(ctx, "brand"))
+	lexicons, err := service.List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, lexicons)
+}
+
+func TestRegexpCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache := newRegexpCache(2)
+	first, err := cache.get("a+")
+	require.NoError(t, err)
+	_, err = cache.get("b+")
+	require.NoError(t, err)
+
+	again, _ := cache.get("a+") // a+를 최근에 쓴 것으로 만듦
+	assert.Same(t, first, again)
+	_, err = cache.get("c+") // 가장 오래 쓰지 않은 b+를 버림
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, cache.order.Len())
+	assert.Contains(t, cache.items, "a+")
+	assert.Contains(t, cache.items, "c+")
+	assert.NotContains(t, cache.items, "b+")
+
+	_, err = cache.get("(")
+	assert.Error(t, err)
+	assert.NotContains(t, cache.items, "(")
+}
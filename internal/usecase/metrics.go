@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/metrics"
+)
+
+// upstreamBuckets는 업스트림 합성 시간(초)의 히스토그램 구간입니다. 긴 텍스트는 수십 초가 걸릴 수 있습니다.
+var upstreamBuckets = []float64{.1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// otherVoice는 별칭이나 음성 목록에 없는 Voice ID를 묶는 voice 레이블 값입니다.
+// Voice ID는 요청 경로와 SSML에서 그대로 오므로, 묶지 않으면 임의의 ID마다 시계열이 끝없이 늘어납니다.
+const otherVoice = "other"
+
+// Metrics는 유즈케이스 계층에서 기록하는 Prometheus 지표입니다.
+type Metrics struct {
+	upstreamDuration *metrics.Histogram
+	upstreamErrors   *metrics.Counter
+	characters       *metrics.Counter
+	cacheRequests    *metrics.Counter
+
+	mu     sync.RWMutex
+	voices map[string]bool // 마지막으로 받은 음성 목록의 Voice ID
+}
+
+// NewMetrics는 지표를 reg에 등록합니다.
+func NewMetrics(reg *metrics.Registry) *Metrics {
+	return &Metrics{
+		upstreamDuration: reg.NewHistogram("tts_proxy_upstream_request_duration_seconds",
+			"Latency of upstream TTS synthesis calls.", upstreamBuckets, "provider", "voice", "result"),
+		upstreamErrors: reg.NewCounter("tts_proxy_upstream_errors_total",
+			"Failed upstream TTS synthesis calls.", "provider", "voice"),
+		characters: reg.NewCounter("tts_proxy_characters_synthesized_total",
+			"Characters successfully synthesized by the upstream provider.", "provider", "voice"),
+		cacheRequests: reg.NewCounter("tts_proxy_cache_requests_total",
+			"Cache lookups by cache and result (hit or miss).", "cache", "result"),
+	}
+}
+
+// InstrumentAdapter는 업스트림 호출 시간, 오류, 합성 글자 수를 기록하도록 adapter를 감쌉니다.
+// voice 레이블에는 aliases의 별칭이 가리키거나 음성 목록에 있는 Voice ID만 쓰고, 나머지는 other로 묶습니다.
+func (m *Metrics) InstrumentAdapter(adapter TTSAdapter, provider string, aliases *VoiceAliasRegistry) TTSAdapter {
+	return &instrumentedAdapter{next: adapter, provider: provider, aliases: aliases, metrics: m}
+}
+
+// setCatalog은 음성 목록의 Voice ID를 voice 레이블로 쓸 수 있게 합니다. m이 nil이면 아무것도 하지 않습니다.
+func (m *Metrics) setCatalog(voices []domain.Voice) {
+	if m == nil {
+		return
+	}
+	ids := make(map[string]bool, len(voices))
+	for _, v := range voices {
+		ids[v.ID] = true
+	}
+	m.mu.Lock()
+	m.voices = ids
+	m.mu.Unlock()
+}
+
+func (m *Metrics) inCatalog(voiceID string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.voices[voiceID]
+}
+
+// cacheLookup은 캐시 조회 결과를 기록합니다. m이 nil이면 아무것도 하지 않습니다.
+func (m *Metrics) cacheLookup(cache string, hit bool) {
+	if m == nil {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheRequests.Inc(cache, result)
+}
+
+type instrumentedAdapter struct {
+	next     TTSAdapter
+	provider string
+	aliases  *VoiceAliasRegistry
+	metrics  *Metrics
+}
+
+func (a *instrumentedAdapter) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	start := time.Now()
+	resp, err := a.next.Synthesize(ctx, req, voiceID)
+	voice := a.voiceLabel(voiceID)
+	result := "ok"
+	if err != nil {
+		result = "error"
+		a.metrics.upstreamErrors.Inc(a.provider, voice)
+	} else {
+		a.metrics.characters.Add(float64(utf8.RuneCountInString(req.Text)), a.provider, voice)
+	}
+	a.metrics.upstreamDuration.Observe(time.Since(start).Seconds(), a.provider, voice, result)
+	return resp, err
+}
+
+// voiceLabel은 알려진 음성이면 voiceID를, 아니면 otherVoice를 반환합니다.
+func (a *instrumentedAdapter) voiceLabel(voiceID string) string {
+	if a.metrics.inCatalog(voiceID) || (a.aliases != nil && a.aliases.HasVoice(voiceID)) {
+		return voiceID
+	}
+	return otherVoice
+}
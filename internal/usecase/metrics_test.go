@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/metrics"
+)
+
+func TestMetrics_InstrumentAdapter(t *testing.T) {
+	reg := metrics.NewRegistry()
+	m := NewMetrics(reg)
+	adapter := m.InstrumentAdapter(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			if voiceID == "broken" {
+				return nil, errors.New("TTS API error: 503")
+			}
+			return &domain.TTSResponse{Audio: []byte("WAV"), Format: "wav"}, nil
+		},
+	}, "supertone", NewVoiceAliasRegistry([]domain.VoiceAlias{{Name: "narrator", VoiceID: "v1"}, {Name: "bad", VoiceID: "broken"}}))
+
+	_, err := adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "안녕하세요"}, "v1")
+	assert.NoError(t, err)
+	_, err = adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, "broken")
+	assert.Error(t, err)
+
+	assert.Equal(t, 5.0, m.characters.Value("supertone", "v1")) // 바이트가 아닌 글자 수
+	assert.Equal(t, 0.0, m.characters.Value("supertone", "broken"))
+	assert.Equal(t, 1.0, m.upstreamErrors.Value("supertone", "broken"))
+	assert.Equal(t, 1.0, m.upstreamDuration.Count("supertone", "v1", "ok"))
+	assert.Equal(t, 1.0, m.upstreamDuration.Count("supertone", "broken", "error"))
+
+	// 별칭이나 음성 목록에 없는 Voice ID는 other로 묶음
+	for _, id := range []string{"random-1", "random-2", "broken-3"} {
+		adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, id)
+	}
+	assert.Equal(t, 3.0, m.upstreamDuration.Count("supertone", "other", "ok"))
+
+	var b strings.Builder
+	assert.NoError(t, reg.WriteText(&b))
+	assert.Contains(t, b.String(), `tts_proxy_upstream_errors_total{provider="supertone",voice="broken"} 1`)
+	assert.NotContains(t, b.String(), "random-1")
+
+	// 음성 목록을 받으면 목록의 음성도 레이블로 씀
+	voices := NewVoiceService(&mockVoiceCatalogAdapter{voices: testVoices()}, time.Minute, WithVoiceCacheMetrics(m))
+	_, err = voices.ListVoices(context.Background(), domain.VoiceFilter{})
+	assert.NoError(t, err)
+	adapter.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi"}, "v3")
+	assert.Equal(t, 2.0, m.characters.Value("supertone", "v3"))
+}
+
+func TestMetrics_VoiceCache(t *testing.T) {
+	m := NewMetrics(metrics.NewRegistry())
+	service := NewVoiceService(&mockVoiceCatalogAdapter{voices: testVoices()}, time.Minute, WithVoiceCacheMetrics(m))
+
+	for i := 0; i < 3; i++ {
+		_, err := service.ListVoices(context.Background(), domain.VoiceFilter{})
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1.0, m.cacheRequests.Value("voices", "miss"))
+	assert.Equal(t, 2.0, m.cacheRequests.Value("voices", "hit"))
+}
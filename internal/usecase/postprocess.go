@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+// maxNormalizeGainDB는 라우드니스 정규화로 올릴 수 있는 최대 이득입니다. 거의 무음인 오디오의 잡음을 키우지 않도록 제한합니다.
+const maxNormalizeGainDB = 30.0
+
+// processAudio는 합성된 WAV를 요청한 표본화율과 채널 수로 변환하고 후처리한 뒤 요청한 형식으로 인코딩합니다.
+// 무음 제거는 배경을 깔기 전 음성에만, 워터마크와 라우드니스 정규화, 트루 피크 제한은 배경을 섞은 결과에 적용합니다.
+func (s *ttsService) processAudio(ctx context.Context, resp *domain.TTSResponse, req *domain.TTSRequest) (*domain.TTSResponse, error) {
+	if resp.Format != "" && resp.Format != domain.FormatWAV {
+		return nil, fmt.Errorf("%w: audio conversion requires wav audio, got %s", domain.ErrInvalidRequest, resp.Format)
+	}
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode audio for processing: %w", err)
+	}
+
+	rate, channels := req.SampleRate, req.Channels
+	if req.OutputFormat == domain.FormatMuLaw || req.OutputFormat == domain.FormatALaw {
+		if rate == 0 {
+			rate = domain.TelephonySampleRate
+		}
+		if channels == 0 {
+			channels = domain.TelephonyChannels
+		}
+	}
+
+	// 채널을 줄일 때는 먼저, 늘릴 때는 나중에 바꿔 리샘플링할 채널 수를 줄임
+	if channels != 0 && channels < pcm.Channels {
+		pcm = audio.RemixChannels(pcm, channels)
+	}
+	if rate != 0 {
+		pcm = audio.Resample(pcm, rate)
+	}
+	if channels != 0 {
+		pcm = audio.RemixChannels(pcm, channels)
+	}
+	timings := resp.Timings
+	if req.PostProcessing != nil {
+		pcm, timings = trimSilence(pcm, timings, req.PostProcessing)
+	}
+	if req.Background != nil {
+		if pcm, timings, err = s.mixBackground(pcm, timings, req.Background); err != nil {
+			return nil, err
+		}
+	}
+	var requestID string
+	if s.watermarkRequested(req) {
+		if requestID, err = s.embedWatermark(ctx, pcm, req); err != nil {
+			return nil, err
+		}
+	}
+	if req.PostProcessing != nil {
+		normalizeLoudness(pcm, req.PostProcessing)
+	}
+
+	out := encodeAudio(pcm, req.OutputFormat)
+	out.Timings = timings
+	out.RequestID = requestID
+	return out, nil
+}
+
+// encodeAudio는 PCM을 출력 형식으로 인코딩합니다. 헤더가 없는 형식은 표본화율과 채널 수를 응답에 담습니다.
+func encodeAudio(pcm *audio.PCM, format string) *domain.TTSResponse {
+	resp := &domain.TTSResponse{Format: format}
+	switch format {
+	case domain.FormatFLAC:
+		resp.Audio = audio.EncodeFLAC(pcm)
+		return resp
+	case domain.FormatPCM:
+		resp.Audio = audio.EncodePCM16LE(pcm)
+	case domain.FormatMuLaw:
+		resp.Audio = audio.EncodeMuLaw(pcm)
+	case domain.FormatALaw:
+		resp.Audio = audio.EncodeALaw(pcm)
+	default:
+		return &domain.TTSResponse{Audio: audio.EncodeWAV(pcm), Format: domain.FormatWAV}
+	}
+	resp.SampleRate, resp.Channels = pcm.SampleRate, pcm.Channels
+	return resp
+}
+
+// trimSilence는 요청하면 앞뒤 무음을 잘라내고, 앞쪽을 잘라낸 만큼 타이밍 추정값을 앞당깁니다.
+func trimSilence(pcm *audio.PCM, timings []domain.SentenceTiming, opts *domain.PostProcessingOptions) (*audio.PCM, []domain.SentenceTiming) {
+	if !opts.TrimSilence {
+		return pcm, timings
+	}
+	pcm, kept := audio.TrimSilence(pcm, opts.SilenceThreshold(), opts.TrimPadding())
+	return pcm, shiftTimings(timings, kept.Start, pcm.Duration())
+}
+
+// normalizeLoudness는 요청에 따라 라우드니스 정규화와 트루 피크 제한을 차례로 적용합니다.
+func normalizeLoudness(pcm *audio.PCM, opts *domain.PostProcessingOptions) {
+	if opts.NormalizeLoudness {
+		if loudness := audio.IntegratedLoudness(pcm); !math.IsInf(loudness, -1) {
+			audio.Gain(pcm, min(opts.Target()-loudness, maxNormalizeGainDB))
+		}
+	}
+	if ceiling, ok := opts.LimitTruePeak(); ok {
+		audio.LimitTruePeak(pcm, ceiling)
+	}
+}
+
+// shiftTimings는 타이밍을 offset만큼 앞당기고 [0, end] 범위로 자릅니다.
+func shiftTimings(timings []domain.SentenceTiming, offset, end time.Duration) []domain.SentenceTiming {
+	if len(timings) == 0 {
+		return timings
+	}
+	shift := func(t time.Duration) time.Duration {
+		return min(max(t-offset, 0), end)
+	}
+	out := make([]domain.SentenceTiming, len(timings))
+	for i, st := range timings {
+		out[i] = domain.SentenceTiming{Text: st.Text, Start: shift(st.Start), End: shift(st.End)}
+		if st.Words != nil {
+			out[i].Words = make([]domain.WordTiming, len(st.Words))
+			for j, w := range st.Words {
+				out[i].Words[j] = domain.WordTiming{Text: w.Text, Start: shift(w.Start), End: shift(w.End)}
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+// paddedToneAdapter는 앞 200ms, 뒤 300ms 무음이 붙은 1초 길이 1kHz 사인파(피크 amplitudeDB)를 반환합니다.
+func paddedToneAdapter(amplitudeDB float64) *mockTTSAdapter {
+	return &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			tone := audio.Silence(48000, 1, time.Second)
+			a := math.Pow(10, amplitudeDB/20)
+			for i := range tone.Samples {
+				tone.Samples[i] = a * math.Sin(2*math.Pi*1000*float64(i)/48000)
+			}
+			pcm, _ := audio.Concat(audio.Silence(48000, 1, 200*time.Millisecond), tone, audio.Silence(48000, 1, 300*time.Millisecond))
+			return &domain.TTSResponse{Audio: audio.EncodeWAV(pcm), Format: "wav"}, nil
+		},
+	}
+}
+
+func TestTTSService_Synthesize_PostProcessing(t *testing.T) {
+	service := NewTTSService(paddedToneAdapter(-30))
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", Language: "en",
+		PostProcessing: &domain.PostProcessingOptions{TrimSilence: true, NormalizeLoudness: true},
+	}, "voice-123")
+	require.NoError(t, err)
+	assert.Equal(t, "wav", resp.Format)
+
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, 1100*time.Millisecond, pcm.Duration()) // 앞뒤로 50ms씩 남김
+	assert.InDelta(t, domain.DefaultTargetLUFS, audio.IntegratedLoudness(pcm), 0.2)
+	assert.LessOrEqual(t, audio.TruePeak(pcm), domain.DefaultTruePeakDB)
+}
+
+func TestTTSService_Synthesize_PostProcessing_Limit(t *testing.T) {
+	service := NewTTSService(paddedToneAdapter(0))
+	ceiling, padding := -6.0, 0
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", Language: "en",
+		PostProcessing: &domain.PostProcessingOptions{TrimSilence: true, TrimPaddingMS: &padding, TruePeakDB: &ceiling},
+	}, "voice-123")
+	require.NoError(t, err)
+
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, time.Second, pcm.Duration())
+	assert.InDelta(t, -6, audio.TruePeak(pcm), 0.1)
+}
+
+func TestTTSService_Synthesize_PostProcessing_Invalid(t *testing.T) {
+	called := false
+	service := NewTTSService(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			called = true
+			return &domain.TTSResponse{Audio: []byte("MP3DATA"), Format: "mp3"}, nil
+		},
+	})
+
+	target := 0.0
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", Language: "en", PostProcessing: &domain.PostProcessingOptions{NormalizeLoudness: true, TargetLUFS: &target},
+	}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	assert.False(t, called)
+
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", Language: "en", PostProcessing: &domain.PostProcessingOptions{TrimSilence: true},
+	}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func TestShiftTimings(t *testing.T) {
+	ms := time.Millisecond
+	timings := []domain.SentenceTiming{{
+		Text: "Hi there", Start: 100 * ms, End: 900 * ms,
+		Words: []domain.WordTiming{{Text: "Hi", Start: 100 * ms, End: 400 * ms}, {Text: "there", Start: 500 * ms, End: 900 * ms}},
+	}}
+
+	shifted := shiftTimings(timings, 150*ms, 700*ms)
+	assert.Equal(t, []domain.SentenceTiming{{
+		Text: "Hi there", Start: 0, End: 700 * ms,
+		Words: []domain.WordTiming{{Text: "Hi", Start: 0, End: 250 * ms}, {Text: "there", Start: 350 * ms, End: 700 * ms}},
+	}}, shifted)
+	assert.Equal(t, 100*ms, timings[0].Start) // 원본은 그대로
+}
+
+func TestTTSService_Synthesize_SampleRateAndChannels(t *testing.T) {
+	service := NewTTSService(&wavAdapter{}) // 8kHz 모노 0.1초
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", Language: "en", SampleRate: 48000, Channels: 2,
+	}, "voice-123")
+	require.NoError(t, err)
+
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, 48000, pcm.SampleRate)
+	assert.Equal(t, 2, pcm.Channels)
+	assert.Equal(t, 100*time.Millisecond, pcm.Duration())
+	mid := pcm.Frames() / 2
+	assert.InDelta(t, 0.5, pcm.Samples[mid*2], 0.01)
+	assert.Equal(t, pcm.Samples[mid*2], pcm.Samples[mid*2+1])
+
+	for _, req := range []domain.TTSRequest{
+		{Text: "hello", Language: "en", SampleRate: 4000},
+		{Text: "hello", Language: "en", Channels: 6},
+	} {
+		_, err := service.Synthesize(context.Background(), &req, "voice-123")
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	}
+}
+
+func TestTTSService_Synthesize_OutputFormat(t *testing.T) {
+	service := NewTTSService(paddedToneAdapter(-6)) // 48kHz 모노 1.5초
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hello", Language: "en", OutputFormat: "mulaw"}, "voice-123")
+	require.NoError(t, err)
+	assert.Equal(t, "mulaw", resp.Format)
+	assert.Equal(t, 8000, resp.SampleRate) // 전화망 기본값
+	assert.Equal(t, 1, resp.Channels)
+	assert.Len(t, resp.Audio, 12000)
+
+	resp, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hello", Language: "en", OutputFormat: "pcm", Channels: 2}, "voice-123")
+	require.NoError(t, err)
+	assert.Equal(t, "pcm", resp.Format)
+	assert.Equal(t, 48000, resp.SampleRate)
+	assert.Equal(t, 2, resp.Channels)
+	assert.Len(t, resp.Audio, 72000*2*2)
+
+	resp, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hello", Language: "en", OutputFormat: "flac"}, "voice-123")
+	require.NoError(t, err)
+	assert.Equal(t, "flac", resp.Format)
+	assert.Equal(t, "fLaC", string(resp.Audio[:4]))
+	assert.Zero(t, resp.SampleRate)
+
+	for _, req := range []domain.TTSRequest{
+		{Text: "hello", Language: "en", OutputFormat: "ogg"},
+		{Text: "hello", Language: "en", OutputFormat: "mp3", SampleRate: 48000},
+		{Text: "<speak>hello</speak>", Language: "en", OutputFormat: "mp3"},
+	} {
+		_, err := service.Synthesize(context.Background(), &req, "voice-123")
+		assert.ErrorIs(t, err, domain.ErrInvalidRequest, req.OutputFormat)
+	}
+}
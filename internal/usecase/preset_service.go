@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"tts_proxy/internal/domain"
+)
+
+var presetNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// presetService는 PresetService의 실제 구현체로, 요청 컨텍스트의 사용자별로 프리셋을 관리합니다.
+type presetService struct {
+	repo domain.PresetRepository
+	now  func() time.Time
+	mu   sync.Mutex
+}
+
+// NewPresetService는 PresetService 구현체를 생성합니다.
+func NewPresetService(repo domain.PresetRepository) domain.PresetService {
+	return &presetService{repo: repo, now: time.Now}
+}
+
+// Create는 새 프리셋을 저장합니다. 같은 이름이 있으면 ErrPresetExists를 반환합니다.
+func (s *presetService) Create(ctx context.Context, preset domain.Preset) (*domain.Preset, error) {
+	if err := validatePreset(preset); err != nil {
+		return nil, err
+	}
+	userID := domain.UserIDFromContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.repo.Get(userID, preset.Name); err == nil {
+		return nil, domain.ErrPresetExists
+	} else if !errors.Is(err, domain.ErrPresetNotFound) {
+		return nil, err
+	}
+
+	preset.CreatedAt = s.now()
+	preset.UpdatedAt = preset.CreatedAt
+	if err := s.repo.Save(userID, preset); err != nil {
+		return nil, err
+	}
+	return &preset, nil
+}
+
+// Get은 이름으로 프리셋을 조회합니다.
+func (s *presetService) Get(ctx context.Context, name string) (*domain.Preset, error) {
+	return s.repo.Get(domain.UserIDFromContext(ctx), name)
+}
+
+// List는 사용자의 프리셋 목록을 반환합니다.
+func (s *presetService) List(ctx context.Context) ([]domain.Preset, error) {
+	return s.repo.List(domain.UserIDFromContext(ctx))
+}
+
+// Update는 기존 프리셋의 내용을 교체합니다. 이름은 경로의 name으로 고정됩니다.
+func (s *presetService) Update(ctx context.Context, name string, preset domain.Preset) (*domain.Preset, error) {
+	preset.Name = name
+	if err := validatePreset(preset); err != nil {
+		return nil, err
+	}
+	userID := domain.UserIDFromContext(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	existing, err := s.repo.Get(userID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	preset.CreatedAt = existing.CreatedAt
+	preset.UpdatedAt = s.now()
+	if err := s.repo.Save(userID, preset); err != nil {
+		return nil, err
+	}
+	return &preset, nil
+}
+
+// Delete는 프리셋을 삭제합니다.
+func (s *presetService) Delete(ctx context.Context, name string) error {
+	return s.repo.Delete(domain.UserIDFromContext(ctx), name)
+}
+
+func validatePreset(preset domain.Preset) error {
+	if !presetNamePattern.MatchString(preset.Name) {
+		return fmt.Errorf("%w: name must be 1-64 characters of letters, digits, '-' or '_'", domain.ErrInvalidPreset)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type memoryPresetRepository map[string]map[string]domain.Preset
+
+func (m memoryPresetRepository) Get(userID, name string) (*domain.Preset, error) {
+	preset, ok := m[userID][name]
+	if !ok {
+		return nil, domain.ErrPresetNotFound
+	}
+	return &preset, nil
+}
+
+func (m memoryPresetRepository) List(userID string) ([]domain.Preset, error) {
+	presets := []domain.Preset{}
+	for _, p := range m[userID] {
+		presets = append(presets, p)
+	}
+	return presets, nil
+}
+
+func (m memoryPresetRepository) Save(userID string, preset domain.Preset) error {
+	if m[userID] == nil {
+		m[userID] = map[string]domain.Preset{}
+	}
+	m[userID][preset.Name] = preset
+	return nil
+}
+
+func (m memoryPresetRepository) Delete(userID, name string) error {
+	if _, ok := m[userID][name]; !ok {
+		return domain.ErrPresetNotFound
+	}
+	delete(m[userID], name)
+	return nil
+}
+
+func TestPresetService_CRUD(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := &presetService{repo: memoryPresetRepository{}, now: func() time.Time { return now }}
+	ctx := domain.WithUserID(context.Background(), "alice")
+
+	created, err := service.Create(ctx, domain.Preset{Name: "calm", VoiceID: "v1", TTSDefaults: domain.TTSDefaults{Style: "neutral"}})
+	assert.NoError(t, err)
+	assert.Equal(t, now, created.CreatedAt)
+
+	_, err = service.Create(ctx, domain.Preset{Name: "calm"})
+	assert.ErrorIs(t, err, domain.ErrPresetExists)
+
+	now = now.Add(time.Hour)
+	updated, err := service.Update(ctx, "calm", domain.Preset{VoiceID: "v2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "calm", updated.Name)
+	assert.Equal(t, "v2", updated.VoiceID)
+	assert.Equal(t, created.CreatedAt, updated.CreatedAt)
+	assert.Equal(t, now, updated.UpdatedAt)
+
+	// 다른 사용자에게는 보이지 않음
+	_, err = service.Get(domain.WithUserID(context.Background(), "bob"), "calm")
+	assert.ErrorIs(t, err, domain.ErrPresetNotFound)
+
+	assert.NoError(t, service.Delete(ctx, "calm"))
+	presets, err := service.List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, presets)
+}
+
+func TestPresetService_InvalidName(t *testing.T) {
+	service := NewPresetService(memoryPresetRepository{})
+
+	_, err := service.Create(context.Background(), domain.Preset{Name: "bad name!"})
+	assert.ErrorIs(t, err, domain.ErrInvalidPreset)
+
+	_, err = service.Update(context.Background(), "", domain.Preset{})
+	assert.ErrorIs(t, err, domain.ErrInvalidPreset)
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+	"tts_proxy/pkg/langdetect"
+	"tts_proxy/pkg/ssml"
+)
+
+const (
+	minSpeed      = 0.5
+	maxSpeed      = 2.0
+	maxPitchShift = 24.0
+)
+
+// synthesizeSSML은 SSML 문서를 세그먼트별로 합성한 뒤 <break> 위치에 무음을 넣어 하나의 WAV로 이어 붙입니다.
+func (s *ttsService) synthesizeSSML(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	segments, err := ssml.Parse(req.Text, req.Language)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidRequest, err)
+	}
+
+	// 무음 구간은 첫 오디오의 형식을 알아야 만들 수 있으므로 합성 결과만 먼저 모음
+	clips := make([]*audio.PCM, len(segments))
+	var format *audio.PCM
+	for i, seg := range segments {
+		if seg.IsBreak() {
+			continue
+		}
+
+		segReq := *req
+		segReq.VoiceSettings = adjustVoiceSettings(req.VoiceSettings, seg.Rate, seg.Pitch)
+
+		segVoiceID := voiceID
+		if seg.Voice != "" {
+			segVoiceID = s.resolveVoice(&segReq, seg.Voice)
+		}
+		if segReq.Language == "" {
+			segReq.Language = langdetect.Detect(seg.Text).Language
+		}
+		segReq.Text, err = s.prepareText(ctx, seg.Text, &segReq)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.adapter.Synthesize(ctx, &segReq, segVoiceID)
+		if err != nil {
+			return nil, err
+		}
+		pcm, err := audio.DecodeWAV(resp.Audio)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode segment %d: %w", i, err)
+		}
+		clips[i] = pcm
+		if format == nil {
+			format = pcm
+		}
+	}
+	if format == nil {
+		return nil, fmt.Errorf("%w: SSML document contains no text", domain.ErrInvalidRequest)
+	}
+
+	parts := make([]*audio.PCM, 0, len(segments))
+	for i, seg := range segments {
+		if seg.IsBreak() {
+			parts = append(parts, audio.Silence(format.SampleRate, format.Channels, seg.Break))
+		} else {
+			parts = append(parts, clips[i])
+		}
+	}
+	audio.ConvertAll(parts)
+	joined, err := audio.Concat(parts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TTSResponse{Audio: audio.EncodeWAV(joined), Format: "wav"}, nil
+}
+
+// resolveVoice는 SSML <voice name>을 별칭 레지스트리로 해석합니다. 별칭이 아니면 Voice ID로 간주합니다.
+func (s *ttsService) resolveVoice(req *domain.TTSRequest, name string) string {
+	if s.aliases == nil {
+		return name
+	}
+	alias, ok := s.aliases.Resolve(name)
+	if !ok {
+		return name
+	}
+	req.ApplyDefaults(alias.Defaults)
+	return alias.VoiceID
+}
+
+// adjustVoiceSettings는 기본 voice_settings에 SSML 운율 값을 반영한 사본을 반환합니다.
+func adjustVoiceSettings(base map[string]interface{}, rate, pitch float64) map[string]interface{} {
+	if rate == 1 && pitch == 0 {
+		return base
+	}
+
+	settings := make(map[string]interface{}, len(base)+2)
+	for k, v := range base {
+		settings[k] = v
+	}
+	if rate != 1 {
+		speed := toFloat(settings["speed"], 1) * rate
+		settings["speed"] = round2(math.Min(maxSpeed, math.Max(minSpeed, speed)))
+	}
+	if pitch != 0 {
+		shift := toFloat(settings["pitch_shift"], 0) + pitch
+		settings["pitch_shift"] = round2(math.Min(maxPitchShift, math.Max(-maxPitchShift, shift)))
+	}
+	return settings
+}
+
+func toFloat(v interface{}, def float64) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case float32:
+		return float64(n)
+	case int:
+		return float64(n)
+	case int64:
+		return float64(n)
+	}
+	return def
+}
+
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
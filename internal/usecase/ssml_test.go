@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+// wavAdapter는 요청마다 0.1초 길이의 WAV를 반환하고 받은 요청을 기록합니다.
+type wavAdapter struct {
+	requests []domain.TTSRequest
+	voiceIDs []string
+}
+
+func (a *wavAdapter) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	a.requests = append(a.requests, *req)
+	a.voiceIDs = append(a.voiceIDs, voiceID)
+	pcm := audio.Silence(8000, 1, 100*time.Millisecond)
+	for i := range pcm.Samples {
+		pcm.Samples[i] = 0.5
+	}
+	return &domain.TTSResponse{Audio: audio.EncodeWAV(pcm), Format: "wav"}, nil
+}
+
+func TestTTSService_Synthesize_SSML(t *testing.T) {
+	adapter := &wavAdapter{}
+	aliases := NewVoiceAliasRegistry([]domain.VoiceAlias{{Name: "narrator", VoiceID: "narrator-voice"}})
+	service := NewTTSService(adapter, WithVoiceAliases(aliases))
+
+	req := &domain.TTSRequest{
+		Text:          `<speak>안녕하세요<break time="250ms"/><prosody rate="fast" pitch="+2st">빠르게</prosody><voice name="narrator">해설</voice></speak>`,
+		Language:      "ko",
+		VoiceSettings: map[string]interface{}{"speed": 1.0, "pitch_variance": 1},
+	}
+	resp, err := service.Synthesize(context.Background(), req, "voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "wav", resp.Format)
+
+	assert.Len(t, adapter.requests, 3)
+	assert.Equal(t, "안녕하세요", adapter.requests[0].Text)
+	assert.Equal(t, 1.0, adapter.requests[0].VoiceSettings["speed"])
+	assert.Equal(t, "빠르게", adapter.requests[1].Text)
+	assert.Equal(t, 1.25, adapter.requests[1].VoiceSettings["speed"])
+	assert.Equal(t, 2.0, adapter.requests[1].VoiceSettings["pitch_shift"])
+	assert.Equal(t, 1, adapter.requests[1].VoiceSettings["pitch_variance"])
+	assert.Equal(t, []string{"voice-123", "voice-123", "narrator-voice"}, adapter.voiceIDs)
+
+	// 0.1초 * 3 + 무음 0.25초
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	assert.NoError(t, err)
+	assert.Equal(t, 8000*55/100, pcm.Frames())
+	assert.Equal(t, 0.0, pcm.Samples[900])
+}
+
+func TestTTSService_Synthesize_SSMLMixedFormats(t *testing.T) {
+	formats := map[string][2]int{"voice-123": {24000, 1}, "narrator-voice": {44100, 2}}
+	service := NewTTSService(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			f := formats[voiceID]
+			return &domain.TTSResponse{Audio: audio.EncodeWAV(audio.Silence(f[0], f[1], 100*time.Millisecond)), Format: "wav"}, nil
+		},
+	})
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text:     `<speak>안녕하세요<break time="100ms"/><voice name="narrator-voice">해설</voice></speak>`,
+		Language: "ko",
+	}, "voice-123")
+	require.NoError(t, err)
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, 24000, pcm.SampleRate) // 첫 구간의 형식
+	assert.Equal(t, 1, pcm.Channels)
+	assert.Equal(t, 300*time.Millisecond, pcm.Duration())
+}
+
+func TestTTSService_Synthesize_InvalidSSML(t *testing.T) {
+	service := NewTTSService(&wavAdapter{})
+
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: `<speak><break time="x"/>`}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: `<speak><break time="1s"/></speak>`}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func TestAdjustVoiceSettings_Clamp(t *testing.T) {
+	settings := adjustVoiceSettings(map[string]interface{}{"speed": 1.8}, 1.5, -30)
+	assert.Equal(t, 2.0, settings["speed"])
+	assert.Equal(t, -24.0, settings["pitch_shift"])
+}
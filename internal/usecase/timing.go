@@ -0,0 +1,208 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+	"tts_proxy/pkg/langdetect"
+	"tts_proxy/pkg/sentence"
+	"tts_proxy/pkg/textnorm"
+)
+
+const (
+	// pauseThresholdDB 미만의 에너지가 minPause 이상 이어지면 발화 사이의 쉼으로 봅니다.
+	pauseThresholdDB = -40.0
+	minPause         = 80 * time.Millisecond
+)
+
+// synthesizeTimed는 텍스트를 문장 단위로 합성해 이어 붙이고, 문장별 합성 길이와 무음 구간으로
+// 문장/단어 타이밍을 추정합니다. 자막에는 정규화 전 원문을 사용합니다.
+func (s *ttsService) synthesizeTimed(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	sentences := sentence.Split(req.Text)
+	if len(sentences) == 0 {
+		return nil, fmt.Errorf("%w: text is empty", domain.ErrInvalidRequest)
+	}
+
+	clips := make([]*audio.PCM, 0, len(sentences))
+	timings := make([]domain.SentenceTiming, 0, len(sentences))
+	var offset time.Duration
+	for i, text := range sentences {
+		segReq := *req
+		if req.SplitLanguages {
+			if lang := langdetect.Detect(text).Language; lang != "" {
+				segReq.Language = lang
+			}
+		}
+
+		var err error
+		segReq.Text, err = s.prepareText(ctx, text, &segReq)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.adapter.Synthesize(ctx, &segReq, voiceID)
+		if err != nil {
+			return nil, err
+		}
+		pcm, err := audio.DecodeWAV(resp.Audio)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode sentence %d: %w", i, err)
+		}
+
+		timings = append(timings, estimateTiming(text, segReq.Language, pcm, offset))
+		clips = append(clips, pcm)
+		offset += pcm.Duration()
+	}
+
+	audio.ConvertAll(clips)
+	joined, err := audio.Concat(clips...)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.TTSResponse{Audio: audio.EncodeWAV(joined), Format: "wav", Timings: timings}, nil
+}
+
+// estimateTiming은 한 문장 오디오에서 앞뒤 무음을 뺀 발화 구간과 문장 안의 쉼을 찾아 단어 타이밍을 추정합니다.
+// 쉼마다 가장 가까운 단어 사이(문장 부호 뒤를 우선)를 경계로 정하고, 쉼으로 나뉜 발화 구간 안에서는
+// 단어마다 읽는 길이(정규화한 글자 수)에 비례해 시간을 나눕니다.
+func estimateTiming(text, language string, pcm *audio.PCM, offset time.Duration) domain.SentenceTiming {
+	duration := pcm.Duration()
+	speech := audio.Interval{End: duration}
+	pauses := audio.DetectSilence(pcm, pauseThresholdDB, minPause)
+	if n := len(pauses); n > 0 && pauses[n-1].End == duration {
+		speech.End = pauses[n-1].Start
+		pauses = pauses[:n-1]
+	}
+	if len(pauses) > 0 && pauses[0].Start == 0 {
+		speech.Start = pauses[0].End
+		pauses = pauses[1:]
+	}
+	if speech.End <= speech.Start {
+		// 전부 무음이면 문장 전체를 발화 구간으로 봄
+		speech, pauses = audio.Interval{End: duration}, nil
+	}
+
+	words := strings.Fields(text)
+	weights := make([]int, len(words))
+	for i, w := range words {
+		weights[i] = spokenLength(w, language)
+	}
+
+	timing := domain.SentenceTiming{
+		Text:  text,
+		Start: offset + speech.Start,
+		End:   offset + speech.End,
+		Words: make([]domain.WordTiming, 0, len(words)),
+	}
+
+	// 쉼으로 나뉜 발화 구간마다 배정된 단어들에 시간을 나눔
+	regions, splits := speechRegions(speech, pauses, words, weights)
+	first := 0
+	for r, region := range regions {
+		last := len(words)
+		if r < len(splits) {
+			last = splits[r]
+		}
+		total := 0
+		for _, w := range weights[first:last] {
+			total += w
+		}
+		cumulative := 0
+		for i := first; i < last; i++ {
+			start := region.Start + time.Duration(float64(region.Duration())*float64(cumulative)/float64(total))
+			cumulative += weights[i]
+			end := region.Start + time.Duration(float64(region.Duration())*float64(cumulative)/float64(total))
+			timing.Words = append(timing.Words, domain.WordTiming{Text: words[i], Start: offset + start, End: offset + end})
+		}
+		first = last
+	}
+	return timing
+}
+
+// speechRegions는 쉼을 기준으로 발화 구간을 나누고, 각 쉼 앞에서 끝나는 단어 인덱스(splits[k]는 k+1번째 구간의 첫 단어)를 고릅니다.
+// 단어 사이보다 쉼이 많으면 긴 쉼만 사용합니다.
+func speechRegions(speech audio.Interval, pauses []audio.Interval, words []string, weights []int) ([]audio.Interval, []int) {
+	if len(pauses) > len(words)-1 {
+		pauses = longest(pauses, len(words)-1)
+	}
+
+	regions := make([]audio.Interval, 0, len(pauses)+1)
+	start := speech.Start
+	for _, p := range pauses {
+		regions = append(regions, audio.Interval{Start: start, End: p.Start})
+		start = p.End
+	}
+	regions = append(regions, audio.Interval{Start: start, End: speech.End})
+
+	var spoken time.Duration
+	for _, r := range regions {
+		spoken += r.Duration()
+	}
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+
+	splits := make([]int, len(pauses))
+	var before time.Duration
+	prev, cumulative := 0, 0
+	for k := range pauses {
+		before += regions[k].Duration()
+		target := float64(total) * float64(before) / float64(spoken)
+
+		// 다음 쉼들에 쓸 단어 사이를 남겨 두고, 목표 위치에 가장 가까운 단어 사이를 고름
+		best, bestScore := -1, 0.0
+		c := cumulative
+		for gap := prev + 1; gap <= len(words)-(len(pauses)-k); gap++ {
+			c += weights[gap-1]
+			score := math.Abs(float64(c) - target)
+			if endsClause(words[gap-1]) {
+				score /= 2
+			}
+			if best < 0 || score < bestScore {
+				best, bestScore = gap, score
+			}
+		}
+		for _, w := range weights[prev:best] {
+			cumulative += w
+		}
+		splits[k], prev = best, best
+	}
+	return regions, splits
+}
+
+// longest는 시간 순서를 유지하며 가장 긴 n개의 구간을 반환합니다.
+func longest(intervals []audio.Interval, n int) []audio.Interval {
+	if n <= 0 {
+		return nil
+	}
+	sorted := append([]audio.Interval(nil), intervals...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Duration() > sorted[j].Duration() })
+	sorted = sorted[:n]
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Start < sorted[j].Start })
+	return sorted
+}
+
+// endsClause는 단어가 쉼이 올 법한 문장 부호로 끝나는지 반환합니다.
+func endsClause(word string) bool {
+	last, _ := utf8.DecodeLastRuneInString(word)
+	return strings.ContainsRune(",;:.!?、。，！？", last)
+}
+
+// spokenLength는 단어를 읽는 길이의 근사값으로 정규화한 뒤의 글자 수를 사용합니다. ("2026" → "이천이십육" 5)
+func spokenLength(word, language string) int {
+	n := 0
+	for _, r := range textnorm.Normalize(word, language) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			n++
+		}
+	}
+	return max(n, 1)
+}
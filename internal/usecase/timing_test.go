@@ -0,0 +1,136 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+func toneClip(d time.Duration) *audio.PCM {
+	p := audio.Silence(8000, 1, d)
+	for i := range p.Samples {
+		p.Samples[i] = 0.5 * math.Sin(2*math.Pi*440*float64(i)/8000)
+	}
+	return p
+}
+
+func TestEstimateTiming(t *testing.T) {
+	// 무음 0.1초, 발화 0.4초, 쉼 0.2초, 발화 0.4초, 무음 0.1초
+	pcm, err := audio.Concat(
+		audio.Silence(8000, 1, 100*time.Millisecond),
+		toneClip(400*time.Millisecond),
+		audio.Silence(8000, 1, 200*time.Millisecond),
+		toneClip(400*time.Millisecond),
+		audio.Silence(8000, 1, 100*time.Millisecond),
+	)
+	assert.NoError(t, err)
+
+	timing := estimateTiming("Good morning, everyone.", "en", pcm, time.Second)
+	assert.Equal(t, "Good morning, everyone.", timing.Text)
+	assert.Equal(t, 1100*time.Millisecond, timing.Start)
+	assert.Equal(t, 2100*time.Millisecond, timing.End)
+
+	// 첫 발화 구간 0.4초를 "Good"과 "morning,"이 글자 수 비율 4:7로 나눔
+	assert.Len(t, timing.Words, 3)
+	assert.Equal(t, "Good", timing.Words[0].Text)
+	assert.Equal(t, 1100*time.Millisecond, timing.Words[0].Start)
+	assert.InDelta(t, 1245*time.Millisecond, timing.Words[0].End, float64(time.Millisecond))
+	// "morning,"은 쉼 직전에 끝나고 "everyone."은 쉼 직후에 시작
+	assert.Equal(t, 1500*time.Millisecond, timing.Words[1].End)
+	assert.Equal(t, 1700*time.Millisecond, timing.Words[2].Start)
+	assert.Equal(t, 2100*time.Millisecond, timing.Words[2].End)
+}
+
+func TestEstimateTiming_MorePausesThanWordGaps(t *testing.T) {
+	pcm, err := audio.Concat(
+		toneClip(200*time.Millisecond),
+		audio.Silence(8000, 1, 100*time.Millisecond),
+		toneClip(200*time.Millisecond),
+		audio.Silence(8000, 1, 300*time.Millisecond),
+		toneClip(200*time.Millisecond),
+	)
+	assert.NoError(t, err)
+
+	// 단어 사이가 하나뿐이므로 긴 쉼(0.5~0.8초)만 경계로 사용
+	timing := estimateTiming("hello world", "en", pcm, 0)
+	assert.Len(t, timing.Words, 2)
+	assert.Equal(t, 500*time.Millisecond, timing.Words[0].End)
+	assert.Equal(t, 800*time.Millisecond, timing.Words[1].Start)
+}
+
+func TestEstimateTiming_NormalizedLength(t *testing.T) {
+	pcm := toneClip(time.Second)
+
+	// "2026년"은 "이천이십육 년"으로 읽으므로 "올해"보다 길게 배분됨
+	timing := estimateTiming("올해 2026년", "ko", pcm, 0)
+	assert.Len(t, timing.Words, 2)
+	assert.Less(t, timing.Words[0].End-timing.Words[0].Start, timing.Words[1].End-timing.Words[1].Start)
+}
+
+func TestTTSService_Synthesize_Subtitles(t *testing.T) {
+	adapter := &wavAdapter{}
+	service := NewTTSService(adapter)
+
+	req := &domain.TTSRequest{
+		Text:      "첫 문장입니다. 3개 있어요.",
+		Subtitles: &domain.SubtitleOptions{Format: domain.SubtitleSRT},
+	}
+	resp, err := service.Synthesize(context.Background(), req, "voice-123")
+	assert.NoError(t, err)
+
+	// 문장마다 한 번씩 합성하고, 자막은 정규화 전 원문을 사용
+	assert.Len(t, adapter.requests, 2)
+	assert.Equal(t, "세 개 있어요.", adapter.requests[1].Text)
+	assert.Len(t, resp.Timings, 2)
+	assert.Equal(t, "3개 있어요.", resp.Timings[1].Text)
+	assert.Equal(t, 100*time.Millisecond, resp.Timings[1].Start)
+	assert.Equal(t, 200*time.Millisecond, resp.Timings[1].End)
+
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	assert.NoError(t, err)
+	assert.Equal(t, 1600, pcm.Frames())
+}
+
+func TestTTSService_Synthesize_SubtitlesMixedFormats(t *testing.T) {
+	// 문장마다 업스트림이 다른 형식을 돌려줘도 첫 문장의 형식으로 이어 붙임
+	calls := 0
+	service := NewTTSService(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			calls++
+			clip := toneClip(100 * time.Millisecond)
+			if calls == 2 {
+				clip = audio.Convert(clip, 44100, 2)
+			}
+			return &domain.TTSResponse{Audio: audio.EncodeWAV(clip), Format: "wav"}, nil
+		},
+	})
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text:      "Hello there. Nice to meet you.",
+		Subtitles: &domain.SubtitleOptions{},
+	}, "voice-123")
+	require.NoError(t, err)
+	require.Len(t, resp.Timings, 2)
+	assert.Equal(t, 100*time.Millisecond, resp.Timings[1].Start)
+	pcm, err := audio.DecodeWAV(resp.Audio)
+	require.NoError(t, err)
+	assert.Equal(t, 8000, pcm.SampleRate)
+	assert.Equal(t, 1, pcm.Channels)
+	assert.Equal(t, 1600, pcm.Frames())
+}
+
+func TestTTSService_Synthesize_SubtitlesRejectSSML(t *testing.T) {
+	service := NewTTSService(&wavAdapter{})
+
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text:      "<speak>hi</speak>",
+		Subtitles: &domain.SubtitleOptions{Format: domain.SubtitleVTT},
+	}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
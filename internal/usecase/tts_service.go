@@ -1,23 +1,235 @@
 package usecase
 
-import "tts_proxy/internal/domain"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/langdetect"
+	"tts_proxy/pkg/ssml"
+	"tts_proxy/pkg/textnorm"
+	"tts_proxy/pkg/tracing"
+)
 
 // TTSAdapter는 외부 TTS API 호출을 추상화합니다.
 type TTSAdapter interface {
-	Synthesize(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error)
+	Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error)
 }
 
 // ttsService는 TTSService의 실제 구현체입니다.
 type ttsService struct {
 	adapter TTSAdapter
+	presets domain.PresetRepository
+	aliases domain.VoiceAliasRegistry
+
+	lexicons       domain.LexiconRepository
+	globalLexicons []domain.Lexicon
+
+	backgrounds domain.BackgroundRepository
+	beds        *BedCache
+
+	watermarkKey []byte
+	watermarkAll bool
+
+	audit         domain.AuditRepository
+	auditProvider string
+	auditText     bool
+
+	usage domain.UsageRepository
+}
+
+// TTSServiceOption은 ttsService의 선택적 의존성을 설정합니다.
+type TTSServiceOption func(*ttsService)
+
+// WithPresets는 요청의 preset 필드를 해석할 프리셋 저장소를 설정합니다.
+func WithPresets(repo domain.PresetRepository) TTSServiceOption {
+	return func(s *ttsService) {
+		s.presets = repo
+	}
+}
+
+// WithVoiceAliases는 SSML <voice name>을 해석할 별칭 레지스트리를 설정합니다.
+func WithVoiceAliases(aliases domain.VoiceAliasRegistry) TTSServiceOption {
+	return func(s *ttsService) {
+		s.aliases = aliases
+	}
+}
+
+// WithLexicons는 합성 전에 텍스트에 적용할 사용자별 발음 사전 저장소와 전역 사전을 설정합니다.
+// 사용자 사전이 전역 사전보다 먼저 적용됩니다.
+func WithLexicons(repo domain.LexiconRepository, global []domain.Lexicon) TTSServiceOption {
+	return func(s *ttsService) {
+		s.lexicons = repo
+		s.globalLexicons = global
+	}
+}
+
+// WithBackgrounds는 요청의 background.track을 찾을 배경 트랙 저장소와 디코딩한 트랙을 보관할 캐시를 설정합니다.
+func WithBackgrounds(repo domain.BackgroundRepository, beds *BedCache) TTSServiceOption {
+	return func(s *ttsService) {
+		s.backgrounds, s.beds = repo, beds
+	}
+}
+
+// WithWatermark는 워터마크 키를 설정합니다. all이면 요청과 관계없이 모든 합성 결과에 워터마크를 넣습니다.
+func WithWatermark(key []byte, all bool) TTSServiceOption {
+	return func(s *ttsService) {
+		s.watermarkKey = key
+		s.watermarkAll = all
+	}
+}
+
+// WithAudit은 모든 합성 요청을 기록할 감사 로그를 설정합니다. logText이면 원문 텍스트도 기록합니다.
+// 감사 기록을 남기지 못하면 합성 결과를 돌려주지 않고 오류를 반환합니다.
+func WithAudit(repo domain.AuditRepository, provider string, logText bool) TTSServiceOption {
+	return func(s *ttsService) {
+		s.audit = repo
+		s.auditProvider = provider
+		s.auditText = logText
+	}
+}
+
+// WithUsage는 완료된 합성의 글자 수와 오디오 길이를 집계할 사용량 저장소를 설정합니다.
+func WithUsage(repo domain.UsageRepository) TTSServiceOption {
+	return func(s *ttsService) {
+		s.usage = repo
+	}
 }
 
 // NewTTSService는 TTSService 구현체를 생성합니다.
-func NewTTSService(adapter TTSAdapter) domain.TTSService {
-	return &ttsService{adapter: adapter}
+func NewTTSService(adapter TTSAdapter, opts ...TTSServiceOption) domain.TTSService {
+	s := &ttsService{adapter: adapter}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
-// Synthesize는 외부 TTSAdapter를 통해 TTS 변환을 수행합니다.
-func (s *ttsService) Synthesize(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
-	return s.adapter.Synthesize(req, voiceID)
-} 
\ No newline at end of file
+// Synthesize는 외부 TTSAdapter를 통해 TTS 변환을 수행하고, 요청에 따라 결과 오디오의 형식을 변환하고 후처리합니다.
+func (s *ttsService) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (resp *domain.TTSResponse, err error) {
+	ctx, span := tracing.Start(ctx, "tts.synthesize", tracing.KindInternal,
+		tracing.String("tts.voice_id", strings.Clone(voiceID)), // 스팬은 요청이 끝난 뒤 내보냄
+		tracing.Int("tts.chars", utf8.RuneCountInString(req.Text)),
+		tracing.String("tts.output_format", req.OutputFormat),
+	)
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
+	text := req.Text // 사전과 정규화를 적용하기 전의 원문
+	// defer는 역순으로 실행되므로, 감사 기록 실패로 결과를 돌려주지 않는 합성은 사용량에 더하지 않음
+	if s.usage != nil {
+		defer func() {
+			if err == nil {
+				s.recordUsage(ctx, text, voiceID, req, resp)
+			}
+		}()
+	}
+	if s.audit != nil {
+		defer func() {
+			if auditErr := s.recordAudit(ctx, text, voiceID, req, resp, err); auditErr != nil && err == nil {
+				resp, err = nil, auditErr
+			}
+		}()
+	}
+
+	if err := req.ValidateOutput(); err != nil {
+		return nil, err
+	}
+	if err := s.validateWatermark(req); err != nil {
+		return nil, err
+	}
+	voiceID, err = s.applyPreset(ctx, req, voiceID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err = s.synthesize(ctx, req, voiceID)
+	if err != nil || !(req.NeedsAudioProcessing() || s.watermarkRequested(req)) {
+		return resp, err
+	}
+	ctx, audioSpan := tracing.Start(ctx, "tts.process_audio", tracing.KindInternal)
+	defer audioSpan.End()
+	resp, err = s.processAudio(ctx, resp, req)
+	audioSpan.RecordError(err)
+	return resp, err
+}
+
+func (s *ttsService) synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	if ssml.IsSSML(req.Text) {
+		if req.OutputFormat == domain.FormatMP3 {
+			return nil, fmt.Errorf("%w: mp3 output is not supported for SSML", domain.ErrInvalidRequest)
+		}
+		if req.Subtitles != nil {
+			return nil, fmt.Errorf("%w: subtitles are not supported for SSML", domain.ErrInvalidRequest)
+		}
+		return s.synthesizeSSML(ctx, req, voiceID)
+	}
+
+	if req.Language == "" {
+		req.Language = langdetect.Detect(req.Text).Language
+	}
+	if req.Subtitles != nil {
+		return s.synthesizeTimed(ctx, req, voiceID)
+	}
+	if req.SplitLanguages {
+		if segments := langdetect.Split(req.Text); len(segments) > 1 {
+			return s.synthesizeLanguages(ctx, req, voiceID, segments)
+		}
+	}
+
+	var err error
+	req.Text, err = s.prepareText(ctx, req.Text, req)
+	if err != nil {
+		return nil, err
+	}
+	return s.adapter.Synthesize(ctx, req, voiceID)
+}
+
+// prepareText는 어댑터에 전달하기 전에 텍스트에 발음 사전과 정규화를 차례로 적용합니다.
+// 사전이 먼저 적용되므로 사용자가 숫자가 포함된 표기("3D" 등)의 읽기를 직접 지정할 수 있습니다.
+func (s *ttsService) prepareText(ctx context.Context, text string, req *domain.TTSRequest) (string, error) {
+	if s.lexicons != nil {
+		userLexicons, err := s.lexicons.List(domain.UserIDFromContext(ctx))
+		if err != nil {
+			return "", err
+		}
+		text = applyLexicons(userLexicons, text, req.Language)
+	}
+	text = applyLexicons(s.globalLexicons, text, req.Language)
+
+	if req.NormalizeEnabled() {
+		text = textnorm.Normalize(text, req.Language)
+	}
+	return text, nil
+}
+
+// applyPreset은 요청이 참조하는 프리셋의 값으로 비어 있는 필드를 채웁니다.
+// 경로의 voiceID와 요청에 명시된 필드가 프리셋보다 우선합니다.
+func (s *ttsService) applyPreset(ctx context.Context, req *domain.TTSRequest, voiceID string) (string, error) {
+	if req.Preset == "" {
+		return voiceID, nil
+	}
+	if s.presets == nil {
+		return "", fmt.Errorf("%w: %s", domain.ErrPresetNotFound, req.Preset)
+	}
+
+	preset, err := s.presets.Get(domain.UserIDFromContext(ctx), req.Preset)
+	if errors.Is(err, domain.ErrPresetNotFound) {
+		return "", fmt.Errorf("%w: %s", domain.ErrPresetNotFound, req.Preset)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	req.ApplyDefaults(preset.TTSDefaults)
+	if voiceID == "" {
+		voiceID = preset.VoiceID
+	}
+	if voiceID == "" {
+		return "", fmt.Errorf("%w: preset %s has no voice_id, specify one in the URL path", domain.ErrInvalidRequest, req.Preset)
+	}
+	return voiceID, nil
+}
@@ -1,24 +1,26 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/tracing"
 )
 
 type mockTTSAdapter struct {
-	SynthesizeFunc func(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error)
+	SynthesizeFunc func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error)
 }
 
-func (m *mockTTSAdapter) Synthesize(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
-	return m.SynthesizeFunc(req, voiceID)
+func (m *mockTTSAdapter) Synthesize(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+	return m.SynthesizeFunc(ctx, req, voiceID)
 }
 
 func TestTTSService_Synthesize_Success(t *testing.T) {
 	adapter := &mockTTSAdapter{
-		SynthesizeFunc: func(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
 			return &domain.TTSResponse{Audio: []byte("MP3DATA"), Format: "mp3"}, nil
 		},
 	}
@@ -35,7 +37,7 @@ func TestTTSService_Synthesize_Success(t *testing.T) {
 			"speed":          1,
 		},
 	}
-	resp, err := service.Synthesize(req, "voice-123")
+	resp, err := service.Synthesize(context.Background(), req, "voice-123")
 	assert.NoError(t, err)
 	assert.Equal(t, []byte("MP3DATA"), resp.Audio)
 	assert.Equal(t, "mp3", resp.Format)
@@ -43,17 +45,158 @@ func TestTTSService_Synthesize_Success(t *testing.T) {
 
 func TestTTSService_Synthesize_Error(t *testing.T) {
 	adapter := &mockTTSAdapter{
-		SynthesizeFunc: func(req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
 			return nil, errors.New("TTS error")
 		},
 	}
 	service := NewTTSService(adapter)
 
 	req := &domain.TTSRequest{
-		Text:    "fail",
+		Text:     "fail",
 		Language: "en",
 	}
-	resp, err := service.Synthesize(req, "voice-123")
+	resp, err := service.Synthesize(context.Background(), req, "voice-123")
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-} 
\ No newline at end of file
+}
+func TestTTSService_Synthesize_Preset(t *testing.T) {
+	var gotReq *domain.TTSRequest
+	var gotVoiceID string
+	adapter := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			gotReq, gotVoiceID = req, voiceID
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	repo := memoryPresetRepository{}
+	repo.Save("alice", domain.Preset{
+		Name:    "calm",
+		VoiceID: "preset-voice",
+		TTSDefaults: domain.TTSDefaults{
+			Language:      "ko",
+			Style:         "neutral",
+			Model:         "sona_speech_1",
+			VoiceSettings: map[string]interface{}{"speed": 0.9, "pitch_shift": 0},
+		},
+	})
+	service := NewTTSService(adapter, WithPresets(repo))
+	ctx := domain.WithUserID(context.Background(), "alice")
+
+	req := &domain.TTSRequest{Text: "hi", Style: "happy", Preset: "calm", VoiceSettings: map[string]interface{}{"speed": 1.2}}
+	_, err := service.Synthesize(ctx, req, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "preset-voice", gotVoiceID)
+	assert.Equal(t, "ko", gotReq.Language)
+	assert.Equal(t, "happy", gotReq.Style)
+	assert.Equal(t, map[string]interface{}{"speed": 1.2, "pitch_shift": 0}, gotReq.VoiceSettings)
+
+	// 경로의 voiceID가 프리셋보다 우선
+	_, err = service.Synthesize(ctx, &domain.TTSRequest{Text: "hi", Preset: "calm"}, "path-voice")
+	assert.NoError(t, err)
+	assert.Equal(t, "path-voice", gotVoiceID)
+
+	// 프리셋에도 경로에도 voice_id가 없으면 잘못된 요청
+	repo.Save("alice", domain.Preset{Name: "no-voice", TTSDefaults: domain.TTSDefaults{Style: "calm"}})
+	_, err = service.Synthesize(ctx, &domain.TTSRequest{Text: "hi", Preset: "no-voice"}, "")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func TestTTSService_Synthesize_UnknownPreset(t *testing.T) {
+	service := NewTTSService(&mockTTSAdapter{}, WithPresets(memoryPresetRepository{}))
+
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi", Preset: "missing"}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrPresetNotFound)
+	assert.Nil(t, resp)
+}
+
+func TestTTSService_Synthesize_Lexicons(t *testing.T) {
+	var gotText string
+	adapter := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			gotText = req.Text
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	repo := memoryLexiconRepository{}
+	repo.Save("alice", domain.Lexicon{Name: "mine", Entries: []domain.LexiconEntry{
+		{Grapheme: "Supertone", Replacement: "슈퍼톤", Match: domain.MatchWord},
+	}})
+	global := []domain.Lexicon{{Name: "global", Entries: []domain.LexiconEntry{
+		{Grapheme: "Supertone", Replacement: "수퍼톤", Match: domain.MatchWord},
+		{Grapheme: "API", Replacement: "에이피아이", Match: domain.MatchWord},
+	}}}
+	service := NewTTSService(adapter, WithLexicons(repo, global))
+
+	// 사용자 사전이 전역 사전보다 먼저 적용됨
+	_, err := service.Synthesize(domain.WithUserID(context.Background(), "alice"), &domain.TTSRequest{Text: "Supertone API", Language: "ko"}, "voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "슈퍼톤 에이피아이", gotText)
+
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "Supertone API", Language: "ko"}, "voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "수퍼톤 에이피아이", gotText)
+}
+
+func TestTTSService_Synthesize_Normalize(t *testing.T) {
+	var gotText string
+	adapter := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			gotText = req.Text
+			return &domain.TTSResponse{Audio: []byte("WAVDATA"), Format: "wav"}, nil
+		},
+	}
+	global := []domain.Lexicon{{Name: "global", Entries: []domain.LexiconEntry{
+		{Grapheme: "3D", Replacement: "쓰리디", Match: domain.MatchWord},
+	}}}
+	service := NewTTSService(adapter, WithLexicons(nil, global))
+
+	// 사전이 정규화보다 먼저 적용됨
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "3D 안경 2개", Language: "ko"}, "voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "쓰리디 안경 두 개", gotText)
+
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "It costs $5", Language: "en"}, "voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "It costs five dollars", gotText)
+
+	off := false
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "3D 안경 2개", Language: "ko", Normalize: &off}, "voice-123")
+	assert.NoError(t, err)
+	assert.Equal(t, "쓰리디 안경 2개", gotText)
+}
+
+type memorySpanExporter struct{ spans []tracing.SpanData }
+
+func (m *memorySpanExporter) Export(ctx context.Context, spans []tracing.SpanData) error {
+	m.spans = append(m.spans, spans...)
+	return nil
+}
+
+func TestTTSService_Synthesize_Tracing(t *testing.T) {
+	exporter := &memorySpanExporter{}
+	tracer := tracing.NewTracer(exporter, tracing.TracerOptions{SampleRatio: 1})
+	defer tracing.SetDefault(tracing.Default())
+	tracing.SetDefault(tracer)
+
+	var adapterSpan tracing.SpanContext
+	service := NewTTSService(&mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			adapterSpan = tracing.SpanContextFromContext(ctx)
+			return &domain.TTSResponse{Audio: []byte("MP3DATA"), Format: "mp3"}, nil
+		},
+	})
+	_, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "안녕", Language: "ko"}, "voice-123")
+	assert.NoError(t, err)
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hi", OutputFormat: "ogg"}, "voice-123")
+	assert.Error(t, err)
+	assert.NoError(t, tracer.Shutdown(context.Background()))
+
+	assert.Len(t, exporter.spans, 2)
+	ok, failed := exporter.spans[0], exporter.spans[1]
+	assert.Equal(t, "tts.synthesize", ok.Name)
+	assert.Equal(t, ok.SpanContext, adapterSpan) // 어댑터는 유즈케이스 스팬 안에서 호출됨
+	assert.Contains(t, ok.Attributes, tracing.String("tts.voice_id", "voice-123"))
+	assert.Contains(t, ok.Attributes, tracing.Int("tts.chars", 2))
+	assert.Equal(t, tracing.StatusUnset, ok.Status)
+	assert.Equal(t, tracing.StatusError, failed.Status)
+}
@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+// usageService는 UsageService의 실제 구현체입니다.
+type usageService struct {
+	repo    domain.UsageRepository
+	pricing domain.UsagePricing
+}
+
+// NewUsageService는 pricing으로 비용을 추정하는 UsageService 구현체를 생성합니다.
+func NewUsageService(repo domain.UsageRepository, pricing domain.UsagePricing) domain.UsageService {
+	return &usageService{repo: repo, pricing: pricing}
+}
+
+// Report는 기간 안의 시간당 버킷을 GroupBy 기준으로 합칩니다. 비용은 버킷마다 그 모델의 가격으로 계산해 더합니다.
+func (s *usageService) Report(ctx context.Context, q domain.UsageQuery) (*domain.UsageReport, error) {
+	key, err := usageGroupKey(q.GroupBy)
+	if err != nil {
+		return nil, err
+	}
+	if !q.From.IsZero() && !q.To.IsZero() && !q.From.Before(q.To) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidRequest)
+	}
+	buckets, err := s.repo.Query(q.From, q.To)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.UsageReport{From: q.From, To: q.To, GroupBy: q.GroupBy, Currency: s.pricing.Currency, Rows: []domain.UsageRow{}}
+	rows := map[string]*domain.UsageRow{}
+	for _, b := range buckets {
+		k := key(b)
+		row, ok := rows[k]
+		if !ok {
+			row = &domain.UsageRow{Key: k}
+			rows[k] = row
+		}
+		cost := s.pricing.Cost(b.Model, b.Characters, b.AudioSeconds)
+		for _, r := range []*domain.UsageRow{row, &report.Total} {
+			r.Requests += b.Requests
+			r.Characters += b.Characters
+			r.AudioSeconds += b.AudioSeconds
+			r.EstimatedCost += cost
+		}
+	}
+	for _, row := range rows {
+		report.Rows = append(report.Rows, *row)
+	}
+	sort.Slice(report.Rows, func(i, j int) bool { return report.Rows[i].Key < report.Rows[j].Key })
+	return report, nil
+}
+
+func usageGroupKey(groupBy string) (func(domain.UsageBucket) string, error) {
+	switch groupBy {
+	case domain.UsageByUser:
+		return func(b domain.UsageBucket) string { return b.UserID }, nil
+	case domain.UsageByVoice:
+		return func(b domain.UsageBucket) string { return b.VoiceID }, nil
+	case domain.UsageByModel:
+		return func(b domain.UsageBucket) string { return b.Model }, nil
+	}
+	return nil, fmt.Errorf("%w: group_by must be user, voice or model", domain.ErrInvalidRequest)
+}
+
+// recordUsage는 완료된 합성의 글자 수와 오디오 길이를 사용량 저장소에 더합니다.
+// 사용량 기록 실패는 합성 결과에 영향을 주지 않고 로그만 남깁니다.
+func (s *ttsService) recordUsage(ctx context.Context, text, voiceID string, req *domain.TTSRequest, resp *domain.TTSResponse) {
+	duration, err := audioDuration(resp)
+	if err != nil {
+		slog.DebugContext(ctx, "unknown audio duration for usage", "format", resp.Format, "error", err)
+	}
+	err = s.usage.Add(domain.UsageRecord{
+		Time:         time.Now(),
+		UserID:       domain.UserIDFromContext(ctx),
+		VoiceID:      voiceID,
+		Model:        req.Model,
+		Characters:   utf8.RuneCountInString(text),
+		AudioSeconds: duration.Seconds(),
+	})
+	if err != nil {
+		slog.WarnContext(ctx, "failed to record usage", "error", err)
+	}
+}
+
+// audioDuration은 응답 오디오를 디코딩하지 않고 형식별 헤더나 크기로 길이를 계산합니다.
+func audioDuration(resp *domain.TTSResponse) (time.Duration, error) {
+	switch resp.Format {
+	case domain.FormatWAV, "":
+		return audio.WAVDuration(resp.Audio)
+	case domain.FormatFLAC:
+		return audio.FLACDuration(resp.Audio)
+	case domain.FormatMP3:
+		return audio.MP3Duration(resp.Audio)
+	}
+	// 헤더가 없는 형식은 SampleRate와 Channels로 계산
+	bytesPerSample := 1 // mulaw, alaw
+	if resp.Format == domain.FormatPCM {
+		bytesPerSample = 2
+	}
+	if resp.SampleRate == 0 || resp.Channels == 0 {
+		return 0, audio.ErrUnknownDuration
+	}
+	frames := len(resp.Audio) / bytesPerSample / resp.Channels
+	return time.Duration(float64(frames) / float64(resp.SampleRate) * float64(time.Second)), nil
+}
@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+type memoryUsageRepository struct {
+	records []domain.UsageRecord
+	buckets []domain.UsageBucket
+}
+
+func (m *memoryUsageRepository) Add(record domain.UsageRecord) error {
+	m.records = append(m.records, record)
+	return nil
+}
+
+func (m *memoryUsageRepository) Query(from, to time.Time) ([]domain.UsageBucket, error) {
+	return m.buckets, nil
+}
+
+func TestTTSService_Synthesize_Usage(t *testing.T) {
+	wav := audio.EncodeWAV(audio.Silence(24000, 1, 1500*time.Millisecond))
+	adapter := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return &domain.TTSResponse{Audio: wav, Format: "wav"}, nil
+		},
+	}
+	usage := &memoryUsageRepository{}
+	service := NewTTSService(adapter, WithUsage(usage))
+	ctx := domain.WithUserID(context.Background(), "alice")
+
+	_, err := service.Synthesize(ctx, &domain.TTSRequest{Text: "안녕하세요", Language: "ko", Model: "sona_speech_1"}, "voice-1")
+	require.NoError(t, err)
+	// 감사 기록에 실패해 결과를 돌려주지 않은 합성은 사용량에 더하지 않음
+	service = NewTTSService(adapter, WithUsage(usage), WithAudit(&memoryAuditRepository{err: assert.AnError}, "", false))
+	_, err = service.Synthesize(ctx, &domain.TTSRequest{Text: "hi", Language: "en"}, "voice-1")
+	require.Error(t, err)
+
+	require.Len(t, usage.records, 1)
+	record := usage.records[0]
+	assert.Equal(t, "alice", record.UserID)
+	assert.Equal(t, "voice-1", record.VoiceID)
+	assert.Equal(t, "sona_speech_1", record.Model)
+	assert.Equal(t, 5, record.Characters)
+	assert.InDelta(t, 1.5, record.AudioSeconds, 1e-9)
+}
+
+func TestAudioDuration(t *testing.T) {
+	for _, tc := range []struct {
+		resp domain.TTSResponse
+		want time.Duration
+	}{
+		{domain.TTSResponse{Format: "flac", Audio: audio.EncodeFLAC(audio.Silence(24000, 1, time.Second))}, time.Second},
+		{domain.TTSResponse{Format: "pcm", Audio: make([]byte, 96000), SampleRate: 24000, Channels: 2}, time.Second},
+		{domain.TTSResponse{Format: "mulaw", Audio: make([]byte, 4000), SampleRate: 8000, Channels: 1}, 500 * time.Millisecond},
+	} {
+		got, err := audioDuration(&tc.resp)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.want, got, tc.resp.Format)
+	}
+	_, err := audioDuration(&domain.TTSResponse{Format: "mp3", Audio: []byte("ID3")})
+	assert.ErrorIs(t, err, audio.ErrUnknownDuration)
+}
+
+func TestUsageService_Report(t *testing.T) {
+	hour := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	repo := &memoryUsageRepository{buckets: []domain.UsageBucket{
+		{Hour: hour, UserID: "alice", VoiceID: "voice-1", Model: "sona_speech_1", Requests: 2, Characters: 2000, AudioSeconds: 60},
+		{Hour: hour, UserID: "alice", VoiceID: "voice-2", Model: "", Requests: 1, Characters: 1000, AudioSeconds: 30},
+		{Hour: hour.Add(time.Hour), UserID: "bob", VoiceID: "voice-1", Model: "sona_speech_1", Requests: 1, Characters: 500, AudioSeconds: 15},
+	}}
+	pricing := domain.UsagePricing{Currency: "USD", Models: map[string]domain.ModelPrice{
+		"sona_speech_1": {PerThousandChars: 0.2},
+		"default":       {PerThousandChars: 0.1, PerAudioMinute: 0.02},
+	}}
+	service := NewUsageService(repo, pricing)
+
+	report, err := service.Report(context.Background(), domain.UsageQuery{GroupBy: "user"})
+	require.NoError(t, err)
+	assert.Equal(t, "USD", report.Currency)
+	require.Len(t, report.Rows, 2)
+	assert.Equal(t, "alice", report.Rows[0].Key)
+	assert.Equal(t, int64(3), report.Rows[0].Requests)
+	assert.Equal(t, int64(3000), report.Rows[0].Characters)
+	assert.InDelta(t, 0.4+0.1+0.01, report.Rows[0].EstimatedCost, 1e-9)
+	assert.InDelta(t, 0.61, report.Total.EstimatedCost, 1e-9)
+	assert.Equal(t, 105.0, report.Total.AudioSeconds)
+
+	report, err = service.Report(context.Background(), domain.UsageQuery{GroupBy: "voice"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"voice-1", "voice-2"}, []string{report.Rows[0].Key, report.Rows[1].Key})
+	assert.Equal(t, int64(2500), report.Rows[0].Characters)
+
+	_, err = service.Report(context.Background(), domain.UsageQuery{GroupBy: "region"})
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	_, err = service.Report(context.Background(), domain.UsageQuery{GroupBy: "model", From: hour, To: hour})
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"sync"
+
+	"tts_proxy/internal/domain"
+)
+
+// VoiceAliasRegistry는 domain.VoiceAliasRegistry의 메모리 구현체로, 실행 중 교체가 가능합니다.
+type VoiceAliasRegistry struct {
+	mu       sync.RWMutex
+	aliases  map[string]domain.VoiceAlias
+	voiceIDs map[string]bool
+}
+
+// NewVoiceAliasRegistry는 주어진 별칭 목록으로 레지스트리를 생성합니다.
+func NewVoiceAliasRegistry(aliases []domain.VoiceAlias) *VoiceAliasRegistry {
+	r := &VoiceAliasRegistry{}
+	r.Replace(aliases)
+	return r
+}
+
+// Resolve는 별칭에 해당하는 음성을 반환합니다.
+func (r *VoiceAliasRegistry) Resolve(name string) (*domain.VoiceAlias, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	alias, ok := r.aliases[name]
+	if !ok {
+		return nil, false
+	}
+	return &alias, true
+}
+
+// Replace는 별칭 목록 전체를 원자적으로 교체합니다.
+func (r *VoiceAliasRegistry) Replace(aliases []domain.VoiceAlias) {
+	m := make(map[string]domain.VoiceAlias, len(aliases))
+	ids := make(map[string]bool, len(aliases))
+	for _, a := range aliases {
+		m[a.Name] = a
+		ids[a.VoiceID] = true
+	}
+
+	r.mu.Lock()
+	r.aliases, r.voiceIDs = m, ids
+	r.mu.Unlock()
+}
+
+// HasVoice는 voiceID를 가리키는 별칭이 있는지 반환합니다.
+func (r *VoiceAliasRegistry) HasVoice(voiceID string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.voiceIDs[voiceID]
+}
@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+func TestVoiceAliasRegistry_Resolve(t *testing.T) {
+	registry := NewVoiceAliasRegistry([]domain.VoiceAlias{
+		{Name: "narrator", VoiceID: "voice-123", Defaults: domain.TTSDefaults{Language: "ko"}},
+	})
+
+	alias, ok := registry.Resolve("narrator")
+	assert.True(t, ok)
+	assert.Equal(t, "voice-123", alias.VoiceID)
+	assert.Equal(t, "ko", alias.Defaults.Language)
+
+	_, ok = registry.Resolve("voice-123")
+	assert.False(t, ok)
+}
+
+func TestVoiceAliasRegistry_Replace(t *testing.T) {
+	registry := NewVoiceAliasRegistry([]domain.VoiceAlias{{Name: "narrator", VoiceID: "old"}})
+
+	registry.Replace([]domain.VoiceAlias{{Name: "kid-girl", VoiceID: "new"}})
+
+	_, ok := registry.Resolve("narrator")
+	assert.False(t, ok)
+	alias, ok := registry.Resolve("kid-girl")
+	assert.True(t, ok)
+	assert.Equal(t, "new", alias.VoiceID)
+}
+
+func TestTTSRequest_ApplyDefaults(t *testing.T) {
+	req := &domain.TTSRequest{
+		Text:          "hi",
+		Style:         "happy",
+		VoiceSettings: map[string]interface{}{"speed": 1.2},
+	}
+
+	req.ApplyDefaults(domain.TTSDefaults{
+		Language:      "ko",
+		Style:         "neutral",
+		Model:         "sona_speech_1",
+		VoiceSettings: map[string]interface{}{"speed": 0.9, "pitch_shift": 2},
+	})
+
+	assert.Equal(t, "ko", req.Language)
+	assert.Equal(t, "happy", req.Style)
+	assert.Equal(t, "sona_speech_1", req.Model)
+	assert.Equal(t, map[string]interface{}{"speed": 1.2, "pitch_shift": 2}, req.VoiceSettings)
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"tts_proxy/internal/domain"
+)
+
+// VoiceCatalogAdapter는 외부 TTS API의 음성 목록 조회를 추상화합니다.
+type VoiceCatalogAdapter interface {
+	ListVoices(ctx context.Context) ([]domain.Voice, error)
+}
+
+// voiceService는 VoiceService의 실제 구현체로, 음성 목록을 TTL 동안 캐시합니다.
+type voiceService struct {
+	adapter VoiceCatalogAdapter
+	ttl     time.Duration
+	now     func() time.Time
+	metrics *Metrics
+
+	mu        sync.Mutex
+	voices    []domain.Voice
+	fetchedAt time.Time
+}
+
+// VoiceServiceOption은 voiceService의 선택적 의존성을 설정합니다.
+type VoiceServiceOption func(*voiceService)
+
+// WithVoiceCacheMetrics는 음성 목록 캐시의 적중 여부를 m에 기록합니다.
+func WithVoiceCacheMetrics(m *Metrics) VoiceServiceOption {
+	return func(s *voiceService) {
+		s.metrics = m
+	}
+}
+
+// NewVoiceService는 VoiceService 구현체를 생성합니다. ttl이 0 이하이면 캐시하지 않습니다.
+func NewVoiceService(adapter VoiceCatalogAdapter, ttl time.Duration, opts ...VoiceServiceOption) domain.VoiceService {
+	s := &voiceService{adapter: adapter, ttl: ttl, now: time.Now}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// ListVoices는 캐시된 음성 목록에 필터를 적용해 반환합니다.
+func (s *voiceService) ListVoices(ctx context.Context, filter domain.VoiceFilter) ([]domain.Voice, error) {
+	voices, err := s.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.Voice, 0, len(voices))
+	for _, v := range voices {
+		if matchVoice(v, filter) {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// GetVoice는 ID로 음성을 조회합니다.
+func (s *voiceService) GetVoice(ctx context.Context, id string) (*domain.Voice, error) {
+	voices, err := s.catalog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range voices {
+		if v.ID == id {
+			voice := v
+			return &voice, nil
+		}
+	}
+	return nil, domain.ErrVoiceNotFound
+}
+
+// catalog는 캐시가 유효하면 캐시를, 아니면 어댑터에서 새로 가져온 목록을 반환합니다.
+func (s *voiceService) catalog(ctx context.Context) ([]domain.Voice, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.voices != nil && s.ttl > 0 && s.now().Sub(s.fetchedAt) < s.ttl {
+		s.metrics.cacheLookup("voices", true)
+		return s.voices, nil
+	}
+	s.metrics.cacheLookup("voices", false)
+
+	voices, err := s.adapter.ListVoices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.voices = voices
+	s.fetchedAt = s.now()
+	s.metrics.setCatalog(voices)
+	return voices, nil
+}
+
+func matchVoice(v domain.Voice, f domain.VoiceFilter) bool {
+	if f.Gender != "" && !strings.EqualFold(v.Gender, f.Gender) {
+		return false
+	}
+	if f.Age != "" && !strings.EqualFold(v.Age, f.Age) {
+		return false
+	}
+	if f.Language != "" && !containsFold(v.Languages, f.Language) {
+		return false
+	}
+	if f.Style != "" && !containsFold(v.Styles, f.Style) {
+		return false
+	}
+	if f.Model != "" && !containsFold(v.Models, f.Model) {
+		return false
+	}
+	return true
+}
+
+func containsFold(values []string, target string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"tts_proxy/internal/domain"
+)
+
+type mockVoiceCatalogAdapter struct {
+	calls  int
+	voices []domain.Voice
+	err    error
+}
+
+func (m *mockVoiceCatalogAdapter) ListVoices(ctx context.Context) ([]domain.Voice, error) {
+	m.calls++
+	return m.voices, m.err
+}
+
+func testVoices() []domain.Voice {
+	return []domain.Voice{
+		{ID: "v1", Name: "Agatha", Gender: "female", Age: "young-adult", Languages: []string{"ko", "en"}, Styles: []string{"neutral", "happy"}, Models: []string{"sona_speech_1"}},
+		{ID: "v2", Name: "Bob", Gender: "male", Age: "middle-aged", Languages: []string{"en"}, Styles: []string{"neutral"}, Models: []string{"sona_speech_1"}},
+		{ID: "v3", Name: "Chika", Gender: "female", Age: "child", Languages: []string{"ja"}, Styles: []string{"sad"}, Models: []string{"sona_speech_2"}},
+	}
+}
+
+func TestVoiceService_ListVoices_Filter(t *testing.T) {
+	adapter := &mockVoiceCatalogAdapter{voices: testVoices()}
+	service := NewVoiceService(adapter, time.Minute)
+
+	voices, err := service.ListVoices(context.Background(), domain.VoiceFilter{Language: "EN", Gender: "female"})
+	assert.NoError(t, err)
+	assert.Len(t, voices, 1)
+	assert.Equal(t, "v1", voices[0].ID)
+
+	voices, err = service.ListVoices(context.Background(), domain.VoiceFilter{Model: "sona_speech_1", Style: "neutral"})
+	assert.NoError(t, err)
+	assert.Len(t, voices, 2)
+
+	voices, err = service.ListVoices(context.Background(), domain.VoiceFilter{Age: "child"})
+	assert.NoError(t, err)
+	assert.Len(t, voices, 1)
+	assert.Equal(t, "v3", voices[0].ID)
+}
+
+func TestVoiceService_Cache(t *testing.T) {
+	adapter := &mockVoiceCatalogAdapter{voices: testVoices()}
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	service := &voiceService{adapter: adapter, ttl: time.Minute, now: func() time.Time { return now }}
+
+	_, err := service.ListVoices(context.Background(), domain.VoiceFilter{})
+	assert.NoError(t, err)
+	_, err = service.GetVoice(context.Background(), "v2")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, adapter.calls)
+
+	// TTL 경과 후에는 다시 조회
+	now = now.Add(2 * time.Minute)
+	_, err = service.ListVoices(context.Background(), domain.VoiceFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, adapter.calls)
+}
+
+func TestVoiceService_GetVoice_NotFound(t *testing.T) {
+	service := NewVoiceService(&mockVoiceCatalogAdapter{voices: testVoices()}, time.Minute)
+
+	voice, err := service.GetVoice(context.Background(), "missing")
+	assert.ErrorIs(t, err, domain.ErrVoiceNotFound)
+	assert.Nil(t, voice)
+}
+
+func TestVoiceService_AdapterError(t *testing.T) {
+	service := NewVoiceService(&mockVoiceCatalogAdapter{err: errors.New("upstream down")}, time.Minute)
+
+	voices, err := service.ListVoices(context.Background(), domain.VoiceFilter{})
+	assert.Error(t, err)
+	assert.Nil(t, voices)
+}
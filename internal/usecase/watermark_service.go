@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+	"tts_proxy/pkg/watermark"
+)
+
+// watermarkService는 WatermarkService의 실제 구현체입니다.
+type watermarkService struct {
+	key []byte
+}
+
+// NewWatermarkService는 key로 삽입한 워터마크를 검출하는 WatermarkService 구현체를 생성합니다.
+func NewWatermarkService(key []byte) domain.WatermarkService {
+	return &watermarkService{key: key}
+}
+
+// Detect는 WAV를 디코딩해 워터마크 페이로드를 읽습니다.
+func (s *watermarkService) Detect(ctx context.Context, wav []byte, userID string) (*domain.WatermarkDetection, error) {
+	if len(s.key) == 0 {
+		return nil, fmt.Errorf("%w: watermarking is not configured", domain.ErrInvalidRequest)
+	}
+	pcm, err := audio.DecodeWAV(wav)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidRequest, err)
+	}
+	found, err := watermark.Detect(pcm, s.key)
+	if errors.Is(err, watermark.ErrNotFound) {
+		return nil, domain.ErrWatermarkNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	detection := &domain.WatermarkDetection{
+		RequestID:       fmt.Sprintf("%016x", found.RequestID),
+		UserFingerprint: fmt.Sprintf("%08x", found.UserHash),
+		Confidence:      found.Confidence,
+		Copies:          found.Copies,
+	}
+	if userID != "" {
+		match := watermark.UserHash(s.key, userID) == found.UserHash
+		detection.UserMatch = &match
+	}
+	return detection, nil
+}
+
+// watermarkExplicit는 요청이 post_processing.watermark로 워터마크를 직접 요청했는지 반환합니다.
+func watermarkExplicit(req *domain.TTSRequest) bool {
+	return req.PostProcessing != nil && req.PostProcessing.Watermark
+}
+
+// watermarkRequested는 이 요청의 결과에 워터마크를 넣어야 하는지 반환합니다.
+// WATERMARK_ALL은 워터마크를 넣을 수 없는 mp3 출력에는 적용하지 않습니다.
+func (s *ttsService) watermarkRequested(req *domain.TTSRequest) bool {
+	return watermarkExplicit(req) || (s.watermarkAll && req.OutputFormat != domain.FormatMP3)
+}
+
+// validateWatermark는 워터마크를 직접 요청했지만 넣을 수 없는 요청을 거부합니다.
+func (s *ttsService) validateWatermark(req *domain.TTSRequest) error {
+	if !watermarkExplicit(req) {
+		return nil
+	}
+	if len(s.watermarkKey) == 0 {
+		return fmt.Errorf("%w: watermarking is not configured", domain.ErrInvalidRequest)
+	}
+	if req.OutputFormat == domain.FormatMP3 {
+		return fmt.Errorf("%w: mp3 output cannot be watermarked", domain.ErrInvalidRequest)
+	}
+	return nil
+}
+
+// embedWatermark는 컨텍스트의 요청 ID와 사용자 ID를 담은 워터마크를 pcm에 넣고 요청 ID를 반환합니다.
+// 요청 ID가 없으면 새로 만듭니다. 오디오가 watermark.MinDuration보다 짧으면 페이로드를 온전히 실을 수 없으므로,
+// 직접 요청했으면 ErrInvalidRequest를 반환하고 WATERMARK_ALL이면 넣지 않고 빈 요청 ID를 반환합니다.
+func (s *ttsService) embedWatermark(ctx context.Context, pcm *audio.PCM, req *domain.TTSRequest) (string, error) {
+	if pcm.Duration().Seconds() < watermark.MinDuration {
+		if watermarkExplicit(req) {
+			return "", fmt.Errorf("%w: audio must be at least %.1fs long to be watermarked", domain.ErrInvalidRequest, watermark.MinDuration)
+		}
+		return "", nil
+	}
+	requestID := domain.RequestIDFromContext(ctx)
+	if requestID == "" {
+		var err error
+		if requestID, err = domain.NewRequestID(); err != nil {
+			return "", err
+		}
+	}
+	watermark.Embed(pcm, s.watermarkKey, watermark.Payload{
+		RequestID: watermark.RequestTag(requestID),
+		UserHash:  watermark.UserHash(s.watermarkKey, domain.UserIDFromContext(ctx)),
+	})
+	return requestID, nil
+}
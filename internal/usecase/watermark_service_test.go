@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/internal/domain"
+	"tts_proxy/pkg/audio"
+)
+
+var testWatermarkKey = []byte("test-watermark-key")
+
+// longToneAdapter는 워터마크 페이로드를 여러 번 담을 수 있는 4초 길이의 WAV를 반환합니다.
+func longToneAdapter() *mockTTSAdapter {
+	return &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			tone := audio.Silence(24000, 1, 4*time.Second)
+			for i := range tone.Samples {
+				if (i/6000)%2 == 0 {
+					tone.Samples[i] = 0.3 * float64(i%55-27) / 27 // 짧은 무음이 끼어 있는 톱니파
+				}
+			}
+			return &domain.TTSResponse{Audio: audio.EncodeWAV(tone), Format: "wav"}, nil
+		},
+	}
+}
+
+func TestTTSService_Synthesize_Watermark(t *testing.T) {
+	service := NewTTSService(longToneAdapter(), WithWatermark(testWatermarkKey, false))
+	ctx := domain.WithRequestID(domain.WithUserID(context.Background(), "alice"), "0123456789abcdef")
+
+	resp, err := service.Synthesize(ctx, &domain.TTSRequest{
+		Text: "hello", Language: "en",
+		PostProcessing: &domain.PostProcessingOptions{Watermark: true},
+	}, "voice-123")
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", resp.RequestID)
+
+	detector := NewWatermarkService(testWatermarkKey)
+	found, err := detector.Detect(context.Background(), resp.Audio, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef", found.RequestID)
+	assert.True(t, *found.UserMatch)
+
+	found, err = detector.Detect(context.Background(), resp.Audio, "bob")
+	require.NoError(t, err)
+	assert.False(t, *found.UserMatch)
+
+	_, err = NewWatermarkService([]byte("other-key")).Detect(context.Background(), resp.Audio, "")
+	assert.ErrorIs(t, err, domain.ErrWatermarkNotFound)
+}
+
+func TestTTSService_Synthesize_WatermarkAll(t *testing.T) {
+	service := NewTTSService(longToneAdapter(), WithWatermark(testWatermarkKey, true))
+
+	// 요청 ID가 없으면 새로 만들어 워터마크에 담음
+	resp, err := service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hello", Language: "en"}, "voice-123")
+	require.NoError(t, err)
+	assert.Len(t, resp.RequestID, 16)
+
+	found, err := NewWatermarkService(testWatermarkKey).Detect(context.Background(), resp.Audio, "")
+	require.NoError(t, err)
+	assert.Equal(t, resp.RequestID, found.RequestID)
+	assert.Nil(t, found.UserMatch)
+
+	// mp3는 워터마크를 넣을 수 없으므로 거부하지 않고 그대로 합성
+	resp, err = service.Synthesize(context.Background(), &domain.TTSRequest{Text: "hello", OutputFormat: domain.FormatMP3}, "voice-123")
+	require.NoError(t, err)
+	assert.Empty(t, resp.RequestID)
+
+	// 직접 요청한 mp3 워터마크는 거부
+	_, err = service.Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", OutputFormat: domain.FormatMP3, PostProcessing: &domain.PostProcessingOptions{Watermark: true},
+	}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
+
+func TestTTSService_Synthesize_WatermarkShortClip(t *testing.T) {
+	shortClip := &mockTTSAdapter{
+		SynthesizeFunc: func(ctx context.Context, req *domain.TTSRequest, voiceID string) (*domain.TTSResponse, error) {
+			return &domain.TTSResponse{Audio: audio.EncodeWAV(audio.Silence(24000, 1, time.Second)), Format: "wav"}, nil
+		},
+	}
+	ctx := domain.WithRequestID(context.Background(), "0123456789abcdef")
+
+	// 페이로드를 온전히 실을 수 없는 길이이면 직접 요청한 경우 거부
+	_, err := NewTTSService(shortClip, WithWatermark(testWatermarkKey, false)).Synthesize(ctx, &domain.TTSRequest{
+		Text: "hi", Language: "en", PostProcessing: &domain.PostProcessingOptions{Watermark: true},
+	}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+
+	// WATERMARK_ALL이면 워터마크 없이 응답하고 요청 ID를 돌려주지 않음
+	resp, err := NewTTSService(shortClip, WithWatermark(testWatermarkKey, true)).Synthesize(ctx, &domain.TTSRequest{
+		Text: "hi", Language: "en",
+	}, "voice-123")
+	require.NoError(t, err)
+	assert.Empty(t, resp.RequestID)
+}
+
+func TestWatermark_NotConfigured(t *testing.T) {
+	_, err := NewTTSService(longToneAdapter()).Synthesize(context.Background(), &domain.TTSRequest{
+		Text: "hello", Language: "en", PostProcessing: &domain.PostProcessingOptions{Watermark: true},
+	}, "voice-123")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+
+	_, err = NewWatermarkService(nil).Detect(context.Background(), []byte("RIFF"), "")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+	_, err = NewWatermarkService(testWatermarkKey).Detect(context.Background(), []byte("not a wav"), "")
+	assert.ErrorIs(t, err, domain.ErrInvalidRequest)
+}
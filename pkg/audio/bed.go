@@ -0,0 +1,145 @@
+package audio
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	duckThresholdDB = -45.0                  // 이보다 큰 에너지를 음성으로 봄
+	duckAttack      = 120 * time.Millisecond // 음성이 시작되기 전에 미리 줄이는 시간
+	duckRelease     = 400 * time.Millisecond // 음성이 끝난 뒤 유지했다가 되돌리는 시간
+	loopCrossfade   = 30 * time.Millisecond  // 반복 이음매의 크로스페이드
+)
+
+// BedOptions는 음성 아래에 까는 배경 음악(베드)의 믹스 설정입니다.
+type BedOptions struct {
+	GainDB    float64       // 베드 음량 (dB)
+	DuckingDB float64       // 음성이 나오는 동안 추가로 줄일 음량 (0 이하, 0이면 덕킹 없음)
+	FadeIn    time.Duration // 베드 시작 페이드 인
+	FadeOut   time.Duration // 베드 끝 페이드 아웃
+	LeadIn    time.Duration // 음성 시작 전 베드만 나오는 시간
+	Tail      time.Duration // 음성이 끝난 뒤 베드가 이어지는 시간
+	Loop      bool          // 베드가 결과보다 짧으면 반복
+}
+
+// MixBed는 speech를 LeadIn 뒤에 배치하고 bed를 아래에 깔아 하나의 클립으로 합칩니다.
+// bed는 speech의 표본화율과 채널 수로 변환되며, 결과 길이는 LeadIn + speech + Tail입니다.
+func MixBed(speech, bed *PCM, opts BedOptions) (*PCM, error) {
+	bed = Convert(bed, speech.SampleRate, speech.Channels)
+
+	rate := float64(speech.SampleRate)
+	lead := int(opts.LeadIn.Seconds() * rate)
+	total := lead + speech.Frames() + int(opts.Tail.Seconds()*rate)
+	track, frames := loopBed(bed, total, opts.Loop, int(loopCrossfade.Seconds()*rate))
+
+	gain := math.Pow(10, opts.GainDB/20)
+	duck := duckEnvelope(speech, lead, frames, opts.DuckingDB)
+	fadeIn := int(opts.FadeIn.Seconds() * rate)
+	fadeOut := int(opts.FadeOut.Seconds() * rate)
+	for f := 0; f < frames; f++ {
+		g := gain * duck[f] * fade(f, fadeIn) * fade(frames-1-f, fadeOut)
+		for ch := 0; ch < track.Channels; ch++ {
+			track.Samples[f*track.Channels+ch] *= g
+		}
+	}
+
+	return Mix(Track{Clip: track}, Track{Clip: speech, Offset: opts.LeadIn})
+}
+
+// loopBed는 bed를 total 프레임 길이의 새 클립으로 만들고, 소리가 나는 프레임 수를 함께 반환합니다.
+// loop이면 src-crossfade 간격으로 반복하면서 앞 반복의 끝과 다음 반복의 시작을 등전력 크로스페이드하고,
+// 아니면 한 번만 재생한 뒤 무음으로 채웁니다.
+func loopBed(bed *PCM, total int, loop bool, crossfade int) (*PCM, int) {
+	src := bed.Frames()
+	frames := total
+	if !loop || src == 0 {
+		frames = min(total, src)
+		crossfade = 0
+	}
+	if src < 2*crossfade {
+		crossfade = 0
+	}
+	period := max(src-crossfade, 1)
+
+	out := &PCM{SampleRate: bed.SampleRate, Channels: bed.Channels, Samples: make([]float64, total*bed.Channels)}
+	for f := 0; f < frames; f++ {
+		k, pos := f/period, f%period
+		for ch := 0; ch < bed.Channels; ch++ {
+			v := bed.Samples[pos*bed.Channels+ch]
+			if k > 0 && pos < crossfade {
+				x := float64(pos) / float64(crossfade) * math.Pi / 2
+				prev := bed.Samples[(pos+period)*bed.Channels+ch]
+				v = v*math.Sin(x) + prev*math.Cos(x)
+			}
+			out.Samples[f*bed.Channels+ch] = v
+		}
+	}
+	return out, frames
+}
+
+// fade는 경계에서 pos 프레임 떨어진 위치의 페이드 이득(0~1, 코사인 곡선)을 반환합니다.
+func fade(pos, length int) float64 {
+	if length <= 0 || pos >= length {
+		return 1
+	}
+	if pos < 0 {
+		return 0
+	}
+	return (1 - math.Cos(math.Pi*float64(pos)/float64(length))) / 2
+}
+
+// duckEnvelope은 베드의 프레임별 덕킹 이득을 계산합니다. 음성이 있는 10ms 창마다 목표 이득을 두고,
+// 앞으로 duckAttack, 뒤로 duckRelease만큼 넓힌 뒤 이동 평균으로 부드럽게 바꿉니다.
+func duckEnvelope(speech *PCM, lead, frames int, duckingDB float64) []float64 {
+	env := make([]float64, frames)
+	for f := range env {
+		env[f] = 1
+	}
+	window := int(float64(speech.SampleRate) * analysisWindow.Seconds())
+	if duckingDB >= 0 || window <= 0 || frames == 0 {
+		return env
+	}
+
+	// 결과 전체를 창 단위로 나눠 음성 구간의 목표 이득을 구함
+	windows := (frames + window - 1) / window
+	target := make([]float64, windows)
+	threshold := math.Pow(10, duckThresholdDB/20)
+	duck := math.Pow(10, duckingDB/20)
+	for w := range target {
+		target[w] = 1
+		start, end := w*window-lead, (w+1)*window-lead
+		start, end = max(start, 0), min(end, speech.Frames())
+		if start < end && speech.rms(start, end) >= threshold {
+			target[w] = duck
+		}
+	}
+
+	attack := int(duckAttack / analysisWindow)
+	held := movingMin(target, int(duckRelease/analysisWindow), attack)
+	smooth := make([]float64, windows)
+	var sum float64
+	for w := range held {
+		sum += held[w]
+		if w >= attack {
+			sum -= held[w-attack]
+		}
+		smooth[w] = sum / float64(min(w+1, attack))
+	}
+
+	// 창 중심 사이를 선형 보간
+	for f := range env {
+		x := float64(f)/float64(window) - 0.5
+		w := int(math.Floor(x))
+		switch {
+		case w < 0:
+			env[f] = smooth[0]
+		case w >= windows-1:
+			env[f] = smooth[windows-1]
+		default:
+			t := x - float64(w)
+			env[f] = smooth[w]*(1-t) + smooth[w+1]*t
+		}
+	}
+	return env
+}
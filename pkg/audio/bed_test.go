@@ -0,0 +1,84 @@
+package audio
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMixBed_LayoutAndGain(t *testing.T) {
+	speech := constant(0.5, time.Second)
+	out, err := MixBed(speech, constant(0.5, 10*time.Second), BedOptions{
+		GainDB: -6,
+		LeadIn: 500 * time.Millisecond,
+		Tail:   time.Second,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2500, out.Frames())
+
+	bed := 0.5 * math.Pow(10, -6.0/20)
+	assert.InDelta(t, bed, out.Samples[100], 1e-9)      // 음성 전에는 배경만
+	assert.InDelta(t, 0.5+bed, out.Samples[1000], 1e-9) // 덕킹 없이 음성과 합침
+	assert.InDelta(t, bed, out.Samples[2400], 1e-9)
+}
+
+func TestMixBed_Ducking(t *testing.T) {
+	speech, err := Concat(Silence(1000, 1, time.Second), constant(0.5, time.Second), Silence(1000, 1, 2*time.Second))
+	assert.NoError(t, err)
+	out, err := MixBed(speech, constant(0.1, 10*time.Second), BedOptions{DuckingDB: -20})
+	assert.NoError(t, err)
+
+	assert.InDelta(t, 0.1, out.Samples[500], 1e-9)       // 음성과 먼 구간은 그대로
+	assert.InDelta(t, 0.5+0.01, out.Samples[1500], 1e-9) // 음성 구간은 -20dB
+	assert.Less(t, out.Samples[990], 0.1*0.5)            // 음성 직전에 미리 줄어듦
+	assert.Less(t, out.Samples[2200], 0.1*0.5)           // 음성이 끝난 뒤 잠시 유지
+	assert.InDelta(t, 0.1, out.Samples[3500], 1e-9)      // 이후 원래 음량으로 복귀
+}
+
+func TestMixBed_Fades(t *testing.T) {
+	out, err := MixBed(Silence(1000, 1, time.Second), constant(1, 10*time.Second), BedOptions{
+		FadeIn:  200 * time.Millisecond,
+		FadeOut: 200 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, out.Samples[0], 1e-9)
+	assert.InDelta(t, 0.5, out.Samples[100], 1e-9)
+	assert.InDelta(t, 1, out.Samples[500], 1e-9)
+	assert.InDelta(t, 0.5, out.Samples[899], 1e-9)
+	assert.InDelta(t, 0, out.Samples[999], 1e-9)
+}
+
+func TestMixBed_Loop(t *testing.T) {
+	// 주기가 정수 프레임이 아닌 사인파를 반복하면 이음매가 크로스페이드되어 튀지 않아야 함
+	bed := sine(8000, 1, 437, -6, 0, 300*time.Millisecond)
+	speech := Silence(8000, 1, 2*time.Second)
+
+	looped, err := MixBed(speech, bed, BedOptions{Loop: true})
+	assert.NoError(t, err)
+	assert.Equal(t, 16000, looped.Frames())
+	maxStep := 2 * math.Pi * 437 / 8000 * math.Pow(10, -6.0/20)
+	for i := 1; i < looped.Frames(); i++ {
+		if d := math.Abs(looped.Samples[i] - looped.Samples[i-1]); d > maxStep*1.5 {
+			t.Fatalf("discontinuity at frame %d: %f", i, d)
+		}
+	}
+	assert.Greater(t, samplePeakDB(looped.Samples[12000:]), -7.0)
+
+	once, err := MixBed(speech, bed, BedOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 16000, once.Frames())
+	assert.Greater(t, samplePeakDB(once.Samples[:2400]), -7.0)
+	assert.Equal(t, math.Inf(-1), samplePeakDB(once.Samples[2400:]))
+}
+
+func TestMixBed_ConvertsBed(t *testing.T) {
+	bed := sine(48000, 2, 440, -12, 0, time.Second)
+	out, err := MixBed(Silence(24000, 1, 500*time.Millisecond), bed, BedOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, 24000, out.SampleRate)
+	assert.Equal(t, 1, out.Channels)
+	assert.Equal(t, 12000, out.Frames())
+	assert.InDelta(t, -12, samplePeakDB(out.Samples[1000:11000]), 0.1)
+}
@@ -0,0 +1,61 @@
+package audio
+
+// bitWriter는 MSB부터 비트를 채워 쓰는 버퍼입니다. (FLAC 비트스트림)
+type bitWriter struct {
+	buf   []byte
+	cur   uint64 // 아직 바이트로 내보내지 않은 비트
+	nbits uint
+}
+
+// write는 v의 하위 n비트(n <= 32)를 씁니다.
+func (w *bitWriter) write(v uint64, n uint) {
+	if n == 0 {
+		return
+	}
+	w.cur = w.cur<<n | v&(1<<n-1)
+	w.nbits += n
+	for w.nbits >= 8 {
+		w.nbits -= 8
+		w.buf = append(w.buf, byte(w.cur>>w.nbits))
+	}
+	w.cur &= 1<<w.nbits - 1
+}
+
+// writeSigned는 2의 보수로 n비트를 씁니다.
+func (w *bitWriter) writeSigned(v int64, n uint) {
+	w.write(uint64(v), n)
+}
+
+// writeUnary는 q개의 0과 1을 씁니다.
+func (w *bitWriter) writeUnary(q uint64) {
+	for ; q >= 32; q -= 32 {
+		w.write(0, 32)
+	}
+	w.write(1, uint(q)+1)
+}
+
+// align은 남은 비트를 0으로 채워 바이트 경계를 맞춥니다.
+func (w *bitWriter) align() {
+	if w.nbits > 0 {
+		w.write(0, 8-w.nbits)
+	}
+}
+
+// len은 지금까지 쓴 비트 수를 반환합니다.
+func (w *bitWriter) len() int {
+	return len(w.buf)*8 + int(w.nbits)
+}
+
+// append는 다른 bitWriter의 내용을 비트 단위로 이어 씁니다.
+func (w *bitWriter) append(o *bitWriter) {
+	for _, b := range o.buf {
+		w.write(uint64(b), 8)
+	}
+	w.write(o.cur, o.nbits)
+}
+
+// bytes는 바이트 경계에 맞춘 내용을 반환합니다.
+func (w *bitWriter) bytes() []byte {
+	w.align()
+	return w.buf
+}
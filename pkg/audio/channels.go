@@ -0,0 +1,44 @@
+package audio
+
+// RemixChannels는 채널 수를 바꿉니다. 모노로 줄일 때는 모든 채널을 평균하고, 늘릴 때는 기존 채널을 차례로 복제합니다.
+// (모노 → 스테레오는 양쪽에 같은 신호) 채널 수가 같으면 p를 그대로 반환합니다.
+func RemixChannels(p *PCM, channels int) *PCM {
+	if channels == p.Channels || channels <= 0 || p.Channels <= 0 {
+		return p
+	}
+
+	frames := p.Frames()
+	out := &PCM{SampleRate: p.SampleRate, Channels: channels, Samples: make([]float64, frames*channels)}
+	for f := 0; f < frames; f++ {
+		in := p.Samples[f*p.Channels : (f+1)*p.Channels]
+		if channels == 1 {
+			var sum float64
+			for _, s := range in {
+				sum += s
+			}
+			out.Samples[f] = sum / float64(p.Channels)
+			continue
+		}
+		for ch := 0; ch < channels; ch++ {
+			out.Samples[f*channels+ch] = in[ch%p.Channels]
+		}
+	}
+	return out
+}
+
+// Convert는 p를 rate 표본화율, channels 채널로 바꿉니다. 채널을 줄일 때는 리샘플링 전에 바꿔 계산량을 줄입니다.
+// 이미 같은 형식이면 p를 그대로 반환합니다.
+func Convert(p *PCM, rate, channels int) *PCM {
+	if channels < p.Channels {
+		p = RemixChannels(p, channels)
+	}
+	return RemixChannels(Resample(p, rate), channels)
+}
+
+// ConvertAll은 clips를 첫 클립의 표본화율과 채널 수로 바꿔 제자리에서 교체합니다.
+// 업스트림이 음성이나 언어마다 다른 형식을 돌려줄 수 있으므로 Concat이나 Mix 전에 호출합니다.
+func ConvertAll(clips []*PCM) {
+	for i := 1; i < len(clips); i++ {
+		clips[i] = Convert(clips[i], clips[0].SampleRate, clips[0].Channels)
+	}
+}
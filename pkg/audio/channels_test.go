@@ -0,0 +1,50 @@
+package audio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemixChannels(t *testing.T) {
+	mono := &PCM{SampleRate: 8000, Channels: 1, Samples: []float64{0.1, -0.2, 0.3}}
+	stereo := RemixChannels(mono, 2)
+	assert.Equal(t, []float64{0.1, 0.1, -0.2, -0.2, 0.3, 0.3}, stereo.Samples)
+	assert.Equal(t, 2, stereo.Channels)
+	assert.Equal(t, mono.Duration(), stereo.Duration())
+
+	down := RemixChannels(&PCM{SampleRate: 8000, Channels: 2, Samples: []float64{0.2, 0.4, -0.5, 0.5}}, 1)
+	assert.InDeltaSlice(t, []float64{0.3, 0}, down.Samples, 1e-12)
+
+	assert.Same(t, mono, RemixChannels(mono, 1))
+}
+
+func TestConvert(t *testing.T) {
+	stereo := Silence(48000, 2, 100*time.Millisecond)
+	mono := Convert(stereo, 24000, 1)
+	assert.Equal(t, 24000, mono.SampleRate)
+	assert.Equal(t, 1, mono.Channels)
+	assert.Equal(t, 2400, mono.Frames())
+
+	up := Convert(mono, 44100, 2)
+	assert.Equal(t, 44100, up.SampleRate)
+	assert.Equal(t, 2, up.Channels)
+	assert.Equal(t, 4410, up.Frames())
+
+	assert.Same(t, mono, Convert(mono, 24000, 1))
+}
+
+func TestConvertAll(t *testing.T) {
+	first := Silence(24000, 1, 100*time.Millisecond)
+	clips := []*PCM{first, Silence(44100, 2, 200*time.Millisecond), first}
+	ConvertAll(clips)
+	for _, c := range clips {
+		assert.Equal(t, 24000, c.SampleRate)
+		assert.Equal(t, 1, c.Channels)
+	}
+	assert.Equal(t, 4800, clips[1].Frames())
+	assert.Same(t, first, clips[2])
+
+	ConvertAll(nil)
+}
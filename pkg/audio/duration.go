@@ -0,0 +1,127 @@
+package audio
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// ErrUnknownDuration은 헤더에서 오디오 길이를 알아낼 수 없을 때 반환됩니다.
+var ErrUnknownDuration = errors.New("audio duration is unknown")
+
+// WAVDuration은 샘플을 디코딩하지 않고 fmt, data 청크 헤더만으로 WAV 길이를 계산합니다.
+func WAVDuration(data []byte) (time.Duration, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return 0, ErrNotWAV
+	}
+	var sampleRate, blockAlign, dataSize int
+	for pos := 12; pos+8 <= len(data); {
+		id := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		body := data[pos+8:]
+		if size > len(body) || (id == "data" && size == 0) {
+			size = len(body)
+		}
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return 0, errors.New("wav: fmt chunk too short")
+			}
+			sampleRate = int(binary.LittleEndian.Uint32(body[4:8]))
+			blockAlign = int(binary.LittleEndian.Uint16(body[12:14]))
+		case "data":
+			dataSize = size
+		}
+		pos += 8 + size + size%2
+	}
+	if sampleRate == 0 || blockAlign == 0 {
+		return 0, errors.New("wav: missing or invalid fmt chunk")
+	}
+	return framesDuration(dataSize/blockAlign, sampleRate), nil
+}
+
+// FLACDuration은 STREAMINFO 블록의 전체 샘플 수로 FLAC 길이를 계산합니다.
+func FLACDuration(data []byte) (time.Duration, error) {
+	// "fLaC" + 메타데이터 블록 헤더(4) + STREAMINFO(34)
+	if len(data) < 42 || string(data[0:4]) != "fLaC" || data[4]&0x7F != 0 {
+		return 0, errors.New("flac: missing STREAMINFO")
+	}
+	info := data[8+10:] // 최소/최대 블록·프레임 크기 다음
+	sampleRate := int(info[0])<<12 | int(info[1])<<4 | int(info[2])>>4
+	frames := int(info[3]&0x0F)<<32 | int(binary.BigEndian.Uint32(info[4:8]))
+	if sampleRate == 0 || frames == 0 {
+		return 0, ErrUnknownDuration
+	}
+	return framesDuration(frames, sampleRate), nil
+}
+
+var (
+	mp3BitratesV1 = [16]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mp3BitratesV2 = [16]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+	mp3Rates      = [4][3]int{
+		{11025, 12000, 8000},  // MPEG 2.5
+		{},                    // 예약
+		{22050, 24000, 16000}, // MPEG 2
+		{44100, 48000, 32000}, // MPEG 1
+	}
+)
+
+// MP3Duration은 MPEG Layer III 프레임 헤더를 따라가며 MP3 길이를 계산합니다. 앞의 ID3v2 태그는 건너뛰고,
+// 프레임 동기를 잃으면 다음 동기 바이트를 찾습니다. 가변 비트레이트도 프레임마다 계산하므로 정확합니다.
+func MP3Duration(data []byte) (time.Duration, error) {
+	pos := 0
+	if len(data) >= 10 && string(data[0:3]) == "ID3" {
+		size := int(data[6]&0x7F)<<21 | int(data[7]&0x7F)<<14 | int(data[8]&0x7F)<<7 | int(data[9]&0x7F)
+		pos = 10 + size
+		if data[5]&0x10 != 0 { // 꼬리말
+			pos += 10
+		}
+	}
+
+	var seconds float64
+	frames := 0
+	for pos+4 <= len(data) {
+		length, samples, rate := mp3Frame(data[pos : pos+4])
+		if length == 0 || pos+length > len(data) {
+			pos++
+			continue
+		}
+		seconds += float64(samples) / float64(rate)
+		frames++
+		pos += length
+	}
+	if frames == 0 {
+		return 0, ErrUnknownDuration
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// mp3Frame은 Layer III 프레임 헤더를 읽어 프레임 길이(바이트), 프레임당 샘플 수, 샘플레이트를 반환합니다.
+// 올바른 헤더가 아니면 길이 0을 반환합니다.
+func mp3Frame(h []byte) (length, samples, rate int) {
+	if h[0] != 0xFF || h[1]&0xE0 != 0xE0 {
+		return 0, 0, 0
+	}
+	version := int(h[1]>>3) & 3
+	layer := int(h[1]>>1) & 3
+	bitrateIndex := int(h[2] >> 4)
+	rateIndex := int(h[2]>>2) & 3
+	padding := int(h[2]>>1) & 1
+	if version == 1 || layer != 1 || rateIndex == 3 {
+		return 0, 0, 0
+	}
+
+	rate = mp3Rates[version][rateIndex]
+	bitrate, coefficient, samples := mp3BitratesV1[bitrateIndex], 144, 1152
+	if version != 3 {
+		bitrate, coefficient, samples = mp3BitratesV2[bitrateIndex], 72, 576
+	}
+	if bitrate == 0 {
+		return 0, 0, 0
+	}
+	return coefficient*bitrate*1000/rate + padding, samples, rate
+}
+
+func framesDuration(frames, sampleRate int) time.Duration {
+	return time.Duration(float64(frames) / float64(sampleRate) * float64(time.Second))
+}
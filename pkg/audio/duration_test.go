@@ -0,0 +1,58 @@
+package audio
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWAVDuration(t *testing.T) {
+	wav := EncodeWAV(Silence(24000, 2, 1500*time.Millisecond))
+	d, err := WAVDuration(wav)
+	assert.NoError(t, err)
+	assert.Equal(t, 1500*time.Millisecond, d)
+
+	_, err = WAVDuration([]byte("not audio"))
+	assert.ErrorIs(t, err, ErrNotWAV)
+}
+
+func TestFLACDuration(t *testing.T) {
+	d, err := FLACDuration(EncodeFLAC(Silence(44100, 1, 2*time.Second)))
+	assert.NoError(t, err)
+	assert.Equal(t, 2*time.Second, d)
+
+	_, err = FLACDuration([]byte("fLaC"))
+	assert.Error(t, err)
+}
+
+// mp3Frames는 헤더 뒤를 0으로 채운 Layer III 프레임 n개를 만듭니다.
+func mp3Frames(header []byte, length, n int) []byte {
+	frame := make([]byte, length)
+	copy(frame, header)
+	return bytes.Repeat(frame, n)
+}
+
+func TestMP3Duration(t *testing.T) {
+	// MPEG-1 128kbps 44.1kHz: 417바이트, 1152샘플
+	cbr := mp3Frames([]byte{0xFF, 0xFB, 0x90, 0x00}, 417, 100)
+	d, err := MP3Duration(cbr)
+	assert.NoError(t, err)
+	assert.InDelta(t, framesDuration(115200, 44100), d, float64(time.Microsecond))
+
+	// ID3v2 태그 뒤의 MPEG-2 64kbps 24kHz: 192바이트, 576샘플(24ms)
+	id3 := []byte{'I', 'D', '3', 4, 0, 0, 0, 0, 0, 5, 1, 2, 3, 4, 5}
+	mpeg2 := append(id3, mp3Frames([]byte{0xFF, 0xF3, 0x84, 0x00}, 192, 50)...)
+	d, err = MP3Duration(mpeg2)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1200*time.Millisecond, d, float64(time.Microsecond))
+
+	// 동기를 잃으면 다음 프레임을 찾음
+	d, err = MP3Duration(append(append(cbr[:417:417], 0x00, 0x12), cbr[:417]...))
+	assert.NoError(t, err)
+	assert.InDelta(t, framesDuration(2304, 44100), d, float64(time.Microsecond))
+
+	_, err = MP3Duration([]byte("not audio"))
+	assert.ErrorIs(t, err, ErrUnknownDuration)
+}
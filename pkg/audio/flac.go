@@ -0,0 +1,326 @@
+package audio
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+const (
+	flacBlockSize         = 4096
+	flacBitsPerSample     = 16
+	flacMaxFixedOrder     = 4
+	flacMaxPartitionOrder = 8
+	flacMaxRiceParam      = 14 // 4비트 파라미터 중 15는 이스케이프 코드
+)
+
+// FLAC 채널 배치 (프레임 헤더)
+const (
+	flacLeftSide    = 8
+	flacMidSide     = 10
+	flacIndependent = 0 // + 채널 수 - 1
+)
+
+// EncodeFLAC은 PCM을 16비트 FLAC으로 무손실 압축합니다. 블록마다 고정 예측기(0~4차) 중 가장 작은 것을 고르고,
+// 잔차는 분할 Rice 부호로 씁니다. 스테레오는 독립/left-side/mid-side 중 작은 배치를 사용합니다.
+func EncodeFLAC(p *PCM) []byte {
+	frames := p.Frames()
+	channels := make([][]int64, p.Channels)
+	for ch := range channels {
+		channels[ch] = make([]int64, frames)
+		for i := 0; i < frames; i++ {
+			channels[ch][i] = int64(ToInt16(p.Samples[i*p.Channels+ch]))
+		}
+	}
+
+	out := []byte("fLaC")
+	out = append(out, flacStreamInfo(p, channels)...)
+	for start, n := 0, uint64(0); start < frames; start, n = start+flacBlockSize, n+1 {
+		end := min(start+flacBlockSize, frames)
+		block := make([][]int64, len(channels))
+		for ch := range channels {
+			block[ch] = channels[ch][start:end]
+		}
+		out = append(out, flacFrame(block, n)...)
+	}
+	return out
+}
+
+// flacStreamInfo는 마지막 메타데이터 블록인 STREAMINFO를 만듭니다.
+func flacStreamInfo(p *PCM, channels [][]int64) []byte {
+	var w bitWriter
+	w.write(1, 1) // 마지막 메타데이터 블록
+	w.write(0, 7) // STREAMINFO
+	w.write(34, 24)
+	w.write(flacBlockSize, 16)
+	w.write(flacBlockSize, 16)
+	w.write(0, 24) // 최소/최대 프레임 크기 (알 수 없음)
+	w.write(0, 24)
+	w.write(uint64(p.SampleRate), 20)
+	w.write(uint64(p.Channels-1), 3)
+	w.write(flacBitsPerSample-1, 5)
+	w.write(uint64(p.Frames())>>32, 4)
+	w.write(uint64(p.Frames())&0xFFFFFFFF, 32)
+
+	// 서명: 인터리브된 리틀 엔디언 16비트 샘플의 MD5
+	h := md5.New()
+	sample := make([]byte, 2)
+	for i := 0; i < p.Frames(); i++ {
+		for ch := range channels {
+			binary.LittleEndian.PutUint16(sample, uint16(channels[ch][i]))
+			h.Write(sample)
+		}
+	}
+	return append(w.bytes(), h.Sum(nil)...)
+}
+
+func flacFrame(block [][]int64, number uint64) []byte {
+	assignment := flacIndependent + len(block) - 1
+	subframes := make([]*bitWriter, len(block))
+	for ch, samples := range block {
+		subframes[ch] = flacSubframe(samples, flacBitsPerSample)
+	}
+
+	// 스테레오는 채널 간 상관을 이용하는 배치가 더 작으면 사용
+	if len(block) == 2 {
+		left, right := block[0], block[1]
+		mid, side := make([]int64, len(left)), make([]int64, len(left))
+		for i := range left {
+			mid[i] = (left[i] + right[i]) >> 1
+			side[i] = left[i] - right[i]
+		}
+		sideFrame := flacSubframe(side, flacBitsPerSample+1) // side 채널은 1비트 더 필요
+		best := subframes[0].len() + subframes[1].len()
+		if n := subframes[0].len() + sideFrame.len(); n < best {
+			best, assignment = n, flacLeftSide
+			subframes = []*bitWriter{subframes[0], sideFrame}
+		}
+		if midFrame := flacSubframe(mid, flacBitsPerSample); midFrame.len()+sideFrame.len() < best {
+			assignment = flacMidSide
+			subframes = []*bitWriter{midFrame, sideFrame}
+		}
+	}
+
+	var w bitWriter
+	w.write(0x3FFE, 14) // 동기 코드
+	w.write(0, 1)
+	w.write(0, 1) // 고정 블록 크기
+	w.write(7, 4) // 블록 크기는 헤더 끝 16비트
+	w.write(0, 4) // 표본화율은 STREAMINFO 참고
+	w.write(uint64(assignment), 4)
+	w.write(4, 3) // 16비트
+	w.write(0, 1)
+	for _, b := range flacUTF8(number) {
+		w.write(uint64(b), 8)
+	}
+	w.write(uint64(len(block[0])-1), 16)
+	w.write(uint64(crc8(w.buf)), 8)
+
+	for _, sf := range subframes {
+		w.append(sf)
+	}
+	frame := w.bytes()
+	crc := crc16(frame)
+	return append(frame, byte(crc>>8), byte(crc))
+}
+
+// flacSubframe은 한 채널의 블록을 CONSTANT, FIXED, VERBATIM 중 가장 작은 서브프레임으로 인코딩합니다.
+func flacSubframe(samples []int64, bps uint) *bitWriter {
+	constant := true
+	for _, s := range samples[1:] {
+		if s != samples[0] {
+			constant = false
+			break
+		}
+	}
+
+	w := &bitWriter{}
+	if constant {
+		w.write(0, 8) // 패딩 0, CONSTANT, wasted bits 없음
+		w.writeSigned(samples[0], bps)
+		return w
+	}
+
+	order, residual, cost := bestFixedPredictor(samples)
+	if cost >= uint64(len(samples))*uint64(bps) {
+		w.write(0x01<<1, 8) // VERBATIM
+		for _, s := range samples {
+			w.writeSigned(s, bps)
+		}
+		return w
+	}
+	w.write(uint64(0x08|order)<<1, 8) // FIXED (001xxx)
+	for _, s := range samples[:order] {
+		w.writeSigned(s, bps)
+	}
+	writeResidual(w, residual, len(samples), order)
+	return w
+}
+
+// bestFixedPredictor는 잔차 절댓값 합이 가장 작은 고정 예측기 차수와 잔차, 예상 비트 수를 반환합니다.
+func bestFixedPredictor(samples []int64) (int, []int64, uint64) {
+	maxOrder := min(flacMaxFixedOrder, len(samples)-1)
+	bestOrder, bestSum := 0, uint64(math.MaxUint64)
+	for order := 0; order <= maxOrder; order++ {
+		var sum uint64
+		for i := order; i < len(samples); i++ {
+			r := fixedResidual(samples, i, order)
+			if r < 0 {
+				r = -r
+			}
+			sum += uint64(r)
+		}
+		if sum < bestSum {
+			bestOrder, bestSum = order, sum
+		}
+	}
+
+	residual := make([]int64, len(samples)-bestOrder)
+	for i := range residual {
+		residual[i] = fixedResidual(samples, i+bestOrder, bestOrder)
+	}
+	_, cost := riceCost(residual, len(samples), bestOrder)
+	return bestOrder, residual, cost + uint64(bestOrder)*flacBitsPerSample
+}
+
+func fixedResidual(x []int64, i, order int) int64 {
+	switch order {
+	case 0:
+		return x[i]
+	case 1:
+		return x[i] - x[i-1]
+	case 2:
+		return x[i] - 2*x[i-1] + x[i-2]
+	case 3:
+		return x[i] - 3*x[i-1] + 3*x[i-2] - x[i-3]
+	default:
+		return x[i] - 4*x[i-1] + 6*x[i-2] - 4*x[i-3] + x[i-4]
+	}
+}
+
+// riceCost는 비트 수가 가장 작은 분할 차수와 그 비트 수를 반환합니다.
+func riceCost(residual []int64, blockSize, order int) (int, uint64) {
+	bestOrder, bestCost := 0, uint64(math.MaxUint64)
+	for po := 0; po <= flacMaxPartitionOrder; po++ {
+		if blockSize%(1<<po) != 0 || blockSize>>po <= order {
+			break
+		}
+		cost := uint64(6) // 부호화 방식 + 분할 차수
+		for _, part := range partitions(residual, blockSize, order, po) {
+			_, bitsUsed := riceParam(part)
+			cost += 4 + bitsUsed
+		}
+		if cost < bestCost {
+			bestOrder, bestCost = po, cost
+		}
+	}
+	return bestOrder, bestCost
+}
+
+// partitions는 잔차를 2^po개 분할로 나눕니다. 첫 분할은 예측기 차수만큼 짧습니다.
+func partitions(residual []int64, blockSize, order, po int) [][]int64 {
+	size := blockSize >> po
+	parts := make([][]int64, 0, 1<<po)
+	start := 0
+	for i := 0; i < 1<<po; i++ {
+		n := size
+		if i == 0 {
+			n -= order
+		}
+		parts = append(parts, residual[start:start+n])
+		start += n
+	}
+	return parts
+}
+
+// riceParam은 분할에 가장 작은 Rice 파라미터와 그 비트 수를 반환합니다.
+func riceParam(part []int64) (uint, uint64) {
+	var sum uint64
+	for _, r := range part {
+		sum += zigzag(r)
+	}
+	// 평균 크기에서 출발해 주변 값을 정확히 계산
+	guess := uint(0)
+	if n := uint64(len(part)); n > 0 && sum > n {
+		guess = uint(bits.Len64(sum/n)) - 1
+	}
+	bestK, bestBits := uint(0), uint64(math.MaxUint64)
+	for k := max(int(guess)-1, 0); k <= min(int(guess)+1, flacMaxRiceParam); k++ {
+		bitsUsed := uint64(len(part)) * uint64(k+1)
+		for _, r := range part {
+			bitsUsed += zigzag(r) >> uint(k)
+		}
+		if bitsUsed < bestBits {
+			bestK, bestBits = uint(k), bitsUsed
+		}
+	}
+	return bestK, bestBits
+}
+
+func writeResidual(w *bitWriter, residual []int64, blockSize, order int) {
+	po, _ := riceCost(residual, blockSize, order)
+	w.write(0, 2) // 4비트 Rice 파라미터
+	w.write(uint64(po), 4)
+	for _, part := range partitions(residual, blockSize, order, po) {
+		k, _ := riceParam(part)
+		w.write(uint64(k), 4)
+		for _, r := range part {
+			u := zigzag(r)
+			w.writeUnary(u >> k)
+			w.write(u, k)
+		}
+	}
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
+
+// flacUTF8은 프레임 번호를 UTF-8과 같은 방식의 가변 길이로 부호화합니다.
+func flacUTF8(v uint64) []byte {
+	if v < 0x80 {
+		return []byte{byte(v)}
+	}
+	n := 2
+	for v >= 1<<(5*n+1) {
+		n++
+	}
+	out := make([]byte, n)
+	for i := n - 1; i > 0; i-- {
+		out[i] = 0x80 | byte(v&0x3F)
+		v >>= 6
+	}
+	out[0] = byte(0xFF<<(8-n)) | byte(v)
+	return out
+}
+
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x8005
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
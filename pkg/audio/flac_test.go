@@ -0,0 +1,209 @@
+package audio
+
+import (
+	"crypto/md5"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bitReader와 decodeFLAC은 EncodeFLAC이 만드는 형식(고정 예측기, Rice 부호)만 해석하는 검증용 디코더입니다.
+type bitReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *bitReader) read(n int) uint64 {
+	var v uint64
+	for i := 0; i < n; i++ {
+		bit := r.data[r.pos/8] >> (7 - r.pos%8) & 1
+		v = v<<1 | uint64(bit)
+		r.pos++
+	}
+	return v
+}
+
+func (r *bitReader) readSigned(n int) int64 {
+	v := r.read(n)
+	if v&(1<<(n-1)) != 0 {
+		return int64(v) - 1<<n
+	}
+	return int64(v)
+}
+
+func (r *bitReader) align() { r.pos = (r.pos + 7) / 8 * 8 }
+
+type flacStream struct {
+	sampleRate, channels, bps int
+	total                     uint64
+	md5                       []byte
+	samples                   [][]int64
+}
+
+func decodeFLAC(t *testing.T, data []byte) *flacStream {
+	require.Equal(t, "fLaC", string(data[:4]))
+	r := &bitReader{data: data, pos: 32}
+	assert.Equal(t, uint64(1), r.read(1), "last metadata block")
+	assert.Equal(t, uint64(0), r.read(7), "STREAMINFO")
+	assert.Equal(t, uint64(34), r.read(24))
+	r.read(16 + 16 + 24 + 24)
+	s := &flacStream{sampleRate: int(r.read(20)), channels: int(r.read(3)) + 1, bps: int(r.read(5)) + 1}
+	s.total = r.read(36)
+	s.md5 = data[r.pos/8 : r.pos/8+16]
+	r.pos += 128
+	s.samples = make([][]int64, s.channels)
+
+	for frame := uint64(0); uint64(len(s.samples[0])) < s.total; frame++ {
+		start := r.pos / 8
+		require.Equal(t, uint64(0x3FFE), r.read(14), "sync")
+		r.read(2)
+		require.Equal(t, uint64(7), r.read(4))
+		r.read(4)
+		assignment := int(r.read(4))
+		require.Equal(t, uint64(4), r.read(3))
+		r.read(1)
+		first := r.read(8)
+		number := first
+		if n := leadingOnes(byte(first)); n > 1 {
+			number = first & (0xFF >> (n + 1))
+			for i := 1; i < n; i++ {
+				number = number<<6 | r.read(8)&0x3F
+			}
+		}
+		assert.Equal(t, frame, number)
+		size := int(r.read(16)) + 1
+		assert.Equal(t, crc8(data[start:r.pos/8]), byte(r.read(8)), "header CRC")
+
+		block := make([][]int64, s.channels)
+		for ch := range block {
+			bps := s.bps
+			if (assignment == flacLeftSide && ch == 1) || (assignment == flacMidSide && ch == 1) {
+				bps++
+			}
+			block[ch] = decodeSubframe(t, r, bps, size)
+		}
+		switch assignment {
+		case flacLeftSide:
+			for i := range block[0] {
+				block[1][i] = block[0][i] - block[1][i]
+			}
+		case flacMidSide:
+			for i := range block[0] {
+				mid, side := block[0][i]<<1|block[1][i]&1, block[1][i]
+				block[0][i], block[1][i] = (mid+side)>>1, (mid-side)>>1
+			}
+		}
+		r.align()
+		assert.Equal(t, crc16(data[start:r.pos/8]), uint16(r.read(16)), "frame CRC")
+		for ch := range block {
+			s.samples[ch] = append(s.samples[ch], block[ch]...)
+		}
+	}
+	assert.Equal(t, len(data)*8, r.pos)
+	return s
+}
+
+func leadingOnes(b byte) int {
+	n := 0
+	for ; b&0x80 != 0; b <<= 1 {
+		n++
+	}
+	return n
+}
+
+func decodeSubframe(t *testing.T, r *bitReader, bps, size int) []int64 {
+	require.Equal(t, uint64(0), r.read(1))
+	kind := int(r.read(6))
+	require.Equal(t, uint64(0), r.read(1), "wasted bits")
+	out := make([]int64, size)
+	switch {
+	case kind == 0:
+		v := r.readSigned(bps)
+		for i := range out {
+			out[i] = v
+		}
+	case kind == 1:
+		for i := range out {
+			out[i] = r.readSigned(bps)
+		}
+	case kind >= 8 && kind <= 12:
+		order := kind - 8
+		for i := 0; i < order; i++ {
+			out[i] = r.readSigned(bps)
+		}
+		require.Equal(t, uint64(0), r.read(2))
+		po := int(r.read(4))
+		i := order
+		for part := 0; part < 1<<po; part++ {
+			k := int(r.read(4))
+			require.NotEqual(t, 15, k)
+			n := size >> po
+			if part == 0 {
+				n -= order
+			}
+			for j := 0; j < n; j++ {
+				q := uint64(0)
+				for r.read(1) == 0 {
+					q++
+				}
+				u := q<<k | r.read(k)
+				res := int64(u>>1) ^ -int64(u&1)
+				out[i] = res + (out[i] - fixedResidual(out, i, order)) // 예측값 = x - residual(x=0)
+				i++
+			}
+		}
+	default:
+		t.Fatalf("unexpected subframe type %d", kind)
+	}
+	return out
+}
+
+func toInt16Channels(p *PCM) [][]int64 {
+	out := make([][]int64, p.Channels)
+	for i, s := range p.Samples {
+		out[i%p.Channels] = append(out[i%p.Channels], int64(ToInt16(s)))
+	}
+	return out
+}
+
+func TestEncodeFLAC_RoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	noise := Silence(44100, 2, 300*time.Millisecond)
+	for i := range noise.Samples {
+		noise.Samples[i] = rng.Float64()*2 - 1
+	}
+	for name, p := range map[string]*PCM{
+		"mono sweep":     sweep(24000, 50, 10000, 700*time.Millisecond),
+		"stereo sine":    sine(48000, 2, 440, -3, 0, 500*time.Millisecond),
+		"stereo noise":   noise,
+		"silence":        Silence(16000, 1, 100*time.Millisecond),
+		"single sample":  {SampleRate: 8000, Channels: 1, Samples: []float64{0.25}},
+		"clipped stereo": {SampleRate: 8000, Channels: 2, Samples: []float64{1, -1, -1, 1, 1, -1}},
+	} {
+		data := EncodeFLAC(p)
+		s := decodeFLAC(t, data)
+		assert.Equal(t, p.SampleRate, s.sampleRate, name)
+		assert.Equal(t, p.Channels, s.channels, name)
+		assert.Equal(t, 16, s.bps, name)
+		assert.Equal(t, uint64(p.Frames()), s.total, name)
+		assert.Equal(t, toInt16Channels(p), s.samples, name)
+
+		sum := md5.Sum(EncodePCM16LE(p))
+		assert.Equal(t, sum[:], s.md5, name)
+	}
+}
+
+func TestEncodeFLAC_Compresses(t *testing.T) {
+	p := sine(48000, 2, 440, -6, 0, 2*time.Second)
+	flac := EncodeFLAC(p)
+	assert.Less(t, len(flac), len(EncodePCM16LE(p))/3)
+}
+
+func TestFLACUTF8(t *testing.T) {
+	assert.Equal(t, []byte{0x7F}, flacUTF8(0x7F))
+	assert.Equal(t, []byte{0xC2, 0x80}, flacUTF8(0x80))
+	assert.Equal(t, []byte{0xE0, 0xA0, 0x80}, flacUTF8(0x800))
+}
@@ -0,0 +1,103 @@
+package audio
+
+import "math/bits"
+
+// G.711 μ-law 상수
+const (
+	muLawBias = 0x84
+	muLawClip = 32635
+)
+
+// EncodeMuLaw는 PCM을 G.711 μ-law 바이트열(샘플당 1바이트, 인터리브)로 인코딩합니다.
+// 전화망 규격은 8kHz 모노이므로 필요하면 먼저 Resample, RemixChannels로 변환하세요.
+func EncodeMuLaw(p *PCM) []byte {
+	out := make([]byte, len(p.Samples))
+	for i, s := range p.Samples {
+		out[i] = LinearToMuLaw(ToInt16(s))
+	}
+	return out
+}
+
+// EncodeALaw는 PCM을 G.711 A-law 바이트열(샘플당 1바이트, 인터리브)로 인코딩합니다.
+func EncodeALaw(p *PCM) []byte {
+	out := make([]byte, len(p.Samples))
+	for i, s := range p.Samples {
+		out[i] = LinearToALaw(ToInt16(s))
+	}
+	return out
+}
+
+// LinearToMuLaw는 16비트 선형 샘플 하나를 μ-law로 압축합니다.
+func LinearToMuLaw(sample int16) byte {
+	v := int(sample)
+	var sign byte
+	if v < 0 {
+		sign = 0x80
+		v = -v
+	}
+	v = min(v, muLawClip) + muLawBias
+
+	exponent := bits.Len(uint(v>>7)) - 1
+	mantissa := (v >> (exponent + 3)) & 0x0F
+	return ^(sign | byte(exponent<<4) | byte(mantissa))
+}
+
+// MuLawToLinear는 μ-law 바이트를 16비트 선형 샘플로 복원합니다.
+func MuLawToLinear(u byte) int16 {
+	u = ^u
+	exponent := int(u>>4) & 0x07
+	v := ((int(u&0x0F) << 3) + muLawBias) << exponent
+	v -= muLawBias
+	if u&0x80 != 0 {
+		return int16(-v)
+	}
+	return int16(v)
+}
+
+// aLawSegmentEnds는 13비트 크기 값의 구간 경계입니다.
+var aLawSegmentEnds = [8]int{0x1F, 0x3F, 0x7F, 0xFF, 0x1FF, 0x3FF, 0x7FF, 0xFFF}
+
+// LinearToALaw는 16비트 선형 샘플 하나를 A-law로 압축합니다.
+func LinearToALaw(sample int16) byte {
+	v := int(sample) >> 3
+	mask := byte(0xD5)
+	if v < 0 {
+		mask = 0x55
+		v = -v - 1
+	}
+
+	segment := 0
+	for segment < len(aLawSegmentEnds) && v > aLawSegmentEnds[segment] {
+		segment++
+	}
+	if segment >= len(aLawSegmentEnds) {
+		return 0x7F ^ mask
+	}
+
+	a := byte(segment << 4)
+	if segment < 2 {
+		a |= byte(v>>1) & 0x0F
+	} else {
+		a |= byte(v>>segment) & 0x0F
+	}
+	return a ^ mask
+}
+
+// ALawToLinear는 A-law 바이트를 16비트 선형 샘플로 복원합니다.
+func ALawToLinear(a byte) int16 {
+	a ^= 0x55
+	v := int(a&0x0F) << 4
+	switch segment := int(a&0x70) >> 4; segment {
+	case 0:
+		v += 8
+	case 1:
+		v += 0x108
+	default:
+		v += 0x108
+		v <<= segment - 1
+	}
+	if a&0x80 != 0 {
+		return int16(v)
+	}
+	return int16(-v)
+}
@@ -0,0 +1,65 @@
+package audio
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuLaw(t *testing.T) {
+	// ITU-T G.711 기준 값
+	assert.Equal(t, byte(0xFF), LinearToMuLaw(0))
+	assert.Equal(t, byte(0x80), LinearToMuLaw(math.MaxInt16))
+	assert.Equal(t, byte(0x00), LinearToMuLaw(math.MinInt16))
+	assert.Equal(t, int16(0), MuLawToLinear(0xFF))
+	assert.Equal(t, int16(32124), MuLawToLinear(0x80))
+	assert.Equal(t, int16(-32124), MuLawToLinear(0x00))
+
+	// 모든 코드는 복원 후 다시 압축하면 같은 코드 (0x7F는 -0이라 0xFF로 정규화)
+	for code := 0; code < 256; code++ {
+		want := byte(code)
+		if code == 0x7F {
+			want = 0xFF
+		}
+		assert.Equal(t, want, LinearToMuLaw(MuLawToLinear(byte(code))), "code %#x", code)
+	}
+}
+
+func TestALaw(t *testing.T) {
+	assert.Equal(t, byte(0xD5), LinearToALaw(0))
+	assert.Equal(t, byte(0xAA), LinearToALaw(math.MaxInt16))
+	assert.Equal(t, byte(0x2A), LinearToALaw(math.MinInt16))
+	assert.Equal(t, int16(8), ALawToLinear(0xD5))
+	assert.Equal(t, int16(32256), ALawToLinear(0xAA))
+	assert.Equal(t, int16(-32256), ALawToLinear(0x2A))
+
+	for code := 0; code < 256; code++ {
+		assert.Equal(t, byte(code), LinearToALaw(ALawToLinear(byte(code))), "code %#x", code)
+	}
+}
+
+func TestEncodeG711_SNR(t *testing.T) {
+	p := sine(8000, 1, 1000, -6, 0.3, time.Second)
+	for name, tc := range map[string]struct {
+		encode func(*PCM) []byte
+		decode func(byte) int16
+	}{
+		"mulaw": {EncodeMuLaw, MuLawToLinear},
+		"alaw":  {EncodeALaw, ALawToLinear},
+	} {
+		encoded := tc.encode(p)
+		assert.Len(t, encoded, len(p.Samples), name)
+
+		var signal, noise float64
+		for i, b := range encoded {
+			want := p.Samples[i]
+			got := float64(tc.decode(b)) / 32768
+			signal += want * want
+			noise += (got - want) * (got - want)
+		}
+		// 8비트 로그 압축의 양자화 SNR은 약 38dB
+		assert.Greater(t, 10*math.Log10(signal/noise), 35.0, name)
+	}
+}
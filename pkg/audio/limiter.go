@@ -0,0 +1,76 @@
+package audio
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	limiterLookahead = 5 * time.Millisecond  // 이득을 미리 줄이기 시작하는 시간
+	limiterRelease   = 80 * time.Millisecond // 이득이 회복되는 시정수
+)
+
+// LimitTruePeak는 트루 피크가 ceilingDB(dBTP)를 넘지 않도록 룩어헤드 리미터로 이득을 줄입니다.
+// 넘는 구간이 없으면 오디오를 바꾸지 않습니다.
+func LimitTruePeak(p *PCM, ceilingDB float64) {
+	ceiling := math.Pow(10, ceilingDB/20)
+	env := truePeakEnvelope(p)
+
+	required := make([]float64, len(env))
+	over := false
+	for i, v := range env {
+		required[i] = 1
+		if v > ceiling {
+			required[i] = ceiling / v
+			over = true
+		}
+	}
+	if !over {
+		return
+	}
+
+	// 보간 필터 폭과 룩어헤드만큼 앞뒤를 포함한 최솟값을 같은 길이로 이동 평균하면,
+	// 이득이 매끄럽게 변하면서도 각 프레임에서 필요한 이득 이하로 유지됩니다.
+	lookahead := max(1, int(limiterLookahead.Seconds()*float64(p.SampleRate)))
+	floor := movingMin(required, oversampleHalfWidth, lookahead-1+oversampleHalfWidth)
+	release := 1 - math.Exp(-1/(limiterRelease.Seconds()*float64(p.SampleRate)))
+
+	var sum float64
+	gain := 1.0
+	for i := range floor {
+		sum += floor[i]
+		if i >= lookahead {
+			sum -= floor[i-lookahead]
+		}
+		target := sum / float64(min(i+1, lookahead))
+		gain = min(target, gain+(1-gain)*release)
+		for ch := 0; ch < p.Channels; ch++ {
+			p.Samples[i*p.Channels+ch] *= gain
+		}
+	}
+
+	// 이득 변화로 생긴 미세한 초과분은 전체 이득으로 보정
+	if peak := TruePeak(p); peak > ceilingDB {
+		Gain(p, ceilingDB-peak)
+	}
+}
+
+// movingMin은 out[i] = min(values[i-before : i+after+1])을 단조 덱으로 계산합니다.
+func movingMin(values []float64, before, after int) []float64 {
+	out := make([]float64, len(values))
+	var deque []int // values가 증가하는 순서의 인덱스
+	next := 0
+	for i := range values {
+		for ; next < len(values) && next <= i+after; next++ {
+			for len(deque) > 0 && values[deque[len(deque)-1]] >= values[next] {
+				deque = deque[:len(deque)-1]
+			}
+			deque = append(deque, next)
+		}
+		for deque[0] < i-before {
+			deque = deque[1:]
+		}
+		out[i] = values[deque[0]]
+	}
+	return out
+}
@@ -0,0 +1,45 @@
+package audio
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitTruePeak(t *testing.T) {
+	quiet := sine(48000, 2, 1000, -20, 0, time.Second)
+	p, err := Concat(quiet, sine(48000, 2, 1000, 0, 0, time.Second), sine(48000, 2, 12000, 0, math.Pi/4, time.Second))
+	assert.NoError(t, err)
+
+	LimitTruePeak(p, -1)
+	assert.LessOrEqual(t, TruePeak(p), -1+1e-9)
+
+	// 리미터가 동작하기 전의 조용한 구간은 그대로
+	assert.InDelta(t, -20, samplePeakDB(p.Samples[:2*40000]), 0.05)
+
+	// 큰 구간은 천장 근처까지만 줄어듦
+	assert.InDelta(t, -1, samplePeakDB(p.Samples[2*58000:2*94000]), 0.3)
+}
+
+func samplePeakDB(samples []float64) float64 {
+	var peak float64
+	for _, s := range samples {
+		peak = max(peak, math.Abs(s))
+	}
+	return 20 * math.Log10(peak)
+}
+
+func TestLimitTruePeak_NoOp(t *testing.T) {
+	p := sine(48000, 1, 1000, -6, 0, time.Second)
+	want := append([]float64(nil), p.Samples...)
+	LimitTruePeak(p, -1)
+	assert.Equal(t, want, p.Samples)
+}
+
+func TestMovingMin(t *testing.T) {
+	values := []float64{5, 3, 4, 1, 6, 7, 2}
+	assert.Equal(t, []float64{3, 1, 1, 1, 1, 2, 2}, movingMin(values, 1, 2))
+	assert.Equal(t, values, movingMin(values, 0, 0))
+}
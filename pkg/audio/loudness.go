@@ -0,0 +1,223 @@
+package audio
+
+import (
+	"math"
+)
+
+// ITU-R BS.1770 / EBU R128 게이팅 상수
+const (
+	loudnessBlock        = 0.4 // 측정 블록 길이(초)
+	loudnessStep         = 0.1 // 블록 간격(초), 75% 겹침
+	loudnessAbsoluteGate = -70.0
+	loudnessRelativeGate = -10.0
+)
+
+// biquad는 2차 IIR 필터입니다. a0로 정규화된 계수를 사용합니다.
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+}
+
+func newBiquad(b0, b1, b2, a0, a1, a2 float64) biquad {
+	return biquad{b0 / a0, b1 / a0, b2 / a0, a1 / a0, a2 / a0}
+}
+
+// apply는 한 채널(stride 간격의 샘플)을 필터링한 결과를 반환합니다.
+func (f biquad) apply(samples []float64, offset, stride int) []float64 {
+	out := make([]float64, 0, len(samples)/stride+1)
+	var x1, x2, y1, y2 float64
+	for i := offset; i < len(samples); i += stride {
+		x := samples[i]
+		y := f.b0*x + f.b1*x1 + f.b2*x2 - f.a1*y1 - f.a2*y2
+		x2, x1 = x1, x
+		y2, y1 = y1, y
+		out = append(out, y)
+	}
+	return out
+}
+
+// kWeighting은 표본화 주파수에 맞춘 K-가중 필터(고역 셸빙 + 고역 통과)를 반환합니다.
+// BS.1770의 48kHz 계수와 같은 응답을 내도록 아날로그 원형에서 쌍선형 변환으로 계산합니다.
+func kWeighting(sampleRate int) [2]biquad {
+	fs := float64(sampleRate)
+
+	// 1단: 1681Hz 부근 +4dB 고역 셸빙
+	const (
+		shelfGain = 3.99984385397
+		shelfQ    = 0.7071752369554193
+		shelfFreq = 1681.974450955533
+	)
+	k := math.Tan(math.Pi * shelfFreq / fs)
+	vh := math.Pow(10, shelfGain/20)
+	vb := math.Pow(vh, 0.4996667741545416)
+	a0 := 1 + k/shelfQ + k*k
+	shelf := newBiquad(
+		vh+vb*k/shelfQ+k*k,
+		2*(k*k-vh),
+		vh-vb*k/shelfQ+k*k,
+		a0,
+		2*(k*k-1),
+		1-k/shelfQ+k*k,
+	)
+
+	// 2단: 38Hz 고역 통과 (RLB)
+	const (
+		hpQ    = 0.5003270373238773
+		hpFreq = 38.13547087602444
+	)
+	k = math.Tan(math.Pi * hpFreq / fs)
+	// 분자 계수는 BS.1770 표와 같이 정규화하지 않습니다. (통과 대역 이득 1)
+	a0 = 1 + k/hpQ + k*k
+	highpass := biquad{1, -2, 1, 2 * (k*k - 1) / a0, (1 - k/hpQ + k*k) / a0}
+
+	return [2]biquad{shelf, highpass}
+}
+
+// IntegratedLoudness는 EBU R128(ITU-R BS.1770-4) 방식의 통합 라우드니스(LUFS)를 측정합니다.
+// 400ms 블록을 100ms 간격으로 나눠 -70 LUFS 절대 게이트와 -10 LU 상대 게이트를 적용합니다.
+// 400ms보다 짧은 오디오는 전체를 한 블록으로 측정하며, 게이트를 통과한 블록이 없으면 -Inf를 반환합니다.
+func IntegratedLoudness(p *PCM) float64 {
+	frames := p.Frames()
+	if frames == 0 || p.SampleRate == 0 {
+		return math.Inf(-1)
+	}
+
+	// 채널별로 K-가중 후 제곱값의 누적합을 구해 블록 평균을 빠르게 계산
+	filters := kWeighting(p.SampleRate)
+	cumulative := make([][]float64, p.Channels)
+	for ch := 0; ch < p.Channels; ch++ {
+		weighted := filters[1].apply(filters[0].apply(p.Samples, ch, p.Channels), 0, 1)
+		sum := make([]float64, len(weighted)+1)
+		for i, s := range weighted {
+			sum[i+1] = sum[i] + s*s
+		}
+		cumulative[ch] = sum
+	}
+
+	block := int(loudnessBlock * float64(p.SampleRate))
+	step := int(loudnessStep * float64(p.SampleRate))
+	if block > frames {
+		block = frames
+	}
+	var powers []float64
+	for start := 0; start+block <= frames; start += step {
+		var power float64
+		for _, sum := range cumulative {
+			power += (sum[start+block] - sum[start]) / float64(block)
+		}
+		powers = append(powers, power)
+	}
+
+	gated := gatePowers(powers, loudnessAbsoluteGate)
+	if len(gated) == 0 {
+		return math.Inf(-1)
+	}
+	relative := powerToLoudness(mean(gated)) + loudnessRelativeGate
+	gated = gatePowers(gated, relative)
+	if len(gated) == 0 {
+		return math.Inf(-1)
+	}
+	return powerToLoudness(mean(gated))
+}
+
+func powerToLoudness(power float64) float64 {
+	return -0.691 + 10*math.Log10(power)
+}
+
+func gatePowers(powers []float64, threshold float64) []float64 {
+	var out []float64
+	for _, p := range powers {
+		if p > 0 && powerToLoudness(p) > threshold {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func mean(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// Gain은 모든 샘플에 dB 단위 이득을 적용합니다. 클리핑은 하지 않습니다.
+func Gain(p *PCM, db float64) {
+	g := math.Pow(10, db/20)
+	for i := range p.Samples {
+		p.Samples[i] *= g
+	}
+}
+
+// truePeakOversample은 트루 피크 측정 시 오버샘플링 배수입니다. BS.1770은 48kHz에서 4배를 권장합니다.
+const truePeakOversample = 4
+
+// TruePeak는 4배 오버샘플링으로 추정한 트루 피크(dBTP)를 반환합니다. 무음이면 -Inf입니다.
+func TruePeak(p *PCM) float64 {
+	var peak float64
+	for _, v := range truePeakEnvelope(p) {
+		peak = max(peak, v)
+	}
+	return 20 * math.Log10(peak)
+}
+
+// truePeakEnvelope는 프레임마다 [i, i+1) 구간의 오버샘플링된 절댓값 최대치(모든 채널)를 반환합니다.
+func truePeakEnvelope(p *PCM) []float64 {
+	frames := p.Frames()
+	env := make([]float64, frames)
+	kernel := oversampleKernel(truePeakOversample)
+	for ch := 0; ch < p.Channels; ch++ {
+		for i := 0; i < frames; i++ {
+			v := math.Abs(p.Samples[i*p.Channels+ch])
+			for phase := 1; phase < truePeakOversample; phase++ {
+				v = max(v, math.Abs(interpolate(p, ch, i, kernel[phase])))
+			}
+			env[i] = max(env[i], v)
+		}
+	}
+	return env
+}
+
+// oversampleHalfWidth는 보간 필터의 한쪽 탭 수입니다.
+const oversampleHalfWidth = 12
+
+// oversampleKernel은 위상별 보간 탭을 반환합니다. kernel[phase][j]는 프레임 i-halfWidth+1+j의 가중치입니다.
+func oversampleKernel(factor int) [][]float64 {
+	kernel := make([][]float64, factor)
+	for phase := 1; phase < factor; phase++ {
+		frac := float64(phase) / float64(factor)
+		taps := make([]float64, 2*oversampleHalfWidth)
+		for j := range taps {
+			taps[j] = windowedSinc(frac-float64(j-oversampleHalfWidth+1), oversampleHalfWidth)
+		}
+		kernel[phase] = taps
+	}
+	return kernel
+}
+
+// interpolate는 프레임 i와 i+1 사이의 한 위상 값을 보간합니다. 범위 밖 샘플은 0으로 봅니다.
+func interpolate(p *PCM, ch, i int, taps []float64) float64 {
+	frames := p.Frames()
+	var v float64
+	for j, w := range taps {
+		n := i - oversampleHalfWidth + 1 + j
+		if n >= 0 && n < frames {
+			v += w * p.Samples[n*p.Channels+ch]
+		}
+	}
+	return v
+}
+
+// windowedSinc는 Blackman 창을 씌운 sinc 함수입니다. |x| >= halfWidth이면 0입니다.
+func windowedSinc(x float64, halfWidth int) float64 {
+	if x == 0 {
+		return 1
+	}
+	w := float64(halfWidth)
+	if math.Abs(x) >= w {
+		return 0
+	}
+	t := math.Pi * (x/w + 1) // 0..2π
+	window := 0.42 - 0.5*math.Cos(t) + 0.08*math.Cos(2*t)
+	return math.Sin(math.Pi*x) / (math.Pi * x) * window
+}
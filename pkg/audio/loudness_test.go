@@ -0,0 +1,57 @@
+package audio
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sine은 모든 채널에 같은 사인파를 채웁니다. amplitudeDB는 피크 기준 dBFS입니다.
+func sine(sampleRate, channels int, freq, amplitudeDB, phase float64, d time.Duration) *PCM {
+	p := Silence(sampleRate, channels, d)
+	a := math.Pow(10, amplitudeDB/20)
+	for f := 0; f < p.Frames(); f++ {
+		v := a * math.Sin(2*math.Pi*freq*float64(f)/float64(sampleRate)+phase)
+		for c := 0; c < channels; c++ {
+			p.Samples[f*channels+c] = v
+		}
+	}
+	return p
+}
+
+func TestIntegratedLoudness(t *testing.T) {
+	// BS.1770: 0dBFS 997Hz 사인파를 한 채널에 넣으면 -3.01 LKFS
+	assert.InDelta(t, -3.01, IntegratedLoudness(sine(48000, 1, 997, 0, 0, 5*time.Second)), 0.05)
+
+	// EBU Tech 3341: -23dBFS 1kHz 스테레오 사인파는 -23 LUFS
+	assert.InDelta(t, -23, IntegratedLoudness(sine(48000, 2, 1000, -23, 0, 5*time.Second)), 0.05)
+	assert.InDelta(t, -23, IntegratedLoudness(sine(44100, 2, 1000, -23, 0, 5*time.Second)), 0.05)
+
+	// 무음 구간은 절대 게이트로 제외됨 (경계에 걸친 블록 때문에 약간 낮게 측정)
+	p, err := Concat(sine(48000, 2, 1000, -23, 0, 3*time.Second), Silence(48000, 2, 5*time.Second))
+	assert.NoError(t, err)
+	assert.InDelta(t, -23.2, IntegratedLoudness(p), 0.1)
+
+	// 짧은 오디오도 측정
+	assert.InDelta(t, -23, IntegratedLoudness(sine(48000, 2, 1000, -23, 0, 200*time.Millisecond)), 0.2)
+
+	assert.True(t, math.IsInf(IntegratedLoudness(Silence(48000, 1, time.Second)), -1))
+}
+
+func TestTruePeak(t *testing.T) {
+	// fs/4 사인파를 45도 어긋나게 표본화하면 샘플 피크는 -3dB지만 트루 피크는 0dBTP
+	p := sine(48000, 1, 12000, 0, math.Pi/4, time.Second)
+	assert.InDelta(t, -3.01, samplePeakDB(p.Samples), 0.01)
+	assert.InDelta(t, 0, TruePeak(p), 0.3)
+
+	assert.InDelta(t, -6, TruePeak(sine(48000, 2, 1000, -6, 0, time.Second)), 0.05)
+	assert.True(t, math.IsInf(TruePeak(Silence(48000, 1, time.Second)), -1))
+}
+
+func TestGain(t *testing.T) {
+	p := sine(48000, 1, 1000, -20, 0, time.Second)
+	Gain(p, 6)
+	assert.InDelta(t, -14, TruePeak(p), 0.05)
+}
@@ -0,0 +1,58 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Track은 믹스에서 Offset 위치부터 재생되는 클립입니다.
+type Track struct {
+	Clip   *PCM
+	Offset time.Duration
+}
+
+// Mix는 트랙들을 각자의 위치에 겹쳐 하나의 클립으로 합칩니다. 겹치는 구간은 더한 뒤 [-1, 1]로 자릅니다.
+// 모든 클립의 샘플레이트와 채널 수가 같아야 합니다.
+func Mix(tracks ...Track) (*PCM, error) {
+	if len(tracks) == 0 {
+		return nil, errors.New("no tracks to mix")
+	}
+
+	first := tracks[0].Clip
+	frames := 0
+	starts := make([]int, len(tracks))
+	for i, t := range tracks {
+		if t.Clip.SampleRate != first.SampleRate || t.Clip.Channels != first.Channels {
+			return nil, fmt.Errorf("track %d format mismatch: %dHz/%dch, expected %dHz/%dch",
+				i, t.Clip.SampleRate, t.Clip.Channels, first.SampleRate, first.Channels)
+		}
+		if t.Offset < 0 {
+			return nil, fmt.Errorf("track %d has negative offset", i)
+		}
+		starts[i] = int(t.Offset.Seconds() * float64(first.SampleRate))
+		frames = max(frames, starts[i]+t.Clip.Frames())
+	}
+
+	out := &PCM{SampleRate: first.SampleRate, Channels: first.Channels, Samples: make([]float64, frames*first.Channels)}
+	for i, t := range tracks {
+		base := starts[i] * first.Channels
+		for j, s := range t.Clip.Samples {
+			out.Samples[base+j] += s
+		}
+	}
+	for i, s := range out.Samples {
+		out.Samples[i] = clamp(s)
+	}
+	return out, nil
+}
+
+func clamp(s float64) float64 {
+	if s > 1 {
+		return 1
+	}
+	if s < -1 {
+		return -1
+	}
+	return s
+}
@@ -0,0 +1,42 @@
+package audio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func constant(v float64, d time.Duration) *PCM {
+	p := Silence(1000, 1, d)
+	for i := range p.Samples {
+		p.Samples[i] = v
+	}
+	return p
+}
+
+func TestMix(t *testing.T) {
+	out, err := Mix(
+		Track{Clip: constant(0.5, 100*time.Millisecond)},
+		Track{Clip: constant(0.75, 100*time.Millisecond), Offset: 50 * time.Millisecond},
+		Track{Clip: constant(0.25, 10*time.Millisecond), Offset: 200 * time.Millisecond},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 210, out.Frames())
+	assert.Equal(t, 0.5, out.Samples[0])
+	assert.Equal(t, 1.0, out.Samples[60]) // 0.5 + 0.75는 1로 자름
+	assert.Equal(t, 0.75, out.Samples[120])
+	assert.Equal(t, 0.0, out.Samples[170])
+	assert.Equal(t, 0.25, out.Samples[205])
+}
+
+func TestMix_Errors(t *testing.T) {
+	_, err := Mix()
+	assert.Error(t, err)
+
+	_, err = Mix(Track{Clip: constant(0, time.Millisecond)}, Track{Clip: Silence(2000, 1, time.Millisecond)})
+	assert.Error(t, err)
+
+	_, err = Mix(Track{Clip: constant(0, time.Millisecond), Offset: -time.Millisecond})
+	assert.Error(t, err)
+}
@@ -0,0 +1,12 @@
+package audio
+
+import "encoding/binary"
+
+// EncodePCM16LE는 PCM을 헤더 없는 16비트 리틀 엔디언(s16le) 인터리브 샘플로 인코딩합니다.
+func EncodePCM16LE(p *PCM) []byte {
+	out := make([]byte, len(p.Samples)*2)
+	for i, s := range p.Samples {
+		binary.LittleEndian.PutUint16(out[i*2:], uint16(ToInt16(s)))
+	}
+	return out
+}
@@ -0,0 +1,17 @@
+package audio
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodePCM16LE(t *testing.T) {
+	data := EncodePCM16LE(&PCM{SampleRate: 8000, Channels: 1, Samples: []float64{0, 0.5, -1, 1.5}})
+	assert.Equal(t, []int16{0, 16384, -32768, math.MaxInt16}, []int16{
+		int16(binary.LittleEndian.Uint16(data[0:])), int16(binary.LittleEndian.Uint16(data[2:])),
+		int16(binary.LittleEndian.Uint16(data[4:])), int16(binary.LittleEndian.Uint16(data[6:])),
+	})
+}
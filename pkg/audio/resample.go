@@ -0,0 +1,107 @@
+package audio
+
+import "math"
+
+const (
+	resampleZeroCrossings = 24   // 필터 한쪽의 sinc 영점 수 (출력/입력 중 낮은 표본화율 기준)
+	resampleKaiserBeta    = 9.0  // 저지 대역 감쇠 약 -90dB
+	resampleRolloff       = 0.95 // 나이퀴스트 대비 차단 주파수
+	maxPolyphases         = 4096 // 위상 수가 이보다 많으면 탭을 미리 계산하지 않음
+)
+
+// Resample은 polyphase windowed-sinc(Kaiser 창) 필터로 표본화율을 바꿉니다. 낮은 표본화율로 바꿀 때는
+// 차단 주파수를 새 나이퀴스트 아래로 낮춰 에일리어싱을 막습니다. 표본화율이 같으면 p를 그대로 반환합니다.
+func Resample(p *PCM, rate int) *PCM {
+	if rate == p.SampleRate || rate <= 0 || p.SampleRate <= 0 {
+		return p
+	}
+
+	// 출력 n번째 샘플은 입력 시각 n*down/up에 해당하며, 위상은 (n*down) mod up입니다.
+	g := gcd(p.SampleRate, rate)
+	up, down := rate/g, p.SampleRate/g
+	cutoff := resampleRolloff * min(1, float64(up)/float64(down))
+	half := int(math.Ceil(resampleZeroCrossings / cutoff))
+
+	var phases [][]float64
+	if up <= maxPolyphases {
+		phases = make([][]float64, up)
+		for phase := range phases {
+			phases[phase] = resampleTaps(float64(phase)/float64(up), half, cutoff)
+		}
+	}
+
+	frames := p.Frames()
+	outFrames := int((int64(frames)*int64(up) + int64(down) - 1) / int64(down))
+	out := &PCM{SampleRate: rate, Channels: p.Channels, Samples: make([]float64, outFrames*p.Channels)}
+	for n := 0; n < outFrames; n++ {
+		pos := int64(n) * int64(down)
+		base, phase := int(pos/int64(up)), int(pos%int64(up))
+		var taps []float64
+		if phases != nil {
+			taps = phases[phase]
+		} else {
+			taps = resampleTaps(float64(phase)/float64(up), half, cutoff)
+		}
+
+		first := base - half + 1
+		for ch := 0; ch < p.Channels; ch++ {
+			var v float64
+			for j, w := range taps {
+				if i := first + j; i >= 0 && i < frames {
+					v += w * p.Samples[i*p.Channels+ch]
+				}
+			}
+			out.Samples[n*p.Channels+ch] = v
+		}
+	}
+	return out
+}
+
+// resampleTaps는 입력 프레임 base-half+1 … base+half에 곱할 가중치를 반환합니다. frac은 base에서 출력 시각까지의 거리입니다.
+// 직류 이득이 1이 되도록 정규화합니다.
+func resampleTaps(frac float64, half int, cutoff float64) []float64 {
+	taps := make([]float64, 2*half)
+	var sum float64
+	for j := range taps {
+		x := frac - float64(j-half+1) // 출력 시각과 입력 샘플 사이의 거리
+		w := cutoff * sinc(cutoff*x) * kaiser(x/float64(half), resampleKaiserBeta)
+		taps[j] = w
+		sum += w
+	}
+	for j := range taps {
+		taps[j] /= sum
+	}
+	return taps
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return math.Sin(math.Pi*x) / (math.Pi * x)
+}
+
+// kaiser는 [-1, 1] 범위에서 정의되는 Kaiser 창 함수입니다.
+func kaiser(x, beta float64) float64 {
+	if x <= -1 || x >= 1 {
+		return 0
+	}
+	return besselI0(beta*math.Sqrt(1-x*x)) / besselI0(beta)
+}
+
+// besselI0는 0차 제1종 변형 베셀 함수를 급수로 계산합니다.
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	for k := 1; term > 1e-12*sum; k++ {
+		term *= (x / (2 * float64(k))) * (x / (2 * float64(k)))
+		sum += term
+	}
+	return sum
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
@@ -0,0 +1,88 @@
+package audio
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// sweep는 f0에서 f1까지 지수적으로 변하는 0.5 진폭 사인 스윕을 만듭니다.
+func sweep(sampleRate int, f0, f1 float64, d time.Duration) *PCM {
+	p := Silence(sampleRate, 1, d)
+	T := d.Seconds()
+	k := math.Log(f1 / f0)
+	for i := range p.Samples {
+		t := float64(i) / float64(sampleRate)
+		p.Samples[i] = 0.5 * math.Sin(2*math.Pi*f0*T/k*(math.Exp(t/T*k)-1))
+	}
+	return p
+}
+
+// snrDB는 가장자리(필터 길이)를 제외한 구간에서 기준 대비 오차의 신호 대 잡음비를 계산합니다.
+func snrDB(got, want *PCM, edge int) float64 {
+	var signal, noise float64
+	for i := edge; i < min(len(got.Samples), len(want.Samples))-edge; i++ {
+		signal += want.Samples[i] * want.Samples[i]
+		d := got.Samples[i] - want.Samples[i]
+		noise += d * d
+	}
+	return 10 * math.Log10(signal/noise)
+}
+
+func TestResample_Sweep(t *testing.T) {
+	for _, tc := range []struct {
+		from, to int
+		f1       float64
+	}{
+		{24000, 48000, 10000}, // 정수배 업샘플링
+		{44100, 48000, 18000}, // 160/147 비율
+		{22050, 16000, 7000},  // 다운샘플링
+		{48000, 16000, 7000},
+	} {
+		in := sweep(tc.from, 50, tc.f1, time.Second)
+		out := Resample(in, tc.to)
+		want := sweep(tc.to, 50, tc.f1, time.Second)
+
+		assert.Equal(t, tc.to, out.SampleRate)
+		assert.Equal(t, want.Frames(), out.Frames())
+		assert.Greater(t, snrDB(out, want, tc.to/50), 60.0, "%d → %d", tc.from, tc.to)
+	}
+}
+
+func TestResample_AntiAliasing(t *testing.T) {
+	// 새 나이퀴스트(8kHz)보다 높은 12kHz 성분은 제거되어야 함
+	in := sine(48000, 1, 12000, 0, 0, time.Second)
+	out := Resample(in, 16000)
+
+	var power float64
+	edge := 1000
+	for _, s := range out.Samples[edge : len(out.Samples)-edge] {
+		power += s * s
+	}
+	rms := math.Sqrt(power / float64(len(out.Samples)-2*edge))
+	assert.Less(t, 20*math.Log10(rms), -70.0)
+}
+
+func TestResample_Stereo(t *testing.T) {
+	in := sine(22050, 2, 440, -6, 0, 500*time.Millisecond)
+	for f := 0; f < in.Frames(); f++ {
+		in.Samples[f*2+1] = 0 // 오른쪽은 무음
+	}
+	out := Resample(in, 48000)
+	assert.Equal(t, 2, out.Channels)
+
+	var left, right float64
+	for f := 0; f < out.Frames(); f++ {
+		left = max(left, math.Abs(out.Samples[f*2]))
+		right = max(right, math.Abs(out.Samples[f*2+1]))
+	}
+	assert.InDelta(t, -6, 20*math.Log10(left), 0.05)
+	assert.Zero(t, right)
+}
+
+func TestResample_SameRate(t *testing.T) {
+	in := sine(48000, 1, 440, -6, 0, 100*time.Millisecond)
+	assert.Same(t, in, Resample(in, 48000))
+}
@@ -0,0 +1,74 @@
+package audio
+
+import (
+	"math"
+	"time"
+)
+
+// analysisWindow는 에너지 분석에 사용하는 창 길이입니다.
+const analysisWindow = 10 * time.Millisecond
+
+// Interval은 오디오 안의 시간 구간입니다.
+type Interval struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Duration은 구간 길이를 반환합니다.
+func (i Interval) Duration() time.Duration {
+	return i.End - i.Start
+}
+
+// DetectSilence는 RMS 에너지가 thresholdDB(dBFS) 미만인 구간 중 minDuration 이상인 것을 시간 순으로 반환합니다.
+// 에너지는 10ms 창 단위로 모든 채널을 합쳐 계산합니다.
+func DetectSilence(p *PCM, thresholdDB float64, minDuration time.Duration) []Interval {
+	window := int(float64(p.SampleRate) * analysisWindow.Seconds())
+	frames := p.Frames()
+	if window <= 0 || frames == 0 {
+		return nil
+	}
+	threshold := math.Pow(10, thresholdDB/20)
+
+	var silences []Interval
+	silentFrom := -1
+	flush := func(end int) {
+		if silentFrom < 0 {
+			return
+		}
+		iv := Interval{Start: p.frameTime(silentFrom), End: p.frameTime(end)}
+		if iv.Duration() >= minDuration {
+			silences = append(silences, iv)
+		}
+		silentFrom = -1
+	}
+
+	for start := 0; start < frames; start += window {
+		end := min(start+window, frames)
+		if p.rms(start, end) < threshold {
+			if silentFrom < 0 {
+				silentFrom = start
+			}
+		} else {
+			flush(start)
+		}
+	}
+	flush(frames)
+	return silences
+}
+
+// rms는 [start, end) 프레임의 모든 채널 샘플 RMS를 반환합니다.
+func (p *PCM) rms(start, end int) float64 {
+	samples := p.Samples[start*p.Channels : end*p.Channels]
+	if len(samples) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, s := range samples {
+		sum += s * s
+	}
+	return math.Sqrt(sum / float64(len(samples)))
+}
+
+func (p *PCM) frameTime(frame int) time.Duration {
+	return time.Duration(float64(frame) / float64(p.SampleRate) * float64(time.Second))
+}
@@ -0,0 +1,49 @@
+package audio
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tone은 0.5 진폭의 440Hz 사인파를 만듭니다.
+func tone(sampleRate, channels int, d time.Duration) *PCM {
+	p := Silence(sampleRate, channels, d)
+	for f := 0; f < p.Frames(); f++ {
+		v := 0.5 * math.Sin(2*math.Pi*440*float64(f)/float64(sampleRate))
+		for c := 0; c < channels; c++ {
+			p.Samples[f*channels+c] = v
+		}
+	}
+	return p
+}
+
+func TestDetectSilence(t *testing.T) {
+	p, err := Concat(
+		Silence(8000, 2, 100*time.Millisecond),
+		tone(8000, 2, 300*time.Millisecond),
+		Silence(8000, 2, 50*time.Millisecond), // minDuration보다 짧음
+		tone(8000, 2, 200*time.Millisecond),
+		Silence(8000, 2, 250*time.Millisecond),
+		tone(8000, 2, 100*time.Millisecond),
+	)
+	assert.NoError(t, err)
+
+	silences := DetectSilence(p, -40, 100*time.Millisecond)
+	assert.Equal(t, []Interval{
+		{Start: 0, End: 100 * time.Millisecond},
+		{Start: 650 * time.Millisecond, End: 900 * time.Millisecond},
+	}, silences)
+	assert.Equal(t, 250*time.Millisecond, silences[1].Duration())
+}
+
+func TestDetectSilence_Trailing(t *testing.T) {
+	p, err := Concat(tone(8000, 1, 100*time.Millisecond), Silence(8000, 1, 105*time.Millisecond))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []Interval{{Start: 100 * time.Millisecond, End: 205 * time.Millisecond}}, DetectSilence(p, -40, 100*time.Millisecond))
+	assert.Nil(t, DetectSilence(tone(8000, 1, time.Second), -40, 100*time.Millisecond))
+	assert.Nil(t, DetectSilence(&PCM{SampleRate: 8000, Channels: 1}, -40, 0))
+}
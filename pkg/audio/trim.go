@@ -0,0 +1,42 @@
+package audio
+
+import (
+	"math"
+	"time"
+)
+
+// TrimSilence는 앞뒤에서 RMS 에너지가 thresholdDB(dBFS) 미만인 10ms 창을 잘라내고, 남은 소리 앞뒤로 padding만큼 여유를 둡니다.
+// 잘라낸 오디오와 원본에서 남긴 구간을 반환합니다. 전체가 무음이면 원본을 그대로 반환합니다.
+func TrimSilence(p *PCM, thresholdDB float64, padding time.Duration) (*PCM, Interval) {
+	frames := p.Frames()
+	whole := Interval{End: p.Duration()}
+	window := int(float64(p.SampleRate) * analysisWindow.Seconds())
+	if window <= 0 || frames == 0 {
+		return p, whole
+	}
+	threshold := math.Pow(10, thresholdDB/20)
+
+	first, last := -1, -1
+	for start := 0; start < frames; start += window {
+		end := min(start+window, frames)
+		if p.rms(start, end) >= threshold {
+			if first < 0 {
+				first = start
+			}
+			last = end
+		}
+	}
+	if first < 0 {
+		return p, whole
+	}
+
+	pad := int(padding.Seconds() * float64(p.SampleRate))
+	first = max(0, first-pad)
+	last = min(frames, last+pad)
+	trimmed := &PCM{
+		SampleRate: p.SampleRate,
+		Channels:   p.Channels,
+		Samples:    append([]float64(nil), p.Samples[first*p.Channels:last*p.Channels]...),
+	}
+	return trimmed, Interval{Start: p.frameTime(first), End: p.frameTime(last)}
+}
@@ -0,0 +1,33 @@
+package audio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrimSilence(t *testing.T) {
+	p, err := Concat(
+		Silence(8000, 2, 200*time.Millisecond),
+		tone(8000, 2, 300*time.Millisecond),
+		Silence(8000, 2, 500*time.Millisecond),
+	)
+	assert.NoError(t, err)
+
+	trimmed, kept := TrimSilence(p, -40, 50*time.Millisecond)
+	assert.Equal(t, Interval{Start: 150 * time.Millisecond, End: 550 * time.Millisecond}, kept)
+	assert.Equal(t, 400*time.Millisecond, trimmed.Duration())
+	assert.Equal(t, 2, trimmed.Channels)
+	assert.Equal(t, p.Samples[2*1200:2*4400], trimmed.Samples)
+
+	// 패딩이 오디오 범위를 넘지 않음
+	_, kept = TrimSilence(p, -40, time.Second)
+	assert.Equal(t, Interval{Start: 0, End: time.Second}, kept)
+
+	// 전체가 무음이면 그대로
+	silent := Silence(8000, 1, 300*time.Millisecond)
+	out, kept := TrimSilence(silent, -40, 0)
+	assert.Same(t, silent, out)
+	assert.Equal(t, Interval{End: 300 * time.Millisecond}, kept)
+}
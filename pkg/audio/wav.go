@@ -0,0 +1,215 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	wavFormatPCM        = 1
+	wavFormatFloat      = 3
+	wavFormatExtensible = 0xFFFE
+)
+
+// ErrNotWAV는 입력이 RIFF/WAVE 형식이 아닐 때 반환됩니다.
+var ErrNotWAV = errors.New("not a RIFF/WAVE file")
+
+// PCM은 디코딩된 오디오입니다. Samples는 채널이 인터리브된 [-1, 1] 범위의 값입니다.
+type PCM struct {
+	SampleRate int
+	Channels   int
+	Samples    []float64
+}
+
+// Frames는 채널당 샘플 수를 반환합니다.
+func (p *PCM) Frames() int {
+	if p.Channels == 0 {
+		return 0
+	}
+	return len(p.Samples) / p.Channels
+}
+
+// Duration은 오디오 길이를 반환합니다.
+func (p *PCM) Duration() time.Duration {
+	if p.SampleRate == 0 {
+		return 0
+	}
+	return time.Duration(float64(p.Frames()) / float64(p.SampleRate) * float64(time.Second))
+}
+
+// DecodeWAV는 8/16/24/32비트 정수 및 32/64비트 부동소수점 WAV를 디코딩합니다.
+func DecodeWAV(data []byte) (*PCM, error) {
+	if len(data) < 12 || string(data[0:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		return nil, ErrNotWAV
+	}
+
+	var (
+		format, channels, bits uint16
+		sampleRate             uint32
+		fmtFound               bool
+		pcmData                []byte
+		dataFound              bool
+	)
+
+	for pos := 12; pos+8 <= len(data); {
+		id := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4 : pos+8]))
+		body := data[pos+8:]
+		// 스트리밍 응답은 data 청크 크기가 0 또는 최대값으로 기록되기도 하므로 남은 길이로 보정
+		if size > len(body) || (id == "data" && size == 0) {
+			size = len(body)
+		}
+		body = body[:size]
+
+		switch id {
+		case "fmt ":
+			if size < 16 {
+				return nil, errors.New("wav: fmt chunk too short")
+			}
+			format = binary.LittleEndian.Uint16(body[0:2])
+			channels = binary.LittleEndian.Uint16(body[2:4])
+			sampleRate = binary.LittleEndian.Uint32(body[4:8])
+			bits = binary.LittleEndian.Uint16(body[14:16])
+			if format == wavFormatExtensible && size >= 26 {
+				format = binary.LittleEndian.Uint16(body[24:26])
+			}
+			fmtFound = true
+		case "data":
+			pcmData = body
+			dataFound = true
+		}
+
+		pos += 8 + size + size%2
+	}
+
+	if !fmtFound || !dataFound {
+		return nil, errors.New("wav: missing fmt or data chunk")
+	}
+	if channels == 0 || sampleRate == 0 {
+		return nil, errors.New("wav: invalid channel count or sample rate")
+	}
+
+	samples, err := decodeSamples(pcmData, format, bits)
+	if err != nil {
+		return nil, err
+	}
+	// 마지막 프레임이 잘린 경우 버림
+	samples = samples[:len(samples)-len(samples)%int(channels)]
+
+	return &PCM{SampleRate: int(sampleRate), Channels: int(channels), Samples: samples}, nil
+}
+
+func decodeSamples(data []byte, format, bits uint16) ([]float64, error) {
+	width := int(bits) / 8
+	if width == 0 {
+		return nil, fmt.Errorf("wav: unsupported bits per sample %d", bits)
+	}
+	n := len(data) / width
+	samples := make([]float64, n)
+
+	switch {
+	case format == wavFormatPCM && bits == 8:
+		for i := 0; i < n; i++ {
+			samples[i] = (float64(data[i]) - 128) / 128
+		}
+	case format == wavFormatPCM && bits == 16:
+		for i := 0; i < n; i++ {
+			samples[i] = float64(int16(binary.LittleEndian.Uint16(data[i*2:]))) / 32768
+		}
+	case format == wavFormatPCM && bits == 24:
+		for i := 0; i < n; i++ {
+			b := data[i*3:]
+			v := int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24) >> 8
+			samples[i] = float64(v) / 8388608
+		}
+	case format == wavFormatPCM && bits == 32:
+		for i := 0; i < n; i++ {
+			samples[i] = float64(int32(binary.LittleEndian.Uint32(data[i*4:]))) / 2147483648
+		}
+	case format == wavFormatFloat && bits == 32:
+		for i := 0; i < n; i++ {
+			samples[i] = float64(math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:])))
+		}
+	case format == wavFormatFloat && bits == 64:
+		for i := 0; i < n; i++ {
+			samples[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+		}
+	default:
+		return nil, fmt.Errorf("wav: unsupported format %d with %d bits", format, bits)
+	}
+	return samples, nil
+}
+
+// EncodeWAV는 PCM을 16비트 정수 WAV로 인코딩합니다.
+func EncodeWAV(p *PCM) []byte {
+	dataSize := len(p.Samples) * 2
+	blockAlign := p.Channels * 2
+
+	buf := bytes.NewBuffer(make([]byte, 0, 44+dataSize))
+	buf.WriteString("RIFF")
+	binary.Write(buf, binary.LittleEndian, uint32(36+dataSize))
+	buf.WriteString("WAVE")
+
+	buf.WriteString("fmt ")
+	binary.Write(buf, binary.LittleEndian, uint32(16))
+	binary.Write(buf, binary.LittleEndian, uint16(wavFormatPCM))
+	binary.Write(buf, binary.LittleEndian, uint16(p.Channels))
+	binary.Write(buf, binary.LittleEndian, uint32(p.SampleRate))
+	binary.Write(buf, binary.LittleEndian, uint32(p.SampleRate*blockAlign))
+	binary.Write(buf, binary.LittleEndian, uint16(blockAlign))
+	binary.Write(buf, binary.LittleEndian, uint16(16))
+
+	buf.WriteString("data")
+	binary.Write(buf, binary.LittleEndian, uint32(dataSize))
+	buf.Write(EncodePCM16LE(p))
+
+	return buf.Bytes()
+}
+
+// ToInt16은 [-1, 1] 범위의 샘플을 클리핑하여 16비트 정수로 변환합니다.
+func ToInt16(s float64) int16 {
+	v := math.Round(s * 32768)
+	if v > math.MaxInt16 {
+		return math.MaxInt16
+	}
+	if v < math.MinInt16 {
+		return math.MinInt16
+	}
+	return int16(v)
+}
+
+// Silence는 주어진 길이의 무음 PCM을 생성합니다.
+func Silence(sampleRate, channels int, d time.Duration) *PCM {
+	frames := int(d.Seconds() * float64(sampleRate))
+	if frames < 0 {
+		frames = 0
+	}
+	return &PCM{SampleRate: sampleRate, Channels: channels, Samples: make([]float64, frames*channels)}
+}
+
+// Concat은 같은 샘플레이트와 채널 수를 가진 PCM들을 이어 붙입니다.
+func Concat(clips ...*PCM) (*PCM, error) {
+	if len(clips) == 0 {
+		return nil, errors.New("audio: nothing to concatenate")
+	}
+
+	first := clips[0]
+	total := 0
+	for _, c := range clips {
+		if c.SampleRate != first.SampleRate || c.Channels != first.Channels {
+			return nil, fmt.Errorf("audio: format mismatch (%d Hz/%d ch vs %d Hz/%d ch)",
+				c.SampleRate, c.Channels, first.SampleRate, first.Channels)
+		}
+		total += len(c.Samples)
+	}
+
+	samples := make([]float64, 0, total)
+	for _, c := range clips {
+		samples = append(samples, c.Samples...)
+	}
+	return &PCM{SampleRate: first.SampleRate, Channels: first.Channels, Samples: samples}, nil
+}
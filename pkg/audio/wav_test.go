@@ -0,0 +1,80 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rawWAV는 지정한 형식의 WAV 헤더와 데이터를 직접 구성합니다.
+func rawWAV(format, channels uint16, sampleRate uint32, bits uint16, data []byte) []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteString("RIFF")
+	binary.Write(buf, binary.LittleEndian, uint32(36+len(data)))
+	buf.WriteString("WAVE")
+	buf.WriteString("fmt ")
+	binary.Write(buf, binary.LittleEndian, uint32(16))
+	binary.Write(buf, binary.LittleEndian, format)
+	binary.Write(buf, binary.LittleEndian, channels)
+	binary.Write(buf, binary.LittleEndian, sampleRate)
+	binary.Write(buf, binary.LittleEndian, sampleRate*uint32(channels)*uint32(bits/8))
+	binary.Write(buf, binary.LittleEndian, channels*bits/8)
+	binary.Write(buf, binary.LittleEndian, bits)
+	buf.WriteString("data")
+	binary.Write(buf, binary.LittleEndian, uint32(len(data)))
+	buf.Write(data)
+	return buf.Bytes()
+}
+
+func TestWAV_RoundTrip(t *testing.T) {
+	pcm := &PCM{SampleRate: 24000, Channels: 2, Samples: []float64{0, 0.5, -0.5, 1, -1, 0.25}}
+
+	decoded, err := DecodeWAV(EncodeWAV(pcm))
+	assert.NoError(t, err)
+	assert.Equal(t, 24000, decoded.SampleRate)
+	assert.Equal(t, 2, decoded.Channels)
+	assert.Equal(t, 3, decoded.Frames())
+	for i, s := range pcm.Samples {
+		assert.InDelta(t, s, decoded.Samples[i], 1.0/32768)
+	}
+}
+
+func TestDecodeWAV_24BitAndFloat(t *testing.T) {
+	// 24비트: 0x400000 = 0.5, 0xC00000 = -0.5
+	decoded, err := DecodeWAV(rawWAV(wavFormatPCM, 1, 44100, 24, []byte{0x00, 0x00, 0x40, 0x00, 0x00, 0xC0}))
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0.5, -0.5}, decoded.Samples, 1e-9)
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint32(data[0:], math.Float32bits(0.25))
+	binary.LittleEndian.PutUint32(data[4:], math.Float32bits(-0.75))
+	decoded, err = DecodeWAV(rawWAV(wavFormatFloat, 1, 48000, 32, data))
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float64{0.25, -0.75}, decoded.Samples, 1e-9)
+}
+
+func TestDecodeWAV_Invalid(t *testing.T) {
+	_, err := DecodeWAV([]byte("MP3DATA"))
+	assert.ErrorIs(t, err, ErrNotWAV)
+
+	_, err = DecodeWAV(rawWAV(wavFormatPCM, 1, 8000, 12, []byte{0, 0}))
+	assert.Error(t, err)
+}
+
+func TestSilenceAndConcat(t *testing.T) {
+	a := &PCM{SampleRate: 8000, Channels: 1, Samples: []float64{0.1, 0.2}}
+	gap := Silence(8000, 1, 250*time.Millisecond)
+	assert.Equal(t, 2000, gap.Frames())
+
+	joined, err := Concat(a, gap, a)
+	assert.NoError(t, err)
+	assert.Equal(t, 2004, joined.Frames())
+	assert.Equal(t, 0.2, joined.Samples[2003])
+
+	_, err = Concat(a, &PCM{SampleRate: 16000, Channels: 1})
+	assert.Error(t, err)
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"path/filepath"
 )
 
 type Config struct {
@@ -10,22 +11,98 @@ type Config struct {
 	Port      string
 	TTSEndpoint string
 	APIVersion  string
+	VoiceCacheTTL int // 초 단위
+	PresetsFile   string // 비어 있으면 메모리에만 저장
+	LexiconsFile  string // 사용자 발음 사전 저장 파일, 비어 있으면 메모리에만 저장
+	LexiconDir    string // 전역 발음 사전(*.pls, *.json) 디렉토리
+	DialogueConcurrency int // 대화 렌더링 시 동시에 합성할 최대 줄 수
+	AudiobookDir     string // 오디오북 작업 상태와 결과 파일 디렉토리
+	AudiobookWorkers int    // 동시에 실행할 최대 오디오북 작업 수
+	BackgroundDir string // 관리자가 업로드한 배경 음악 트랙 디렉토리
+	AdminAPIKey   string // X-Admin-Key 헤더로 확인할 관리자 키, 비어 있으면 관리자 API 비활성화
+	MaxBodyBytes  int    // 요청 본문 최대 크기 (배경 트랙 업로드 포함)
+	WatermarkKey string // 워터마크 PN 시퀀스와 사용자 지문의 비밀 키, 비어 있으면 워터마크 비활성화
+	WatermarkAll bool   // true면 요청과 관계없이 모든 WAV 계열 출력에 워터마크 삽입
+	LogLevel       string // debug, info, warn, error
+	LogFormat      string // json 또는 text
+	LogText        bool   // true면 합성 텍스트 등 사용자 콘텐츠를 로그에 그대로 기록
+	LogSampleBurst int    // 같은 디버그 로그를 1초에 기록할 최대 개수, 0이면 표본 추출 안 함
+	LogSampleEvery int    // 최대 개수를 넘은 디버그 로그는 N개마다 하나만 기록
+	TraceExporter     string  // none(기본값), stdout, otlp
+	TraceOTLPEndpoint string  // OTLP/HTTP 수집기 주소
+	TraceServiceName  string  // 스팬의 service.name
+	TraceSampleRatio  float64 // 새로 시작하는 추적 중 기록할 비율 (0~1)
+	CircuitFailureThreshold int    // 업스트림이 연속으로 이만큼 실패하면 회로를 엶, 0이면 회로 차단기 비활성화
+	CircuitCooldown         int    // 회로가 열린 뒤 시험 요청을 보내기까지 기다릴 시간 (초)
+	HealthProbeTimeoutMS    int    // /readyz의 업스트림 연결 확인 제한 시간 (밀리초)
+	HealthCanaryVoice       string // /health/deep에서 카나리 합성에 쓸 Voice ID, 비어 있으면 카나리 생략
+	HealthCanaryText        string // 카나리 합성 텍스트
+	HealthCanaryTTL         int    // 카나리 합성 결과를 캐시할 시간 (초)
+	ShutdownDrainDelay  int    // 종료 신호 후 /readyz를 실패시킨 채 요청을 계속 받는 시간 (초)
+	ShutdownTimeout     int    // 처리 중인 요청과 백그라운드 작업을 기다릴 최대 시간 (초)
+	MetricsSnapshotFile string // 비어 있지 않으면 종료 시 지표를 이 파일에 기록
+	AuditLogDir      string // 합성 감사 기록(JSONL)을 저장할 디렉토리
+	AuditLogText     bool   // true면 감사 기록에 원문 텍스트도 남김 (기본은 해시만)
+	AuditLogMaxBytes int    // 감사 로그 파일 하나의 최대 크기 (바이트), 넘으면 새 파일
+	UsageFile        string // 사용량 집계를 저장할 JSON 파일
+	UsageRetentionDays int  // 사용량 시간 버킷을 보관할 기간 (일), 0이면 모두 보관
+	SecretsFile      string // 제공자 API 키와 URL을 담은 보안 파일 (JSON)
+	VoiceAliasesFile string // 음성 별칭 설정 파일 (JSON)
+	ModelPricingFile string // 모델별 가격표 파일 (JSON)
+	ReloadInterval   int    // 설정·보안·별칭 파일이 바뀌었는지 확인할 간격 (초), 0이면 SIGHUP으로만 다시 읽음
 }
 
-func LoadConfig() *Config {
+// DefaultConfig는 설정 파일과 환경 변수가 없을 때의 기본 설정을 반환합니다.
+func DefaultConfig() *Config {
 	return &Config{
-		TTSAPIURL: os.Getenv("TTS_API_URL"),
-		TTSAPIKey: os.Getenv("TTS_API_KEY"),
-		Port:      getEnvOrDefault("PORT", "8080"),
-		TTSEndpoint: getEnvOrDefault("TTS_ENDPOINT", "/tts"),
-		APIVersion:  getEnvOrDefault("API_VERSION", "v1"),
+		Port:      "8080",
+		TTSEndpoint: "/tts",
+		APIVersion:  "v1",
+		VoiceCacheTTL: 600,
+		LexiconDir:    "config/lexicons",
+		DialogueConcurrency: 4,
+		AudiobookDir:     "data/audiobooks",
+		AudiobookWorkers: 1,
+		BackgroundDir: "data/backgrounds",
+		MaxBodyBytes:  32<<20,
+		LogLevel:       "info",
+		LogFormat:      "json",
+		LogSampleBurst: 100,
+		LogSampleEvery: 100,
+		TraceExporter:     "none",
+		TraceOTLPEndpoint: "http://localhost:4318",
+		TraceServiceName:  "tts_proxy",
+		TraceSampleRatio:  1,
+		CircuitFailureThreshold: 5,
+		CircuitCooldown:         30,
+		HealthProbeTimeoutMS:    2000,
+		HealthCanaryText:        "Health check.",
+		HealthCanaryTTL:         60,
+		ShutdownDrainDelay:  5,
+		ShutdownTimeout:     30,
+		AuditLogDir:      "data/audit",
+		AuditLogMaxBytes: 64<<20,
+		UsageFile:        "data/usage.json",
+		UsageRetentionDays: 400,
+		SecretsFile:      filepath.Join("config", "secrets", "api_keys.json"),
+		VoiceAliasesFile: filepath.Join("config", "voice_aliases.json"),
+		ModelPricingFile: filepath.Join("config", "model_pricing.json"),
+		ReloadInterval:   5,
 	}
 }
 
+// LoadConfig는 기본 설정에 환경 변수를 적용합니다. 형식이 잘못된 환경 변수는 무시하고 기본값을 사용합니다.
+// 설정 파일과 검증이 필요하면 Load를 사용하세요.
+func LoadConfig() *Config {
+	cfg := DefaultConfig()
+	applyEnv(settings(cfg, &TTSAPIConfig{}))
+	return cfg
+}
+
 func getEnvOrDefault(key, def string) string {
 	v := os.Getenv(key)
 	if v == "" {
 		return def
 	}
 	return v
-} 
\ No newline at end of file
+}
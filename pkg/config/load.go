@@ -0,0 +1,321 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// setting은 설정 항목 하나의 YAML 경로, 환경 변수 이름, 값을 담을 필드입니다.
+type setting struct {
+	path string // 예: server.port, 비어 있으면 환경 변수로만 설정
+	env  string
+	ptr  any // *string, *int, *bool, *float64, *TTSProvider
+}
+
+// settings는 설정 파일과 환경 변수로 바꿀 수 있는 모든 항목을 반환합니다.
+// YAML 읽기, 환경 변수 적용, 검증 오류 메시지가 모두 이 목록을 사용합니다.
+func settings(c *Config, t *TTSAPIConfig) []setting {
+	return []setting{
+		{"server.port", "PORT", &c.Port},
+		{"server.shutdown_drain_delay", "SHUTDOWN_DRAIN_DELAY", &c.ShutdownDrainDelay},
+		{"server.shutdown_timeout", "SHUTDOWN_TIMEOUT", &c.ShutdownTimeout},
+		{"server.metrics_snapshot_file", "METRICS_SNAPSHOT_FILE", &c.MetricsSnapshotFile},
+		{"server.reload_interval", "CONFIG_RELOAD_INTERVAL", &c.ReloadInterval},
+
+		{"routing.api_version", "API_VERSION", &c.APIVersion},
+		{"routing.tts_endpoint", "TTS_ENDPOINT", &c.TTSEndpoint},
+
+		{"providers.default", "TTS_PROVIDER", &t.Provider},
+		{"providers.secrets_file", "SECRETS_FILE", &c.SecretsFile},
+		{"providers.supertone.api_url", "SUPERTONE_API_URL", &t.APIURL},
+		{"providers.supertone.api_key", "SUPERTONE_API_KEY", &t.APIKey},
+		{"providers.supertone.timeout", "SUPERTONE_TIMEOUT", &t.Timeout},
+		{"providers.circuit_breaker.failure_threshold", "CIRCUIT_FAILURE_THRESHOLD", &c.CircuitFailureThreshold},
+		{"providers.circuit_breaker.cooldown", "CIRCUIT_COOLDOWN", &c.CircuitCooldown},
+		{"", "TTS_API_URL", &c.TTSAPIURL},
+		{"", "TTS_API_KEY", &c.TTSAPIKey},
+
+		{"auth.admin_api_key", "ADMIN_API_KEY", &c.AdminAPIKey},
+
+		{"limits.max_body_bytes", "MAX_BODY_BYTES", &c.MaxBodyBytes},
+		{"limits.dialogue_concurrency", "DIALOGUE_CONCURRENCY", &c.DialogueConcurrency},
+		{"limits.audiobook_workers", "AUDIOBOOK_WORKERS", &c.AudiobookWorkers},
+
+		{"caching.voice_ttl", "VOICE_CACHE_TTL", &c.VoiceCacheTTL},
+		{"caching.health_canary_ttl", "HEALTH_CANARY_TTL", &c.HealthCanaryTTL},
+
+		{"storage.presets_file", "PRESETS_FILE", &c.PresetsFile},
+		{"storage.lexicons_file", "LEXICONS_FILE", &c.LexiconsFile},
+		{"storage.lexicon_dir", "LEXICON_DIR", &c.LexiconDir},
+		{"storage.voice_aliases_file", "VOICE_ALIASES_FILE", &c.VoiceAliasesFile},
+		{"storage.audiobook_dir", "AUDIOBOOK_DIR", &c.AudiobookDir},
+		{"storage.background_dir", "BACKGROUND_DIR", &c.BackgroundDir},
+		{"storage.usage_file", "USAGE_FILE", &c.UsageFile},
+		{"storage.usage_retention_days", "USAGE_RETENTION_DAYS", &c.UsageRetentionDays},
+		{"storage.model_pricing_file", "MODEL_PRICING_FILE", &c.ModelPricingFile},
+
+		{"watermark.key", "WATERMARK_KEY", &c.WatermarkKey},
+		{"watermark.all", "WATERMARK_ALL", &c.WatermarkAll},
+
+		{"health.probe_timeout_ms", "HEALTH_PROBE_TIMEOUT_MS", &c.HealthProbeTimeoutMS},
+		{"health.canary_voice", "HEALTH_CANARY_VOICE", &c.HealthCanaryVoice},
+		{"health.canary_text", "HEALTH_CANARY_TEXT", &c.HealthCanaryText},
+
+		{"logging.level", "LOG_LEVEL", &c.LogLevel},
+		{"logging.format", "LOG_FORMAT", &c.LogFormat},
+		{"logging.text", "LOG_TEXT", &c.LogText},
+		{"logging.sample_burst", "LOG_SAMPLE_BURST", &c.LogSampleBurst},
+		{"logging.sample_every", "LOG_SAMPLE_EVERY", &c.LogSampleEvery},
+
+		{"tracing.exporter", "TRACE_EXPORTER", &c.TraceExporter},
+		{"tracing.otlp_endpoint", "OTEL_EXPORTER_OTLP_ENDPOINT", &c.TraceOTLPEndpoint},
+		{"tracing.service_name", "OTEL_SERVICE_NAME", &c.TraceServiceName},
+		{"tracing.sample_ratio", "TRACE_SAMPLE_RATIO", &c.TraceSampleRatio},
+
+		{"audit.dir", "AUDIT_LOG_DIR", &c.AuditLogDir},
+		{"audit.text", "AUDIT_LOG_TEXT", &c.AuditLogText},
+		{"audit.max_bytes", "AUDIT_LOG_MAX_BYTES", &c.AuditLogMaxBytes},
+	}
+}
+
+// name은 오류 메시지에 쓸 항목 이름입니다. 예: server.port (PORT)
+func (s setting) name() string {
+	if s.path == "" {
+		return s.env
+	}
+	return fmt.Sprintf("%s (%s)", s.path, s.env)
+}
+
+// set은 문자열 값을 항목의 형식으로 변환해 저장합니다.
+func (s setting) set(raw string) error {
+	switch p := s.ptr.(type) {
+	case *string:
+		*p = raw
+	case *TTSProvider:
+		*p = TTSProvider(raw)
+	case *int:
+		v, err := strconv.Atoi(strings.TrimSpace(raw))
+		if err != nil {
+			return fmt.Errorf("expected an integer, got %q", raw)
+		}
+		*p = v
+	case *bool:
+		v, err := strconv.ParseBool(strings.TrimSpace(raw))
+		if err != nil {
+			return fmt.Errorf("expected true or false, got %q", raw)
+		}
+		*p = v
+	case *float64:
+		v, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return fmt.Errorf("expected a number, got %q", raw)
+		}
+		*p = v
+	default:
+		return fmt.Errorf("unsupported setting type %T", s.ptr)
+	}
+	return nil
+}
+
+// Load는 기본값, 설정 파일(path가 비어 있지 않을 때), 환경 변수 순서로 덮어쓴 설정을 읽고 검증합니다.
+// 제공자 API 키와 URL은 보안 파일에 값이 있으면 그 값을 우선합니다 (SupertoneConfig와 같은 규칙).
+// 잘못된 항목이 있으면 모든 문제를 담은 *ValidationError를 반환합니다.
+func Load(path string) (*Config, *TTSAPIConfig, error) {
+	cfg := DefaultConfig()
+	tts := &TTSAPIConfig{Provider: SupertoneProvider, Timeout: 30, Retries: 3}
+	items := settings(cfg, tts)
+
+	var problems []string
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+		}
+		if err := applyYAML(items, data); err != nil {
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				return nil, nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+			}
+			for _, p := range verr.Problems {
+				problems = append(problems, path+":"+p)
+			}
+		}
+	}
+	problems = append(problems, applyEnv(items)...)
+
+	if err := applySecrets(cfg.SecretsFile, tts); err != nil {
+		problems = append(problems, err.Error())
+	}
+	if tts.APIURL == "" {
+		tts.APIURL = "https://supertoneapi.com"
+	}
+
+	// 읽지 못한 항목은 기본값으로 남아 있으므로, 범위 검사도 함께 해서 모든 문제를 한 번에 보여 줌
+	var verr *ValidationError
+	if errors.As(Validate(cfg, tts), &verr) {
+		problems = append(problems, verr.Problems...)
+	}
+	if len(problems) > 0 {
+		return nil, nil, &ValidationError{Problems: problems}
+	}
+	return cfg, tts, nil
+}
+
+// Changed는 두 설정에서 값이 다른 항목의 이름을 반환합니다. 이름은 YAML 경로이며, 환경 변수로만 설정하는 항목은 환경 변수 이름입니다.
+func Changed(oldCfg *Config, oldTTS *TTSAPIConfig, newCfg *Config, newTTS *TTSAPIConfig) []string {
+	before, after := settings(oldCfg, oldTTS), settings(newCfg, newTTS)
+	var changed []string
+	for i, s := range before {
+		if reflect.ValueOf(s.ptr).Elem().Interface() != reflect.ValueOf(after[i].ptr).Elem().Interface() {
+			name := s.path
+			if name == "" {
+				name = s.env
+			}
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// applyEnv는 값이 있는 환경 변수로 항목을 덮어쓰고, 형식이 잘못된 변수의 문제를 반환합니다.
+func applyEnv(items []setting) []string {
+	var problems []string
+	for _, s := range items {
+		raw := os.Getenv(s.env)
+		if raw == "" {
+			continue
+		}
+		if err := s.set(raw); err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %v", s.env, err))
+		}
+	}
+	return problems
+}
+
+// applySecrets는 보안 파일의 제공자 API 키와 URL을 적용합니다. 파일이 없으면 아무것도 하지 않습니다.
+func applySecrets(path string, tts *TTSAPIConfig) error {
+	if path == "" {
+		return nil
+	}
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	secrets, err := LoadSecretsFile(path)
+	if err != nil {
+		return err
+	}
+	if tts.Provider == SupertoneProvider {
+		if secrets.Supertone.APIKey != "" {
+			tts.APIKey = secrets.Supertone.APIKey
+		}
+		if secrets.Supertone.APIURL != "" {
+			tts.APIURL = secrets.Supertone.APIURL
+		}
+	}
+	return nil
+}
+
+// applyYAML은 YAML 문서의 값을 항목에 적용합니다. 값 안의 ${VAR}, ${VAR:-기본값}은 환경 변수로 바꿉니다.
+// 알 수 없는 키, 형식이 잘못된 값, 설정되지 않은 환경 변수는 줄 번호와 함께 *ValidationError로 모아 반환합니다.
+func applyYAML(items []setting, data []byte) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 {
+		return nil // 빈 파일
+	}
+	byPath := make(map[string]setting, len(items))
+	for _, s := range items {
+		if s.path != "" {
+			byPath[s.path] = s
+		}
+	}
+	var problems []string
+	var walk func(prefix string, node *yaml.Node)
+	walk = func(prefix string, node *yaml.Node) {
+		if node.Kind != yaml.MappingNode {
+			section := strings.TrimSuffix(prefix, ".")
+			if section == "" {
+				section = "document"
+			}
+			problems = append(problems, fmt.Sprintf("%d: %s: expected a mapping of settings", node.Line, section))
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			path := prefix + key.Value
+			s, ok := byPath[path]
+			if !ok {
+				if hasPrefix(byPath, path+".") {
+					walk(path+".", value)
+				} else {
+					problems = append(problems, fmt.Sprintf("%d: unknown setting %s", key.Line, path))
+				}
+				continue
+			}
+			if value.Kind != yaml.ScalarNode {
+				problems = append(problems, fmt.Sprintf("%d: %s: expected a single value", value.Line, path))
+				continue
+			}
+			if value.Tag == "!!null" {
+				continue // 값을 비워 두면 기본값 유지
+			}
+			raw, err := interpolate(value.Value)
+			if err == nil {
+				err = s.set(raw)
+			}
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%d: %s: %v", value.Line, path, err))
+			}
+		}
+	}
+	walk("", doc.Content[0])
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+func hasPrefix(byPath map[string]setting, prefix string) bool {
+	for path := range byPath {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+var envRefPattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// interpolate는 ${VAR}와 ${VAR:-기본값}을 환경 변수 값으로 바꿉니다.
+// 기본값 없이 참조한 변수가 설정되지 않았으면 오류를 반환합니다.
+func interpolate(raw string) (string, error) {
+	var missing []string
+	out := envRefPattern.ReplaceAllStringFunc(raw, func(ref string) string {
+		m := envRefPattern.FindStringSubmatch(ref)
+		if v, ok := os.LookupEnv(m[1]); ok {
+			return v
+		}
+		if m[2] != "" {
+			return m[3]
+		}
+		missing = append(missing, m[1])
+		return ""
+	})
+	switch len(missing) {
+	case 0:
+		return out, nil
+	case 1:
+		return "", fmt.Errorf("environment variable %s is not set", missing[0])
+	default:
+		return "", fmt.Errorf("environment variables %s are not set", strings.Join(missing, ", "))
+	}
+}
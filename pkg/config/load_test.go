@@ -0,0 +1,209 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+// noSecrets는 테스트가 작업 디렉토리의 보안 파일을 읽지 않도록 합니다.
+func noSecrets(t *testing.T) {
+	t.Setenv("SECRETS_FILE", filepath.Join(t.TempDir(), "missing.json"))
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	noSecrets(t)
+
+	cfg, tts, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, "8080", cfg.Port)
+	assert.Equal(t, "/tts", cfg.TTSEndpoint)
+	assert.Equal(t, 600, cfg.VoiceCacheTTL)
+	assert.Equal(t, SupertoneProvider, tts.Provider)
+	assert.Equal(t, "https://supertoneapi.com", tts.APIURL)
+}
+
+func TestLoad_YAML(t *testing.T) {
+	noSecrets(t)
+	t.Setenv("TEST_SUPERTONE_KEY", "key-from-env")
+	path := writeConfigFile(t, `
+server:
+  port: 9090
+routing:
+  api_version: v2
+  tts_endpoint: /speak
+providers:
+  supertone:
+    api_url: https://api.example.com
+    api_key: ${TEST_SUPERTONE_KEY}
+  circuit_breaker:
+    failure_threshold: 0
+auth:
+  admin_api_key: ${TEST_ADMIN_KEY:-fallback-admin}
+limits:
+  max_body_bytes: 1048576
+  audiobook_workers: 2
+caching:
+  voice_ttl: 60
+logging:
+  level: debug
+  text: true
+tracing:
+  sample_ratio: 0.25
+storage:
+  presets_file:
+`)
+
+	cfg, tts, err := Load(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "9090", cfg.Port)
+	assert.Equal(t, "v2", cfg.APIVersion)
+	assert.Equal(t, "/speak", cfg.TTSEndpoint)
+	assert.Equal(t, "https://api.example.com", tts.APIURL)
+	assert.Equal(t, "key-from-env", tts.APIKey)
+	assert.Equal(t, 0, cfg.CircuitFailureThreshold)
+	assert.Equal(t, "fallback-admin", cfg.AdminAPIKey)
+	assert.Equal(t, 1<<20, cfg.MaxBodyBytes)
+	assert.Equal(t, 2, cfg.AudiobookWorkers)
+	assert.Equal(t, 60, cfg.VoiceCacheTTL)
+	assert.Equal(t, "debug", cfg.LogLevel)
+	assert.True(t, cfg.LogText)
+	assert.Equal(t, 0.25, cfg.TraceSampleRatio)
+	assert.Equal(t, "", cfg.PresetsFile)
+	assert.Equal(t, "data/audit", cfg.AuditLogDir) // 파일에 없는 항목은 기본값
+}
+
+func TestLoad_EnvOverridesYAML(t *testing.T) {
+	noSecrets(t)
+	t.Setenv("PORT", "7070")
+	t.Setenv("LOG_FORMAT", "text")
+	path := writeConfigFile(t, "server:\n  port: 9090\nlogging:\n  format: json\n  level: warn\n")
+
+	cfg, _, err := Load(path)
+
+	require.NoError(t, err)
+	assert.Equal(t, "7070", cfg.Port)
+	assert.Equal(t, "text", cfg.LogFormat)
+	assert.Equal(t, "warn", cfg.LogLevel)
+}
+
+func TestLoad_SecretsFileWins(t *testing.T) {
+	secrets := filepath.Join(t.TempDir(), "api_keys.json")
+	require.NoError(t, os.WriteFile(secrets, []byte(`{"supertone": {"api_key": "secret-key"}}`), 0600))
+	t.Setenv("SECRETS_FILE", secrets)
+	t.Setenv("SUPERTONE_API_KEY", "env-key")
+
+	_, tts, err := Load("")
+
+	require.NoError(t, err)
+	assert.Equal(t, "secret-key", tts.APIKey)
+	assert.Equal(t, "https://supertoneapi.com", tts.APIURL)
+}
+
+func TestLoad_ReportsAllProblems(t *testing.T) {
+	noSecrets(t)
+	t.Setenv("AUDIOBOOK_WORKERS", "many")
+	path := writeConfigFile(t, `
+server:
+  port: 80
+  prot: 80
+  shutdown_timeout: -1
+limits:
+  dialogue_concurrency: four
+auth:
+  admin_api_key: ${TEST_UNSET_ADMIN_KEY}
+caching: 10
+`)
+
+	_, _, err := Load(path)
+
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr), "got %v", err)
+	assert.Equal(t, []string{
+		path + ":4: unknown setting server.prot",
+		path + ":7: limits.dialogue_concurrency: expected an integer, got \"four\"",
+		path + ":9: auth.admin_api_key: environment variable TEST_UNSET_ADMIN_KEY is not set",
+		path + ":10: caching: expected a mapping of settings",
+		"AUDIOBOOK_WORKERS: expected an integer, got \"many\"",
+		"server.shutdown_timeout (SHUTDOWN_TIMEOUT): must be positive, got -1",
+	}, verr.Problems)
+}
+
+func TestLoad_ValidationError(t *testing.T) {
+	noSecrets(t)
+	path := writeConfigFile(t, "server:\n  port: 70000\nlogging:\n  format: xml\n")
+
+	_, _, err := Load(path)
+
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr), "got %v", err)
+	assert.Equal(t, []string{
+		`server.port (PORT): must be a port number between 1 and 65535, got "70000"`,
+		`logging.format (LOG_FORMAT): must be json or text, got "xml"`,
+	}, verr.Problems)
+}
+
+func TestLoad_InvalidYAML(t *testing.T) {
+	noSecrets(t)
+	path := writeConfigFile(t, "server:\n  port: [8080\n")
+
+	_, _, err := Load(path)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to parse config file")
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	_, _, err := Load(filepath.Join(t.TempDir(), "missing.yaml"))
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadConfig_IgnoresInvalidEnv(t *testing.T) {
+	t.Setenv("VOICE_CACHE_TTL", "soon")
+	t.Setenv("LOG_LEVEL", "debug")
+
+	cfg := LoadConfig()
+
+	assert.Equal(t, 600, cfg.VoiceCacheTTL)
+	assert.Equal(t, "debug", cfg.LogLevel)
+}
+
+func TestInterpolate(t *testing.T) {
+	t.Setenv("TEST_HOST", "example.com")
+	t.Setenv("TEST_EMPTY", "")
+
+	got, err := interpolate("https://${TEST_HOST}:${TEST_PORT:-443}/${TEST_EMPTY}")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com:443/", got)
+
+	_, err = interpolate("${TEST_MISSING_A}-${TEST_MISSING_B}")
+	assert.EqualError(t, err, "environment variables TEST_MISSING_A, TEST_MISSING_B are not set")
+}
+
+func TestChanged(t *testing.T) {
+	oldCfg, oldTTS := DefaultConfig(), &TTSAPIConfig{Provider: SupertoneProvider, APIKey: "old"}
+	newCfg, newTTS := DefaultConfig(), &TTSAPIConfig{Provider: SupertoneProvider, APIKey: "new"}
+	newCfg.CircuitCooldown = 60
+	newCfg.TTSAPIURL = "https://legacy.example.com"
+
+	assert.Equal(t, []string{
+		"providers.supertone.api_key",
+		"providers.circuit_breaker.cooldown",
+		"TTS_API_URL",
+	}, Changed(oldCfg, oldTTS, newCfg, newTTS))
+	assert.Empty(t, Changed(oldCfg, oldTTS, DefaultConfig(), &TTSAPIConfig{Provider: SupertoneProvider, APIKey: "old"}))
+}
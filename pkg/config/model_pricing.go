@@ -0,0 +1,60 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ModelPriceConfig는 모델 하나의 가격입니다.
+type ModelPriceConfig struct {
+	PerThousandChars float64 `json:"per_1k_chars"`
+	PerAudioMinute   float64 `json:"per_audio_minute"`
+}
+
+// ModelPricingConfig는 사용량 보고서의 비용 추정에 쓰는 가격표입니다. models의 "default" 항목은 가격표에 없는 모델에 적용됩니다.
+type ModelPricingConfig struct {
+	Currency string                      `json:"currency"`
+	Models   map[string]ModelPriceConfig `json:"models"`
+}
+
+// ModelPricingPath는 가격표 파일 경로를 반환합니다. MODEL_PRICING_FILE로 변경할 수 있습니다.
+func ModelPricingPath() string {
+	if path := os.Getenv("MODEL_PRICING_FILE"); path != "" {
+		return path
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return filepath.Join("config", "model_pricing.json")
+	}
+	return filepath.Join(wd, "config", "model_pricing.json")
+}
+
+// LoadModelPricing은 가격표 파일을 읽습니다. 파일이 없으면 빈 가격표(비용 0)를 반환합니다.
+func LoadModelPricing() (*ModelPricingConfig, error) {
+	return LoadModelPricingFile(ModelPricingPath())
+}
+
+// LoadModelPricingFile은 path의 가격표 파일을 읽습니다. 파일이 없으면 빈 가격표를 반환합니다.
+func LoadModelPricingFile(path string) (*ModelPricingConfig, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &ModelPricingConfig{Models: map[string]ModelPriceConfig{}}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read model pricing file %s: %w", path, err)
+	}
+
+	var pricing ModelPricingConfig
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return nil, fmt.Errorf("failed to parse model pricing JSON: %w", err)
+	}
+	for model, price := range pricing.Models {
+		if price.PerThousandChars < 0 || price.PerAudioMinute < 0 {
+			return nil, fmt.Errorf("model pricing %q: prices must not be negative", model)
+		}
+	}
+	return &pricing, nil
+}
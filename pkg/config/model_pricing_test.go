@@ -0,0 +1,48 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writePricingFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "model_pricing.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write model pricing file: %v", err)
+	}
+	return path
+}
+
+func TestLoadModelPricing(t *testing.T) {
+	t.Setenv("MODEL_PRICING_FILE", writePricingFile(t, `{
+		"currency": "USD",
+		"models": {
+			"sona_speech_1": {"per_1k_chars": 0.2},
+			"default": {"per_1k_chars": 0.1, "per_audio_minute": 0.02}
+		}
+	}`))
+
+	pricing, err := LoadModelPricing()
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", pricing.Currency)
+	assert.Equal(t, 0.2, pricing.Models["sona_speech_1"].PerThousandChars)
+	assert.Equal(t, 0.02, pricing.Models["default"].PerAudioMinute)
+}
+
+func TestLoadModelPricing_MissingFile(t *testing.T) {
+	t.Setenv("MODEL_PRICING_FILE", filepath.Join(t.TempDir(), "missing.json"))
+
+	pricing, err := LoadModelPricing()
+	assert.NoError(t, err)
+	assert.Empty(t, pricing.Models)
+}
+
+func TestLoadModelPricing_Negative(t *testing.T) {
+	t.Setenv("MODEL_PRICING_FILE", writePricingFile(t, `{"models": {"sona_speech_1": {"per_1k_chars": -1}}}`))
+
+	_, err := LoadModelPricing()
+	assert.ErrorContains(t, err, "sona_speech_1")
+}
@@ -28,8 +28,11 @@ func LoadSecrets() (*SecretsConfig, error) {
 	}
 
 	// Construct the path to the secrets file
-	secretsPath := filepath.Join(wd, "config", "secrets", "api_keys.json")
+	return LoadSecretsFile(filepath.Join(wd, "config", "secrets", "api_keys.json"))
+}
 
+// LoadSecretsFile loads the secrets configuration from the JSON file at path
+func LoadSecretsFile(secretsPath string) (*SecretsConfig, error) {
 	// Read the secrets file
 	data, err := os.ReadFile(secretsPath)
 	if err != nil {
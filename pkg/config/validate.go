@@ -0,0 +1,102 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// ValidationError는 설정의 모든 문제를 모은 오류입니다. 시작할 때 한 번에 모두 보여 주기 위해 첫 문제에서 멈추지 않습니다.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// validator는 항목 이름을 찾아 문제를 기록합니다.
+type validator struct {
+	items    []setting
+	problems []string
+}
+
+// check는 ok가 거짓이면 ptr 항목의 문제로 message를 기록합니다.
+func (v *validator) check(ptr any, ok bool, message string, args ...any) {
+	if ok {
+		return
+	}
+	name := fmt.Sprintf("%v", ptr)
+	for _, s := range v.items {
+		if s.ptr == ptr {
+			name = s.name()
+			break
+		}
+	}
+	v.problems = append(v.problems, name+": "+fmt.Sprintf(message, args...))
+}
+
+// Validate는 설정 값의 범위와 형식을 검사합니다.
+func Validate(c *Config, t *TTSAPIConfig) error {
+	v := &validator{items: settings(c, t)}
+
+	port, err := strconv.Atoi(c.Port)
+	v.check(&c.Port, err == nil && port > 0 && port <= 65535, "must be a port number between 1 and 65535, got %q", c.Port)
+	v.check(&c.ShutdownDrainDelay, c.ShutdownDrainDelay >= 0, "must not be negative, got %d", c.ShutdownDrainDelay)
+	v.check(&c.ShutdownTimeout, c.ShutdownTimeout > 0, "must be positive, got %d", c.ShutdownTimeout)
+	v.check(&c.ReloadInterval, c.ReloadInterval >= 0, "must not be negative (0 reloads only on SIGHUP), got %d", c.ReloadInterval)
+
+	v.check(&c.APIVersion, c.APIVersion != "" && !strings.Contains(c.APIVersion, "/"), "must be a non-empty path segment such as v1, got %q", c.APIVersion)
+	v.check(&c.TTSEndpoint, strings.HasPrefix(c.TTSEndpoint, "/") && len(c.TTSEndpoint) > 1, "must start with / such as /tts, got %q", c.TTSEndpoint)
+
+	v.check(&t.Provider, t.Provider == SupertoneProvider, "unknown provider %q (supported: %s)", t.Provider, SupertoneProvider)
+	v.check(&t.APIURL, isHTTPURL(t.APIURL), "must be an absolute http or https URL, got %q", t.APIURL)
+	v.check(&t.Timeout, t.Timeout > 0, "must be positive, got %d", t.Timeout)
+	v.check(&c.CircuitFailureThreshold, c.CircuitFailureThreshold >= 0, "must not be negative (0 disables the circuit breaker), got %d", c.CircuitFailureThreshold)
+	v.check(&c.CircuitCooldown, c.CircuitFailureThreshold == 0 || c.CircuitCooldown > 0, "must be positive when the circuit breaker is enabled, got %d", c.CircuitCooldown)
+
+	v.check(&c.MaxBodyBytes, c.MaxBodyBytes > 0, "must be positive, got %d", c.MaxBodyBytes)
+	v.check(&c.DialogueConcurrency, c.DialogueConcurrency > 0, "must be at least 1, got %d", c.DialogueConcurrency)
+	v.check(&c.AudiobookWorkers, c.AudiobookWorkers > 0, "must be at least 1, got %d", c.AudiobookWorkers)
+
+	v.check(&c.VoiceCacheTTL, c.VoiceCacheTTL >= 0, "must not be negative, got %d", c.VoiceCacheTTL)
+	v.check(&c.HealthCanaryTTL, c.HealthCanaryTTL >= 0, "must not be negative, got %d", c.HealthCanaryTTL)
+	v.check(&c.HealthProbeTimeoutMS, c.HealthProbeTimeoutMS > 0, "must be positive, got %d", c.HealthProbeTimeoutMS)
+
+	v.check(&c.AudiobookDir, c.AudiobookDir != "", "must not be empty")
+	v.check(&c.BackgroundDir, c.BackgroundDir != "", "must not be empty")
+	v.check(&c.AuditLogDir, c.AuditLogDir != "", "must not be empty")
+	v.check(&c.UsageRetentionDays, c.UsageRetentionDays >= 0, "must not be negative (0 keeps all usage), got %d", c.UsageRetentionDays)
+	v.check(&c.AuditLogMaxBytes, c.AuditLogMaxBytes >= 0, "must not be negative (0 uses the default of 64MiB), got %d", c.AuditLogMaxBytes)
+
+	var level slog.Level
+	v.check(&c.LogLevel, level.UnmarshalText([]byte(c.LogLevel)) == nil, "must be one of debug, info, warn, error, got %q", c.LogLevel)
+	v.check(&c.LogFormat, oneOf(c.LogFormat, "json", "text"), "must be json or text, got %q", c.LogFormat)
+	v.check(&c.LogSampleBurst, c.LogSampleBurst >= 0, "must not be negative, got %d", c.LogSampleBurst)
+	v.check(&c.LogSampleEvery, c.LogSampleEvery >= 0, "must not be negative, got %d", c.LogSampleEvery)
+
+	v.check(&c.TraceExporter, oneOf(c.TraceExporter, "", "none", "stdout", "otlp"), "must be one of none, stdout, otlp, got %q", c.TraceExporter)
+	v.check(&c.TraceOTLPEndpoint, c.TraceExporter != "otlp" || isHTTPURL(c.TraceOTLPEndpoint), "must be an absolute http or https URL, got %q", c.TraceOTLPEndpoint)
+	v.check(&c.TraceSampleRatio, c.TraceSampleRatio >= 0 && c.TraceSampleRatio <= 1, "must be between 0 and 1, got %g", c.TraceSampleRatio)
+
+	if len(v.problems) > 0 {
+		return &ValidationError{Problems: v.problems}
+	}
+	return nil
+}
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func oneOf(v string, allowed ...string) bool {
+	for _, a := range allowed {
+		if v == a {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func validTTSConfig() *TTSAPIConfig {
+	return &TTSAPIConfig{Provider: SupertoneProvider, APIURL: "https://supertoneapi.com", Timeout: 30}
+}
+
+func TestValidate_Defaults(t *testing.T) {
+	assert.NoError(t, Validate(DefaultConfig(), validTTSConfig()))
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config, t *TTSAPIConfig)
+		want   string
+	}{
+		{"port", func(c *Config, _ *TTSAPIConfig) { c.Port = "0" }, `server.port (PORT): must be a port number between 1 and 65535, got "0"`},
+		{"endpoint", func(c *Config, _ *TTSAPIConfig) { c.TTSEndpoint = "tts" }, `routing.tts_endpoint (TTS_ENDPOINT): must start with / such as /tts, got "tts"`},
+		{"provider", func(_ *Config, t *TTSAPIConfig) { t.Provider = "azure" }, `providers.default (TTS_PROVIDER): unknown provider "azure" (supported: supertone)`},
+		{"api url", func(_ *Config, t *TTSAPIConfig) { t.APIURL = "supertoneapi.com" }, `providers.supertone.api_url (SUPERTONE_API_URL): must be an absolute http or https URL, got "supertoneapi.com"`},
+		{"timeout", func(_ *Config, t *TTSAPIConfig) { t.Timeout = 0 }, `providers.supertone.timeout (SUPERTONE_TIMEOUT): must be positive, got 0`},
+		{"cooldown", func(c *Config, _ *TTSAPIConfig) { c.CircuitCooldown = 0 }, `providers.circuit_breaker.cooldown (CIRCUIT_COOLDOWN): must be positive when the circuit breaker is enabled, got 0`},
+		{"workers", func(c *Config, _ *TTSAPIConfig) { c.AudiobookWorkers = 0 }, `limits.audiobook_workers (AUDIOBOOK_WORKERS): must be at least 1, got 0`},
+		{"log level", func(c *Config, _ *TTSAPIConfig) { c.LogLevel = "verbose" }, `logging.level (LOG_LEVEL): must be one of debug, info, warn, error, got "verbose"`},
+		{"otlp endpoint", func(c *Config, _ *TTSAPIConfig) { c.TraceExporter, c.TraceOTLPEndpoint = "otlp", "" }, `tracing.otlp_endpoint (OTEL_EXPORTER_OTLP_ENDPOINT): must be an absolute http or https URL, got ""`},
+		{"sample ratio", func(c *Config, _ *TTSAPIConfig) { c.TraceSampleRatio = 1.5 }, `tracing.sample_ratio (TRACE_SAMPLE_RATIO): must be between 0 and 1, got 1.5`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, tts := DefaultConfig(), validTTSConfig()
+			tt.modify(cfg, tts)
+
+			err := Validate(cfg, tts)
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr), "got %v", err)
+			assert.Equal(t, []string{tt.want}, verr.Problems)
+		})
+	}
+}
+
+func TestValidationError_Error(t *testing.T) {
+	err := &ValidationError{Problems: []string{"a: bad", "b: worse"}}
+
+	assert.Equal(t, "invalid configuration:\n  - a: bad\n  - b: worse", err.Error())
+}
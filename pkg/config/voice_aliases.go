@@ -0,0 +1,58 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// VoiceAliasConfig는 별칭 하나에 대한 Voice ID와 기본 합성 설정입니다.
+type VoiceAliasConfig struct {
+	VoiceID       string                 `json:"voice_id"`
+	Language      string                 `json:"language"`
+	Style         string                 `json:"style"`
+	Model         string                 `json:"model"`
+	VoiceSettings map[string]interface{} `json:"voice_settings"`
+}
+
+// VoiceAliasesPath는 별칭 설정 파일 경로를 반환합니다. VOICE_ALIASES_FILE로 변경할 수 있습니다.
+func VoiceAliasesPath() string {
+	if path := os.Getenv("VOICE_ALIASES_FILE"); path != "" {
+		return path
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return filepath.Join("config", "voice_aliases.json")
+	}
+	return filepath.Join(wd, "config", "voice_aliases.json")
+}
+
+// LoadVoiceAliases는 별칭 설정 파일을 읽습니다. 파일이 없으면 빈 목록을 반환합니다.
+func LoadVoiceAliases() (map[string]VoiceAliasConfig, error) {
+	return LoadVoiceAliasesFile(VoiceAliasesPath())
+}
+
+// LoadVoiceAliasesFile은 path의 별칭 설정 파일을 읽습니다. 파일이 없으면 빈 목록을 반환합니다.
+func LoadVoiceAliasesFile(path string) (map[string]VoiceAliasConfig, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return map[string]VoiceAliasConfig{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read voice aliases file %s: %w", path, err)
+	}
+
+	var aliases map[string]VoiceAliasConfig
+	if err := json.Unmarshal(data, &aliases); err != nil {
+		return nil, fmt.Errorf("failed to parse voice aliases JSON: %w", err)
+	}
+
+	for name, alias := range aliases {
+		if alias.VoiceID == "" {
+			return nil, fmt.Errorf("voice alias %q: voice_id is required", name)
+		}
+	}
+	return aliases, nil
+}
@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeAliasesFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "voice_aliases.json")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write voice aliases file: %v", err)
+	}
+	return path
+}
+
+func TestLoadVoiceAliases(t *testing.T) {
+	path := writeAliasesFile(t, `{
+		"narrator": {
+			"voice_id": "voice-123",
+			"language": "ko",
+			"style": "neutral",
+			"model": "sona_speech_1",
+			"voice_settings": {"speed": 0.9}
+		}
+	}`)
+	t.Setenv("VOICE_ALIASES_FILE", path)
+
+	aliases, err := LoadVoiceAliases()
+	assert.NoError(t, err)
+	assert.Equal(t, "voice-123", aliases["narrator"].VoiceID)
+	assert.Equal(t, "ko", aliases["narrator"].Language)
+	assert.Equal(t, 0.9, aliases["narrator"].VoiceSettings["speed"])
+}
+
+func TestLoadVoiceAliases_MissingFile(t *testing.T) {
+	t.Setenv("VOICE_ALIASES_FILE", filepath.Join(t.TempDir(), "missing.json"))
+
+	aliases, err := LoadVoiceAliases()
+	assert.NoError(t, err)
+	assert.Empty(t, aliases)
+}
+
+func TestLoadVoiceAliases_MissingVoiceID(t *testing.T) {
+	t.Setenv("VOICE_ALIASES_FILE", writeAliasesFile(t, `{"narrator": {"language": "ko"}}`))
+
+	_, err := LoadVoiceAliases()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "narrator")
+}
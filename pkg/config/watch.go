@@ -0,0 +1,57 @@
+package config
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+// fileStamp는 파일이 바뀌었는지 비교할 수정 시각과 크기입니다. 파일이 없으면 zero 값입니다.
+type fileStamp struct {
+	modTime time.Time
+	size    int64
+}
+
+func stampOf(path string) fileStamp {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}
+	}
+	return fileStamp{modTime: info.ModTime(), size: info.Size()}
+}
+
+// FileWatcher는 파일의 수정 시각과 크기를 기억해 두었다가 바뀐 파일을 알려 줍니다.
+// 없던 파일이 생기거나 있던 파일이 지워져도 바뀐 것으로 봅니다.
+type FileWatcher struct {
+	mu     sync.Mutex
+	paths  []string
+	stamps map[string]fileStamp
+}
+
+// NewFileWatcher는 paths의 현재 상태를 기억하는 감시자를 만듭니다. 빈 경로는 무시합니다.
+func NewFileWatcher(paths ...string) *FileWatcher {
+	w := &FileWatcher{stamps: map[string]fileStamp{}}
+	for _, path := range paths {
+		if _, ok := w.stamps[path]; path == "" || ok {
+			continue
+		}
+		w.paths = append(w.paths, path)
+		w.stamps[path] = stampOf(path)
+	}
+	return w
+}
+
+// Changed는 마지막으로 확인한 뒤 바뀐 파일을 반환하고 현재 상태를 다시 기억합니다.
+func (w *FileWatcher) Changed() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var changed []string
+	for _, path := range w.paths {
+		stamp := stampOf(path)
+		if stamp != w.stamps[path] {
+			w.stamps[path] = stamp
+			changed = append(changed, path)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileWatcher(t *testing.T) {
+	dir := t.TempDir()
+	existing := filepath.Join(dir, "config.yaml")
+	missing := filepath.Join(dir, "api_keys.json")
+	require.NoError(t, os.WriteFile(existing, []byte("server:\n"), 0644))
+
+	w := NewFileWatcher(existing, missing, "", existing)
+	assert.Empty(t, w.Changed())
+
+	// 내용과 수정 시각이 바뀌거나 없던 파일이 생기면 알림
+	require.NoError(t, os.WriteFile(existing, []byte("server:\n  port: 9090\n"), 0644))
+	require.NoError(t, os.WriteFile(missing, []byte("{}"), 0600))
+	assert.Equal(t, []string{existing, missing}, w.Changed())
+	assert.Empty(t, w.Changed())
+
+	// 크기가 같아도 수정 시각이 바뀌면 알림
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(missing, later, later))
+	assert.Equal(t, []string{missing}, w.Changed())
+
+	require.NoError(t, os.Remove(existing))
+	assert.Equal(t, []string{existing}, w.Changed())
+}
@@ -0,0 +1,228 @@
+// Package document는 Markdown 또는 일반 텍스트 문서를 낭독용 챕터로 나눕니다.
+package document
+
+import (
+	"regexp"
+	"strings"
+)
+
+// 문서 형식
+const (
+	FormatMarkdown = "markdown"
+	FormatText     = "text"
+)
+
+// Chapter는 제목과 낭독할 본문입니다. 본문의 문단은 빈 줄로 구분됩니다.
+type Chapter struct {
+	Title string
+	Text  string
+}
+
+var (
+	mdHeading   = regexp.MustCompile(`^(#{1,6})\s+(.+?)\s*#*\s*$`)
+	textHeading = regexp.MustCompile(`(?i)^(?:chapter|part)\s+[\w.-]+(?:\W.*)?$|^제\s*\d+\s*[장부편화](?:\s.*)?$|^第.{1,6}[章部話](?:\s.*)?$|^(?:프롤로그|에필로그|prologue|epilogue)$`)
+)
+
+// DetectFormat은 Markdown 제목(#)이 있으면 markdown, 없으면 text를 반환합니다.
+func DetectFormat(doc string) string {
+	for _, line := range lines(doc) {
+		if mdHeading.MatchString(line) {
+			return FormatMarkdown
+		}
+	}
+	return FormatText
+}
+
+// Parse는 문서를 챕터로 나눕니다. format이 비어 있으면 DetectFormat으로 정합니다.
+//
+// Markdown은 두 번 이상 나오는 가장 얕은 수준의 제목(없으면 가장 얕은 제목)을 챕터 경계로 쓰고, 그보다 깊은 제목은
+// 본문 문단으로 읽습니다. 일반 텍스트는 "Chapter 1", "제1장", "第一章" 같은 단독 줄을 챕터 경계로 씁니다.
+// 첫 챕터 제목 앞의 본문은 제목 없는 챕터가 됩니다.
+func Parse(doc, format string) []Chapter {
+	if format == "" {
+		format = DetectFormat(doc)
+	}
+	if format == FormatMarkdown {
+		return parseMarkdown(doc)
+	}
+	return parseText(doc)
+}
+
+func lines(doc string) []string {
+	return strings.Split(strings.ReplaceAll(doc, "\r\n", "\n"), "\n")
+}
+
+func parseMarkdown(doc string) []Chapter {
+	source := stripCodeBlocks(lines(doc))
+	level := chapterLevel(source)
+
+	var chapters []Chapter
+	current := &Chapter{}
+	var body []string
+	finish := func() {
+		current.Text = joinParagraphs(body)
+		if current.Title != "" || current.Text != "" {
+			chapters = append(chapters, *current)
+		}
+		body = nil
+	}
+
+	for _, line := range source {
+		if m := mdHeading.FindStringSubmatch(line); m != nil {
+			title := stripInline(m[2])
+			if len(m[1]) <= level {
+				finish()
+				current = &Chapter{Title: title}
+				continue
+			}
+			// 하위 제목은 앞뒤를 문단으로 나눠 본문으로 읽음
+			body = append(body, "", title+".", "")
+			continue
+		}
+		if listMarker.MatchString(line) {
+			// 목록 항목은 각각 한 문단으로 읽음
+			body = append(body, "", stripBlock(line), "")
+			continue
+		}
+		body = append(body, stripBlock(line))
+	}
+	finish()
+	return chapters
+}
+
+// chapterLevel은 챕터 경계로 쓸 제목 수준을 정합니다.
+func chapterLevel(source []string) int {
+	counts := map[int]int{}
+	shallowest := 0
+	for _, line := range source {
+		if m := mdHeading.FindStringSubmatch(line); m != nil {
+			n := len(m[1])
+			counts[n]++
+			if shallowest == 0 || n < shallowest {
+				shallowest = n
+			}
+		}
+	}
+	for n := 1; n <= 6; n++ {
+		if counts[n] > 1 {
+			return n
+		}
+	}
+	return shallowest
+}
+
+func parseText(doc string) []Chapter {
+	var chapters []Chapter
+	current := &Chapter{}
+	var body []string
+	for _, line := range lines(doc) {
+		trimmed := strings.TrimSpace(line)
+		if len([]rune(trimmed)) <= 60 && textHeading.MatchString(trimmed) {
+			current.Text = joinParagraphs(body)
+			if current.Title != "" || current.Text != "" {
+				chapters = append(chapters, *current)
+			}
+			current, body = &Chapter{Title: trimmed}, nil
+			continue
+		}
+		body = append(body, line)
+	}
+	current.Text = joinParagraphs(body)
+	if current.Title != "" || current.Text != "" {
+		chapters = append(chapters, *current)
+	}
+	return chapters
+}
+
+// stripCodeBlocks는 펜스 코드 블록을 제거합니다. 코드는 낭독하지 않습니다.
+func stripCodeBlocks(source []string) []string {
+	var out []string
+	inFence := false
+	for _, line := range source {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") || strings.HasPrefix(strings.TrimSpace(line), "~~~") {
+			inFence = !inFence
+			out = append(out, "")
+			continue
+		}
+		if !inFence {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+var (
+	listMarker = regexp.MustCompile(`^\s*(?:[-*+]|\d+[.)])\s+(?:\[[ xX]\]\s+)?`)
+	quote      = regexp.MustCompile(`^\s*(?:>\s?)+`)
+	rule       = regexp.MustCompile(`^\s*(?:[-*_]\s*){3,}$`)
+	tableSep   = regexp.MustCompile(`^\s*\|?\s*:?-+:?\s*(?:\|\s*:?-+:?\s*)*\|?\s*$`)
+)
+
+// stripBlock은 줄 단위 Markdown 문법(목록, 인용, 구분선, 표)을 제거합니다.
+func stripBlock(line string) string {
+	if rule.MatchString(line) || tableSep.MatchString(line) {
+		return ""
+	}
+	line = quote.ReplaceAllString(line, "")
+	line = listMarker.ReplaceAllString(line, "")
+	if strings.Contains(line, "|") {
+		cells := strings.Split(strings.Trim(strings.TrimSpace(line), "|"), "|")
+		for i, c := range cells {
+			cells[i] = strings.TrimSpace(c)
+		}
+		line = strings.Join(cells, ", ")
+	}
+	return stripInline(line)
+}
+
+var (
+	image      = regexp.MustCompile(`!\[[^\]]*\]\([^)]*\)`)
+	link       = regexp.MustCompile(`\[([^\]]+)\]\([^)]*\)`)
+	refLink    = regexp.MustCompile(`\[([^\]]+)\]\[[^\]]*\]`)
+	inlineCode = regexp.MustCompile("`([^`]*)`")
+	emphasis   = []*regexp.Regexp{
+		regexp.MustCompile(`\*\*\*(\S(?:.*?\S)?)\*\*\*`),
+		regexp.MustCompile(`\*\*(\S(?:.*?\S)?)\*\*`),
+		regexp.MustCompile(`\*(\S(?:.*?\S)?)\*`),
+		regexp.MustCompile(`\b___(\S(?:.*?\S)?)___\b`),
+		regexp.MustCompile(`\b__(\S(?:.*?\S)?)__\b`),
+		regexp.MustCompile(`\b_(\S(?:.*?\S)?)_\b`),
+		regexp.MustCompile(`~~(\S(?:.*?\S)?)~~`),
+	}
+	htmlTag  = regexp.MustCompile(`</?[A-Za-z][^>]*>`)
+	footnote = regexp.MustCompile(`\[\^[^\]]+\]`)
+)
+
+// stripInline은 링크, 이미지, 강조, 인라인 코드, HTML 태그를 텍스트만 남기고 제거합니다.
+func stripInline(s string) string {
+	s = image.ReplaceAllString(s, "")
+	s = link.ReplaceAllString(s, "$1")
+	s = refLink.ReplaceAllString(s, "$1")
+	s = footnote.ReplaceAllString(s, "")
+	s = inlineCode.ReplaceAllString(s, "$1")
+	s = htmlTag.ReplaceAllString(s, "")
+	for _, re := range emphasis {
+		s = re.ReplaceAllString(s, "$1")
+	}
+	return strings.TrimSpace(s)
+}
+
+// joinParagraphs는 줄을 빈 줄 기준 문단으로 모읍니다. 연속된 빈 줄은 하나로 합칩니다.
+func joinParagraphs(body []string) string {
+	var paragraphs []string
+	var current []string
+	for _, line := range body {
+		if strings.TrimSpace(line) == "" {
+			if len(current) > 0 {
+				paragraphs = append(paragraphs, strings.Join(current, "\n"))
+				current = nil
+			}
+			continue
+		}
+		current = append(current, strings.TrimSpace(line))
+	}
+	if len(current) > 0 {
+		paragraphs = append(paragraphs, strings.Join(current, "\n"))
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
@@ -0,0 +1,58 @@
+package document
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Markdown(t *testing.T) {
+	doc := "# 나의 책\n\n" +
+		"머리말입니다.\n\n" +
+		"## 1장 시작\n\n" +
+		"첫 문단은 **굵게** 쓰고 [링크](https://example.com)를 답니다.\n" +
+		"같은 문단의 둘째 줄입니다.\n\n" +
+		"### 작은 제목\n" +
+		"- 사과\n" +
+		"- 배\n\n" +
+		"```go\nfmt.Println(\"skip\")\n```\n\n" +
+		"> 인용문과 `코드`\n\n" +
+		"## 2장 끝\n\n" +
+		"| 이름 | 값 |\n|---|---|\n| a | 1 |\n\n" +
+		"---\n" +
+		"![그림](a.png)마지막 _문장_.\n"
+
+	chapters := Parse(doc, "")
+	assert.Equal(t, []Chapter{
+		{Title: "나의 책", Text: "머리말입니다."},
+		{Title: "1장 시작", Text: "첫 문단은 굵게 쓰고 링크를 답니다.\n같은 문단의 둘째 줄입니다.\n\n작은 제목.\n\n사과\n\n배\n\n인용문과 코드"},
+		{Title: "2장 끝", Text: "이름, 값\n\na, 1\n\n마지막 문장."},
+	}, chapters)
+}
+
+func TestParse_MarkdownSingleHeadingLevel(t *testing.T) {
+	// 두 번 이상 나오는 제목이 없으면 가장 얕은 제목으로 나눔
+	chapters := Parse("intro\n\n# Only\n\nbody\n\n### deep\n\nmore", FormatMarkdown)
+	assert.Equal(t, []Chapter{
+		{Text: "intro"},
+		{Title: "Only", Text: "body\n\ndeep.\n\nmore"},
+	}, chapters)
+}
+
+func TestParse_Text(t *testing.T) {
+	doc := "서문입니다.\n\n제1장 봄\n봄이 왔다.\n\n꽃이 핀다.\n\n제 2 장\n여름이다.\nChapter 3: Autumn\nLeaves fall.\n第三章\n冬です。"
+
+	assert.Equal(t, FormatText, DetectFormat(doc))
+	assert.Equal(t, []Chapter{
+		{Text: "서문입니다."},
+		{Title: "제1장 봄", Text: "봄이 왔다.\n\n꽃이 핀다."},
+		{Title: "제 2 장", Text: "여름이다."},
+		{Title: "Chapter 3: Autumn", Text: "Leaves fall."},
+		{Title: "第三章", Text: "冬です。"},
+	}, Parse(doc, ""))
+}
+
+func TestParse_TextWithoutHeadings(t *testing.T) {
+	assert.Equal(t, []Chapter{{Text: "just a story.\n\nsecond paragraph."}}, Parse("just a story.\n\n\n\nsecond paragraph.\n", FormatText))
+	assert.Nil(t, Parse("  \n\n", FormatText))
+}
@@ -0,0 +1,151 @@
+// Package langdetect는 문자 체계와 n-gram 빈도로 텍스트의 언어(ko, en, ja)를 추정합니다.
+package langdetect
+
+import (
+	"strings"
+	"unicode"
+
+	"tts_proxy/pkg/sentence"
+)
+
+// 지원하는 언어 코드
+const (
+	Korean   = "ko"
+	English  = "en"
+	Japanese = "ja"
+)
+
+// Result는 언어 추정 결과입니다. Confidence는 0~1 사이의 상대 점수입니다.
+type Result struct {
+	Language   string
+	Confidence float64
+}
+
+// Segment는 같은 언어로 판정된 연속된 문장들입니다.
+type Segment struct {
+	Text     string
+	Language string
+}
+
+// latinWeight는 라틴 문자 한 글자의 가중치입니다. 한글 음절, 가나, 한자 한 글자가 라틴 문자 약 세 글자의 정보량을 가집니다.
+const latinWeight = 1.0 / 3
+
+// enTrigrams는 영어 텍스트에서 빈도가 높은 문자 3-gram입니다. '_'는 단어 경계입니다.
+var enTrigrams = toSet(
+	"_th", "the", "he_", "_an", "and", "nd_", "ing", "ng_", "_to", "to_", "_of", "of_", "_in", "in_",
+	"ion", "on_", "tio", "ent", "_a_", "is_", "_is", "er_", "ed_", "es_", "_he", "re_", "hat", "tha",
+	"at_", "for", "_fo", "or_", "_wi", "wit", "ith", "th_", "ere", "her", "you", "_yo", "ou_", "ter",
+	"ati", "all", "_be", "_co", "_re", "ver", "ly_", "_it", "it_", "_wh", "are", "_ar", "ate", "con",
+	"_ha", "hav", "ave", "ve_", "_we", "_pr", "pro", "_no", "not", "ot_", "_on", "_fr", "fro", "rom",
+	"om_", "thi", "his", "ill", "est", "st_", "ers", "_se", "_ma", "_de", "_so", "ce_", "_wa", "was",
+	"as_", "nce", "_i_", "_my", "my_", "_me", "me_", "ow_", "_ho", "how", "ell", "llo", "lo_", "ld_",
+)
+
+func toSet(items ...string) map[string]struct{} {
+	set := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		set[item] = struct{}{}
+	}
+	return set
+}
+
+// Detect는 텍스트의 언어를 추정합니다. 글자가 없으면 Language가 빈 문자열입니다.
+//
+// 한글은 ko, 가나는 ja로 세고, 한자는 가나가 있거나 한글이 없을 때 ja, 그 밖에는 ko(한자어 표기)로 셉니다.
+// 라틴 문자는 영어 3-gram 비율로 가중하므로 한국어 문장 속 약어나 브랜드명("API", "Supertone")이 판정을 뒤집지 않습니다.
+func Detect(text string) Result {
+	var hangul, kana, han, latin int
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Hangul, r):
+			hangul++
+		case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+			kana++
+		case unicode.Is(unicode.Han, r):
+			han++
+		case r < unicode.MaxLatin1 && unicode.IsLetter(r):
+			latin++
+		}
+	}
+
+	scores := map[string]float64{
+		Korean:   float64(hangul),
+		Japanese: float64(kana),
+		English:  float64(latin) * latinWeight * (0.3 + 2*englishness(text)),
+	}
+	if kana > 0 || hangul == 0 {
+		scores[Japanese] += float64(han)
+	} else {
+		scores[Korean] += float64(han)
+	}
+
+	var best Result
+	var total float64
+	for _, lang := range []string{Korean, Japanese, English} {
+		total += scores[lang]
+		if scores[lang] > best.Confidence {
+			best = Result{Language: lang, Confidence: scores[lang]}
+		}
+	}
+	if total == 0 {
+		return Result{}
+	}
+	best.Confidence /= total
+	return best
+}
+
+// englishness는 라틴 문자 단어의 3-gram 중 영어 상위 3-gram의 비율(0~1)을 반환합니다.
+func englishness(text string) float64 {
+	var matched, total int
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(r < unicode.MaxASCII && unicode.IsLetter(r))
+	}) {
+		padded := "_" + word + "_"
+		for i := 0; i+3 <= len(padded); i++ {
+			total++
+			if _, ok := enTrigrams[padded[i:i+3]]; ok {
+				matched++
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	ratio := float64(matched) / float64(total)
+	if ratio > 0.5 {
+		return 0.5
+	}
+	return ratio
+}
+
+// Split은 텍스트를 문장 단위로 나눠 언어를 판정하고, 같은 언어의 인접 문장을 하나의 Segment로 합칩니다.
+// 글자가 없는 문장(숫자, 기호만 있는 경우)은 앞 문장에, 맨 앞이면 뒤 문장에 붙습니다.
+func Split(text string) []Segment {
+	var segments []Segment
+	pending := "" // 언어를 정하지 못한 앞부분
+	for _, s := range sentence.SplitRaw(text) {
+		lang := Detect(s).Language
+		switch {
+		case lang == "" && len(segments) == 0:
+			pending += s
+		case lang == "":
+			segments[len(segments)-1].Text += s
+		case len(segments) > 0 && segments[len(segments)-1].Language == lang:
+			segments[len(segments)-1].Text += s
+		default:
+			segments = append(segments, Segment{Text: pending + s, Language: lang})
+			pending = ""
+		}
+	}
+
+	if len(segments) == 0 {
+		if strings.TrimSpace(pending) == "" {
+			return nil
+		}
+		return []Segment{{Text: strings.TrimSpace(pending)}}
+	}
+	for i := range segments {
+		segments[i].Text = strings.TrimSpace(segments[i].Text)
+	}
+	return segments
+}
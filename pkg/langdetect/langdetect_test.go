@@ -0,0 +1,64 @@
+package langdetect
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"korean", "안녕하세요, 만나서 반갑습니다.", Korean},
+		{"english", "Hello, how are you today?", English},
+		{"japanese kana", "こんにちは、元気ですか？", Japanese},
+		{"katakana", "コンピューター", Japanese},
+		{"kanji only", "東京都", Japanese},
+		{"korean with hanja", "大韓民國 만세", Korean},
+		{"korean with brand names", "Supertone API를 사용합니다", Korean},
+		{"english with korean name", "I met 민수 at the station and we talked for an hour.", English},
+		{"japanese with english word", "これはTTSのテストです", Japanese},
+		{"no letters", "123 !?", ""},
+		{"empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Detect(tt.text).Language)
+		})
+	}
+}
+
+func TestDetect_Confidence(t *testing.T) {
+	assert.Equal(t, 1.0, Detect("안녕하세요").Confidence)
+
+	mixed := Detect("안녕하세요 hello")
+	assert.Equal(t, Korean, mixed.Language)
+	assert.Less(t, mixed.Confidence, 1.0)
+	assert.Greater(t, mixed.Confidence, 0.5)
+}
+
+func TestSplit(t *testing.T) {
+	segments := Split("안녕하세요. 반갑습니다! Hello there. How are you? こんにちは。")
+	assert.Equal(t, []Segment{
+		{Text: "안녕하세요. 반갑습니다!", Language: Korean},
+		{Text: "Hello there. How are you?", Language: English},
+		{Text: "こんにちは。", Language: Japanese},
+	}, segments)
+}
+
+func TestSplit_AttachesSentencesWithoutLetters(t *testing.T) {
+	assert.Equal(t, []Segment{
+		{Text: "1. 첫째입니다. 2. 둘째입니다.", Language: Korean},
+	}, Split("1. 첫째입니다. 2. 둘째입니다."))
+
+	assert.Equal(t, []Segment{
+		{Text: "원주율은 3.14입니다.", Language: Korean},
+	}, Split("원주율은 3.14입니다."))
+
+	assert.Equal(t, []Segment{{Text: "123"}}, Split("123"))
+	assert.Nil(t, Split("  "))
+}
@@ -0,0 +1,97 @@
+// Package logging은 log/slog 기반의 구조화 로거를 만듭니다. 요청 범위 필드를 컨텍스트로 전달하고,
+// 비밀 값과 사용자 텍스트를 가리며, 디버그 로그를 표본 추출합니다.
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// Options는 로거 설정입니다.
+type Options struct {
+	Level  slog.Level
+	Format string // json(기본값) 또는 text
+	// LogText가 true이면 합성 텍스트 같은 사용자 콘텐츠를 가리지 않고 기록합니다.
+	LogText bool
+	// Secrets는 어떤 필드나 메시지에 나타나더라도 가릴 값입니다 (API 키 등).
+	Secrets []string
+	// SecretSet이 있으면 Secrets를 여기에 더해 사용하므로, 로거를 만든 뒤에도 가릴 값을 추가할 수 있습니다.
+	SecretSet *SecretSet
+	// SampleBurst, SampleEvery는 Info 미만 로그의 표본 추출 설정입니다. 같은 메시지를 1초에 SampleBurst개까지
+	// 기록한 뒤에는 SampleEvery개마다 하나만 기록합니다. SampleBurst가 0이면 표본 추출하지 않습니다.
+	SampleBurst int
+	SampleEvery int
+}
+
+// New는 w에 기록하는 로거를 만듭니다.
+func New(w io.Writer, opts Options) (*slog.Logger, error) {
+	handlerOpts := &slog.HandlerOptions{Level: opts.Level}
+	var h slog.Handler
+	switch strings.ToLower(opts.Format) {
+	case "", "json":
+		h = slog.NewJSONHandler(w, handlerOpts)
+	case "text":
+		h = slog.NewTextHandler(w, handlerOpts)
+	default:
+		return nil, fmt.Errorf("unknown log format %q", opts.Format)
+	}
+	secrets := opts.SecretSet
+	if secrets == nil {
+		secrets = NewSecretSet()
+	}
+	secrets.Add(opts.Secrets...)
+	h = newRedactHandler(h, opts.LogText, secrets)
+	if opts.SampleBurst > 0 {
+		h = newSampleHandler(h, opts.SampleBurst, opts.SampleEvery, time.Second)
+	}
+	return slog.New(h), nil
+}
+
+// ParseLevel은 debug, info, warn, error를 slog.Level로 바꿉니다.
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s)); err != nil {
+		return 0, fmt.Errorf("unknown log level %q", s)
+	}
+	return level, nil
+}
+
+type attrsKey struct{}
+
+// With는 이 컨텍스트로 남기는 모든 로그에 붙을 요청 범위 필드를 추가한 컨텍스트를 반환합니다.
+// 인자는 slog.Logger.With와 같은 키-값 쌍 또는 slog.Attr입니다.
+func With(ctx context.Context, args ...any) context.Context {
+	if len(args) == 0 {
+		return ctx
+	}
+	parent := Attrs(ctx)
+	attrs := make([]slog.Attr, len(parent), len(parent)+len(args)/2)
+	copy(attrs, parent)
+	attrs = append(attrs, argsToAttrs(args)...)
+	return context.WithValue(ctx, attrsKey{}, attrs)
+}
+
+// Attrs는 With로 컨텍스트에 추가한 필드를 반환합니다.
+func Attrs(ctx context.Context) []slog.Attr {
+	if ctx == nil {
+		return nil
+	}
+	attrs, _ := ctx.Value(attrsKey{}).([]slog.Attr)
+	return attrs
+}
+
+// argsToAttrs는 slog의 키-값 인자 규칙을 따라 Attr 목록을 만듭니다.
+func argsToAttrs(args []any) []slog.Attr {
+	var r slog.Record
+	r.Add(args...)
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return attrs
+}
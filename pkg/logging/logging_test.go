@@ -0,0 +1,66 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var line map[string]any
+		require.NoError(t, dec.Decode(&line))
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestNew_ContextAttrs(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, Options{Level: slog.LevelInfo})
+	require.NoError(t, err)
+
+	ctx := With(context.Background(), "request_id", "req-1")
+	child := With(ctx, "user_id", "alice", "voice_id", "voice-123")
+	logger.InfoContext(child, "synthesized", "chars", 12)
+	logger.InfoContext(ctx, "parent")
+	logger.Debug("hidden")
+
+	lines := decodeLines(t, &buf)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "synthesized", lines[0]["msg"])
+	assert.Equal(t, "req-1", lines[0]["request_id"])
+	assert.Equal(t, "alice", lines[0]["user_id"])
+	assert.Equal(t, "voice-123", lines[0]["voice_id"])
+	assert.Equal(t, float64(12), lines[0]["chars"])
+	assert.NotContains(t, lines[1], "user_id") // 부모 컨텍스트는 그대로
+}
+
+func TestNew_Options(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, Options{Level: slog.LevelDebug, Format: "text"})
+	require.NoError(t, err)
+	logger.Debug("hello", "n", 1)
+	assert.Contains(t, buf.String(), "level=DEBUG msg=hello n=1")
+
+	_, err = New(&buf, Options{Format: "xml"})
+	assert.Error(t, err)
+}
+
+func TestParseLevel(t *testing.T) {
+	for s, want := range map[string]slog.Level{"debug": slog.LevelDebug, "INFO": slog.LevelInfo, "warn": slog.LevelWarn, "error": slog.LevelError} {
+		level, err := ParseLevel(s)
+		assert.NoError(t, err)
+		assert.Equal(t, want, level)
+	}
+	_, err := ParseLevel("verbose")
+	assert.Error(t, err)
+}
@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Redacted는 가려진 비밀 값 대신 기록되는 문자열입니다.
+const Redacted = "[REDACTED]"
+
+// secretKeySuffixes로 끝나는 필드는 값과 관계없이 항상 가립니다.
+var secretKeySuffixes = []string{"key", "token", "secret", "password", "authorization", "cookie"}
+
+// contentKeys는 사용자가 보낸 텍스트를 담는 필드로, LogText가 꺼져 있으면 길이만 남깁니다.
+var contentKeys = map[string]bool{"text": true, "ssml": true, "body": true, "request_body": true, "response_body": true, "document": true}
+
+// SecretSet은 로그에서 가릴 비밀 값 목록입니다. 실행 중에 API 키가 바뀌면 Add로 새 값을 더합니다.
+type SecretSet struct {
+	mu     sync.RWMutex
+	values []string
+}
+
+// NewSecretSet은 values를 담은 목록을 만듭니다.
+func NewSecretSet(values ...string) *SecretSet {
+	s := &SecretSet{}
+	s.Add(values...)
+	return s
+}
+
+// Add는 가릴 값을 더합니다. 이미 있는 값과 너무 짧아 일반 문자열까지 가릴 값(4자 미만)은 무시합니다.
+func (s *SecretSet) Add(values ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, v := range values {
+		if len(v) >= 4 && !slices.Contains(s.values, v) {
+			s.values = append(s.values, v)
+		}
+	}
+}
+
+// scrub은 문자열에 포함된 비밀 값을 가립니다.
+func (s *SecretSet) scrub(str string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, secret := range s.values {
+		str = strings.ReplaceAll(str, secret, Redacted)
+	}
+	return str
+}
+
+// redactHandler는 기록 전에 비밀 값과 사용자 콘텐츠를 가리고 컨텍스트의 요청 범위 필드를 붙입니다.
+type redactHandler struct {
+	next    slog.Handler
+	logText bool
+	secrets *SecretSet
+}
+
+func newRedactHandler(next slog.Handler, logText bool, secrets *SecretSet) *redactHandler {
+	return &redactHandler{next: next, logText: logText, secrets: secrets}
+}
+
+func (h *redactHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactHandler) Handle(ctx context.Context, r slog.Record) error {
+	out := slog.NewRecord(r.Time, r.Level, h.scrub(r.Message), r.PC)
+	for _, a := range Attrs(ctx) {
+		out.AddAttrs(h.redact(a))
+	}
+	r.Attrs(func(a slog.Attr) bool {
+		out.AddAttrs(h.redact(a))
+		return true
+	})
+	return h.next.Handle(ctx, out)
+}
+
+func (h *redactHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = h.redact(a)
+	}
+	return &redactHandler{next: h.next.WithAttrs(redacted), logText: h.logText, secrets: h.secrets}
+}
+
+func (h *redactHandler) WithGroup(name string) slog.Handler {
+	return &redactHandler{next: h.next.WithGroup(name), logText: h.logText, secrets: h.secrets}
+}
+
+// redact는 필드 이름과 값에 따라 Attr을 가립니다. 그룹은 재귀적으로 처리합니다.
+func (h *redactHandler) redact(a slog.Attr) slog.Attr {
+	a.Value = a.Value.Resolve()
+	key := strings.ToLower(a.Key)
+	switch {
+	case a.Value.Kind() == slog.KindGroup:
+		group := a.Value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, g := range group {
+			redacted[i] = h.redact(g)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(redacted...)}
+	case isSecretKey(key):
+		return slog.String(a.Key, Redacted)
+	case contentKeys[key] && !h.logText:
+		return slog.String(a.Key, fmt.Sprintf("[REDACTED %d bytes]", len(a.Value.String())))
+	case a.Value.Kind() == slog.KindString:
+		return slog.String(a.Key, h.scrub(a.Value.String()))
+	case a.Value.Kind() == slog.KindAny:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.String(a.Key, h.scrub(err.Error()))
+		}
+	}
+	return a
+}
+
+func (h *redactHandler) scrub(s string) string {
+	return h.secrets.scrub(s)
+}
+
+func isSecretKey(key string) bool {
+	key = strings.NewReplacer("-", "_", ".", "_").Replace(key)
+	for _, suffix := range secretKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedact(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, Options{Secrets: []string{"sk-live-123456", "ab"}})
+	require.NoError(t, err)
+
+	ctx := With(context.Background(), "authorization", "Bearer abc")
+	logger.With("api_key", "sk-live-123456").InfoContext(ctx, "calling https://api/?key=sk-live-123456",
+		"text", "안녕하세요",
+		"X-Sup-Api-Key", "whatever",
+		"error", errors.New("upstream rejected sk-live-123456"),
+		slog.Group("upstream", "body", `{"text":"secret words"}`, "status", 400),
+		"voice", "ab", // 짧은 비밀 값은 가리지 않음
+	)
+
+	out := buf.String()
+	assert.NotContains(t, out, "sk-live-123456")
+	assert.NotContains(t, out, "안녕하세요")
+	assert.NotContains(t, out, "secret words")
+	assert.NotContains(t, out, "Bearer")
+
+	line := decodeLines(t, &buf)[0]
+	assert.Equal(t, "calling https://api/?key=[REDACTED]", line["msg"])
+	assert.Equal(t, Redacted, line["api_key"])
+	assert.Equal(t, Redacted, line["authorization"])
+	assert.Equal(t, Redacted, line["X-Sup-Api-Key"])
+	assert.Equal(t, "[REDACTED 15 bytes]", line["text"])
+	assert.Equal(t, "upstream rejected [REDACTED]", line["error"])
+	assert.Equal(t, map[string]any{"body": "[REDACTED 23 bytes]", "status": float64(400)}, line["upstream"])
+	assert.Equal(t, "ab", line["voice"])
+}
+
+func TestRedact_LogText(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(&buf, Options{LogText: true})
+	require.NoError(t, err)
+
+	logger.Info("synthesize", "text", "안녕하세요", "token", "t0k3n")
+	line := decodeLines(t, &buf)[0]
+	assert.Equal(t, "안녕하세요", line["text"])
+	assert.Equal(t, Redacted, line["token"]) // 비밀 값은 항상 가림
+}
+
+func TestRedact_SecretSetAdd(t *testing.T) {
+	var buf bytes.Buffer
+	secrets := NewSecretSet()
+	logger, err := New(&buf, Options{Secrets: []string{"sk-old-key"}, SecretSet: secrets})
+	require.NoError(t, err)
+	child := logger.With("component", "adapter")
+
+	// 로거를 만든 뒤 더한 값도 이미 만든 하위 로거까지 가림
+	secrets.Add("sk-rotated-key")
+	child.Info("keys sk-old-key sk-rotated-key")
+
+	assert.Equal(t, "keys [REDACTED] [REDACTED]", decodeLines(t, &buf)[0]["msg"])
+}
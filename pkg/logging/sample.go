@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// sampleHandler는 Info 미만 로그를 메시지별로 표본 추출합니다. 매 구간(tick)마다 같은 메시지를 burst개까지
+// 기록하고, 그 뒤로는 every개마다 하나만 기록합니다. Info 이상은 항상 기록합니다.
+type sampleHandler struct {
+	next  slog.Handler
+	state *sampleState
+}
+
+type sampleState struct {
+	burst, every int
+	tick         time.Duration
+	now          func() time.Time
+
+	mu      sync.Mutex
+	window  time.Time
+	counter map[string]int
+}
+
+func newSampleHandler(next slog.Handler, burst, every int, tick time.Duration) *sampleHandler {
+	return &sampleHandler{next: next, state: &sampleState{
+		burst: burst, every: every, tick: tick, now: time.Now,
+		counter: make(map[string]int),
+	}}
+}
+
+func (h *sampleHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *sampleHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level < slog.LevelInfo && !h.state.allow(r.Message) {
+		return nil
+	}
+	return h.next.Handle(ctx, r)
+}
+
+func (h *sampleHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &sampleHandler{next: h.next.WithAttrs(attrs), state: h.state}
+}
+
+func (h *sampleHandler) WithGroup(name string) slog.Handler {
+	return &sampleHandler{next: h.next.WithGroup(name), state: h.state}
+}
+
+// allow는 현재 구간에서 이 메시지를 기록할지 결정합니다.
+func (s *sampleState) allow(msg string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.window) >= s.tick {
+		s.window = now
+		clear(s.counter)
+	}
+	s.counter[msg]++
+	n := s.counter[msg]
+	if n <= s.burst {
+		return true
+	}
+	return s.every > 0 && (n-s.burst)%s.every == 0
+}
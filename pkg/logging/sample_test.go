@@ -0,0 +1,36 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSampleHandler(t *testing.T) {
+	var buf bytes.Buffer
+	h := newSampleHandler(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}), 3, 5, time.Second)
+	now := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	h.state.now = func() time.Time { return now }
+	logger := slog.New(h)
+
+	for i := 0; i < 20; i++ {
+		logger.Debug("chunk")
+		logger.Info("served")
+	}
+	logger.With("voice_id", "v").Debug("other")
+	assert.Equal(t, 3+3, strings.Count(buf.String(), "msg=chunk")) // 처음 3개, 이후 5개마다 하나
+	assert.Equal(t, 20, strings.Count(buf.String(), "msg=served")) // Info 이상은 모두 기록
+	assert.Equal(t, 1, strings.Count(buf.String(), "msg=other"))
+
+	// 다음 구간이 시작되면 다시 burst만큼 기록
+	buf.Reset()
+	now = now.Add(time.Second)
+	for i := 0; i < 3; i++ {
+		logger.Debug("chunk")
+	}
+	assert.Equal(t, 3, strings.Count(buf.String(), "msg=chunk"))
+}
@@ -0,0 +1,272 @@
+// Package metrics는 카운터, 게이지, 히스토그램을 모아 Prometheus 텍스트 형식(0.0.4)으로 내보내는 최소한의 레지스트리입니다.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType은 Prometheus 텍스트 노출 형식의 Content-Type입니다.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets는 HTTP 요청 처리 시간(초)에 쓰는 기본 히스토그램 구간입니다.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Registry는 등록된 지표를 이름순으로 내보냅니다.
+type Registry struct {
+	mu      sync.Mutex
+	metrics map[string]*metric
+}
+
+func NewRegistry() *Registry {
+	return &Registry{metrics: make(map[string]*metric)}
+}
+
+// metric은 이름과 레이블이 같은 시계열 묶음입니다.
+type metric struct {
+	name, help, kind string
+	labels           []string
+	buckets          []float64 // 히스토그램만 사용
+
+	mu     sync.Mutex
+	series map[string]*series
+}
+
+type series struct {
+	labelValues []string
+	value       float64   // 카운터, 게이지
+	counts      []float64 // 히스토그램 구간별 누적 전 개수
+	sum         float64
+	count       float64
+}
+
+func (r *Registry) register(name, help, kind string, buckets []float64, labels []string) *metric {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.metrics[name]; ok {
+		panic("metrics: duplicate metric " + name)
+	}
+	m := &metric{name: name, help: help, kind: kind, labels: labels, buckets: buckets, series: make(map[string]*series)}
+	r.metrics[name] = m
+	return m
+}
+
+// with는 레이블 값에 해당하는 시계열을 반환합니다. 호출자가 m.mu를 잡고 있어야 합니다.
+func (m *metric) with(labelValues []string) *series {
+	if len(labelValues) != len(m.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", m.name, len(m.labels), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	s, ok := m.series[key]
+	if !ok {
+		// 호출자가 요청 버퍼를 가리키는 문자열을 넘길 수 있으므로 보관하는 값은 복사
+		values := make([]string, len(labelValues))
+		for i, v := range labelValues {
+			values[i] = strings.Clone(v)
+		}
+		s = &series{labelValues: values}
+		if m.kind == "histogram" {
+			s.counts = make([]float64, len(m.buckets))
+		}
+		m.series[key] = s
+	}
+	return s
+}
+
+// Counter는 증가만 하는 값입니다.
+type Counter struct{ m *metric }
+
+// NewCounter는 카운터를 등록합니다. 이름은 관례대로 _total로 끝나야 합니다.
+func (r *Registry) NewCounter(name, help string, labels ...string) *Counter {
+	return &Counter{r.register(name, help, "counter", nil, labels)}
+}
+
+// Add는 v(0 이상)를 더합니다.
+func (c *Counter) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		panic("metrics: counter cannot decrease")
+	}
+	c.m.mu.Lock()
+	c.m.with(labelValues).value += v
+	c.m.mu.Unlock()
+}
+
+func (c *Counter) Inc(labelValues ...string) { c.Add(1, labelValues...) }
+
+// Value는 현재 값을 반환합니다.
+func (c *Counter) Value(labelValues ...string) float64 {
+	c.m.mu.Lock()
+	defer c.m.mu.Unlock()
+	return c.m.with(labelValues).value
+}
+
+// Gauge는 오르내리는 값입니다.
+type Gauge struct{ m *metric }
+
+func (r *Registry) NewGauge(name, help string, labels ...string) *Gauge {
+	return &Gauge{r.register(name, help, "gauge", nil, labels)}
+}
+
+func (g *Gauge) Add(v float64, labelValues ...string) {
+	g.m.mu.Lock()
+	g.m.with(labelValues).value += v
+	g.m.mu.Unlock()
+}
+
+func (g *Gauge) Set(v float64, labelValues ...string) {
+	g.m.mu.Lock()
+	g.m.with(labelValues).value = v
+	g.m.mu.Unlock()
+}
+
+func (g *Gauge) Inc(labelValues ...string) { g.Add(1, labelValues...) }
+func (g *Gauge) Dec(labelValues ...string) { g.Add(-1, labelValues...) }
+
+func (g *Gauge) Value(labelValues ...string) float64 {
+	g.m.mu.Lock()
+	defer g.m.mu.Unlock()
+	return g.m.with(labelValues).value
+}
+
+// Histogram은 관측값의 분포를 구간별 누적 개수로 기록합니다.
+type Histogram struct{ m *metric }
+
+// NewHistogram은 히스토그램을 등록합니다. buckets는 오름차순 상한값이며 +Inf는 자동으로 붙습니다.
+func (r *Registry) NewHistogram(name, help string, buckets []float64, labels ...string) *Histogram {
+	if !sort.Float64sAreSorted(buckets) {
+		panic("metrics: histogram buckets must be sorted")
+	}
+	return &Histogram{r.register(name, help, "histogram", buckets, labels)}
+}
+
+func (h *Histogram) Observe(v float64, labelValues ...string) {
+	h.m.mu.Lock()
+	defer h.m.mu.Unlock()
+	s := h.m.with(labelValues)
+	if i := sort.SearchFloat64s(h.m.buckets, v); i < len(s.counts) {
+		s.counts[i]++
+	}
+	s.sum += v
+	s.count++
+}
+
+// Count는 관측 횟수를 반환합니다.
+func (h *Histogram) Count(labelValues ...string) float64 {
+	h.m.mu.Lock()
+	defer h.m.mu.Unlock()
+	return h.m.with(labelValues).count
+}
+
+// WriteText는 모든 지표를 Prometheus 텍스트 형식으로 씁니다.
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.Lock()
+	metrics := make([]*metric, 0, len(r.metrics))
+	for _, m := range r.metrics {
+		metrics = append(metrics, m)
+	}
+	r.mu.Unlock()
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].name < metrics[j].name })
+
+	bw := bufio.NewWriter(w)
+	for _, m := range metrics {
+		m.write(bw)
+	}
+	return bw.Flush()
+}
+
+// WriteFile은 모든 지표를 path에 씁니다. 임시 파일에 쓴 뒤 이름을 바꾸므로 읽는 쪽이 쓰다 만 파일을 보지 않습니다.
+func (r *Registry) WriteFile(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if err := r.WriteText(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (m *metric) write(w *bufio.Writer) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Fprintf(w, "# HELP %s %s\n", m.name, escapeHelp(m.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", m.name, m.kind)
+	keys := make([]string, 0, len(m.series))
+	for k := range m.series {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		s := m.series[k]
+		labels := formatLabels(m.labels, s.labelValues)
+		if m.kind != "histogram" {
+			fmt.Fprintf(w, "%s%s %s\n", m.name, labels, formatValue(s.value))
+			continue
+		}
+		names := append(m.labels[:len(m.labels):len(m.labels)], "le")
+		values := append(s.labelValues[:len(s.labelValues):len(s.labelValues)], "")
+		var cumulative float64
+		for i, upper := range m.buckets {
+			cumulative += s.counts[i]
+			values[len(values)-1] = formatValue(upper)
+			fmt.Fprintf(w, "%s_bucket%s %s\n", m.name, formatLabels(names, values), formatValue(cumulative))
+		}
+		values[len(values)-1] = "+Inf"
+		fmt.Fprintf(w, "%s_bucket%s %s\n", m.name, formatLabels(names, values), formatValue(s.count))
+		fmt.Fprintf(w, "%s_sum%s %s\n", m.name, labels, formatValue(s.sum))
+		fmt.Fprintf(w, "%s_count%s %s\n", m.name, labels, formatValue(s.count))
+	}
+}
+
+func formatLabels(names, values []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(name)
+		b.WriteString(`="`)
+		b.WriteString(labelEscaper.Replace(values[i]))
+		b.WriteByte('"')
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func formatValue(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeHelp(s string) string { return helpEscaper.Replace(s) }
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistry_WriteText(t *testing.T) {
+	r := NewRegistry()
+	requests := r.NewCounter("http_requests_total", "Total HTTP requests.", "route", "status")
+	inFlight := r.NewGauge("http_in_flight", "In-flight requests.")
+	latency := r.NewHistogram("http_duration_seconds", "Request latency.\nIn seconds.", []float64{0.1, 1}, "route")
+
+	requests.Inc("/tts", "200")
+	requests.Add(2, "/tts", "200")
+	requests.Inc(`/a"b\c`, "500")
+	inFlight.Inc()
+	inFlight.Inc()
+	inFlight.Dec()
+	latency.Observe(0.05, "/tts")
+	latency.Observe(0.5, "/tts")
+	latency.Observe(3, "/tts")
+
+	var b strings.Builder
+	assert.NoError(t, r.WriteText(&b))
+	assert.Equal(t, `# HELP http_duration_seconds Request latency.\nIn seconds.
+# TYPE http_duration_seconds histogram
+http_duration_seconds_bucket{route="/tts",le="0.1"} 1
+http_duration_seconds_bucket{route="/tts",le="1"} 2
+http_duration_seconds_bucket{route="/tts",le="+Inf"} 3
+http_duration_seconds_sum{route="/tts"} 3.55
+http_duration_seconds_count{route="/tts"} 3
+# HELP http_in_flight In-flight requests.
+# TYPE http_in_flight gauge
+http_in_flight 1
+# HELP http_requests_total Total HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{route="/a\"b\\c",status="500"} 1
+http_requests_total{route="/tts",status="200"} 3
+`, b.String())
+
+	assert.Equal(t, 3.0, requests.Value("/tts", "200"))
+	assert.Equal(t, 3.0, latency.Count("/tts"))
+}
+
+func TestRegistry_WriteFile(t *testing.T) {
+	r := NewRegistry()
+	r.NewCounter("jobs_total", "Jobs.").Inc()
+	path := filepath.Join(t.TempDir(), "snapshot", "metrics.prom")
+
+	assert.NoError(t, r.WriteFile(path))
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "# HELP jobs_total Jobs.\n# TYPE jobs_total counter\njobs_total 1\n", string(data))
+	assert.NoFileExists(t, path+".tmp")
+}
+
+func TestRegistry_Misuse(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("x_total", "x", "a")
+	assert.Panics(t, func() { r.NewGauge("x_total", "dup") })
+	assert.Panics(t, func() { c.Inc() })
+	assert.Panics(t, func() { c.Add(-1, "v") })
+	assert.Panics(t, func() { r.NewHistogram("h", "h", []float64{1, 0.5}) })
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	r := NewRegistry()
+	c := r.NewCounter("c_total", "c", "worker")
+	h := r.NewHistogram("h_seconds", "h", DefaultBuckets)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				c.Inc("w")
+				h.Observe(0.01)
+				r.WriteText(&strings.Builder{})
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 8000.0, c.Value("w"))
+	assert.Equal(t, 8000.0, h.Count())
+}
@@ -0,0 +1,127 @@
+// Package pls는 W3C Pronunciation Lexicon Specification(PLS) 1.0 XML을 읽고 씁니다.
+//
+// TTS 프록시는 음소(phoneme) 입력을 지원하지 않으므로 <alias>가 있는 lexeme만 치환 규칙으로 사용할 수 있습니다.
+// 일치 방식 등 PLS에 없는 확장 정보는 urn:tts-proxy:lexicon 네임스페이스의 match 속성으로 기록합니다.
+package pls
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Namespace는 PLS 1.0 XML 네임스페이스입니다.
+const Namespace = "http://www.w3.org/2005/01/pronunciation-lexicon"
+
+// ExtensionNamespace는 PLS에 없는 확장 속성의 네임스페이스입니다.
+const ExtensionNamespace = "urn:tts-proxy:lexicon"
+
+// Lexicon은 PLS 문서입니다.
+type Lexicon struct {
+	Language string
+	Alphabet string
+	Lexemes  []Lexeme
+}
+
+// Lexeme은 하나 이상의 표기와 그 읽기(alias 또는 phoneme)입니다.
+type Lexeme struct {
+	Graphemes []string
+	Aliases   []string
+	Phonemes  []string
+	Match     string // 확장 속성: word, substring, regex
+}
+
+type xmlLexicon struct {
+	XMLName  xml.Name    `xml:"lexicon"`
+	Version  string      `xml:"version,attr"`
+	Alphabet string      `xml:"alphabet,attr,omitempty"`
+	Lang     string      `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Lexemes  []xmlLexeme `xml:"lexeme"`
+}
+
+type xmlLexeme struct {
+	Match     string   `xml:"urn:tts-proxy:lexicon match,attr,omitempty"`
+	Graphemes []string `xml:"grapheme"`
+	Phonemes  []string `xml:"phoneme,omitempty"`
+	Aliases   []string `xml:"alias,omitempty"`
+}
+
+// 인코딩 시에는 확장 네임스페이스 접두사를 루트에 한 번만 선언하기 위해 별도 구조체를 사용합니다.
+type xmlLexiconOut struct {
+	XMLName  xml.Name       `xml:"lexicon"`
+	Version  string         `xml:"version,attr"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	XmlnsExt string         `xml:"xmlns:tp,attr,omitempty"`
+	Alphabet string         `xml:"alphabet,attr"`
+	Lang     string         `xml:"xml:lang,attr,omitempty"`
+	Lexemes  []xmlLexemeOut `xml:"lexeme"`
+}
+
+type xmlLexemeOut struct {
+	Match     string   `xml:"tp:match,attr,omitempty"`
+	Graphemes []string `xml:"grapheme"`
+	Phonemes  []string `xml:"phoneme,omitempty"`
+	Aliases   []string `xml:"alias,omitempty"`
+}
+
+// Decode는 PLS 문서를 읽습니다.
+func Decode(r io.Reader) (*Lexicon, error) {
+	var doc xmlLexicon
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("pls: %w", err)
+	}
+	if doc.XMLName.Local != "lexicon" {
+		return nil, errors.New("pls: root element must be <lexicon>")
+	}
+
+	lex := &Lexicon{Language: doc.Lang, Alphabet: doc.Alphabet}
+	for i, l := range doc.Lexemes {
+		if len(l.Graphemes) == 0 {
+			return nil, fmt.Errorf("pls: lexeme %d has no <grapheme>", i)
+		}
+		lex.Lexemes = append(lex.Lexemes, Lexeme{
+			Graphemes: l.Graphemes,
+			Aliases:   l.Aliases,
+			Phonemes:  l.Phonemes,
+			Match:     l.Match,
+		})
+	}
+	return lex, nil
+}
+
+// Encode는 PLS 문서를 씁니다.
+func Encode(w io.Writer, lex *Lexicon) error {
+	alphabet := lex.Alphabet
+	if alphabet == "" {
+		alphabet = "ipa"
+	}
+	doc := xmlLexiconOut{
+		Version:  "1.0",
+		Xmlns:    Namespace,
+		Alphabet: alphabet,
+		Lang:     lex.Language,
+	}
+	for _, l := range lex.Lexemes {
+		if l.Match != "" {
+			doc.XmlnsExt = ExtensionNamespace
+		}
+		doc.Lexemes = append(doc.Lexemes, xmlLexemeOut{
+			Match:     l.Match,
+			Graphemes: l.Graphemes,
+			Phonemes:  l.Phonemes,
+			Aliases:   l.Aliases,
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return fmt.Errorf("pls: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,64 @@
+package pls
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const sample = `<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0"
+      xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"
+      alphabet="ipa" xml:lang="ko">
+  <lexeme>
+    <grapheme>Supertone</grapheme>
+    <grapheme>SUPERTONE</grapheme>
+    <alias>수퍼톤</alias>
+  </lexeme>
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <phoneme>ˈdʌbəljuː θriː siː</phoneme>
+  </lexeme>
+</lexicon>`
+
+func TestDecode(t *testing.T) {
+	lex, err := Decode(strings.NewReader(sample))
+	assert.NoError(t, err)
+	assert.Equal(t, "ko", lex.Language)
+	assert.Equal(t, "ipa", lex.Alphabet)
+	assert.Len(t, lex.Lexemes, 2)
+	assert.Equal(t, []string{"Supertone", "SUPERTONE"}, lex.Lexemes[0].Graphemes)
+	assert.Equal(t, []string{"수퍼톤"}, lex.Lexemes[0].Aliases)
+	assert.Empty(t, lex.Lexemes[1].Aliases)
+	assert.Len(t, lex.Lexemes[1].Phonemes, 1)
+}
+
+func TestEncodeDecode_RoundTrip(t *testing.T) {
+	in := &Lexicon{
+		Language: "ko",
+		Lexemes: []Lexeme{
+			{Graphemes: []string{"API"}, Aliases: []string{"에이피아이"}},
+			{Graphemes: []string{`(\d+)kg`}, Aliases: []string{"$1킬로그램"}, Match: "regex"},
+		},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, Encode(&buf, in))
+	assert.Contains(t, buf.String(), `xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"`)
+	assert.Contains(t, buf.String(), `xml:lang="ko"`)
+
+	out, err := Decode(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "ko", out.Language)
+	assert.Equal(t, in.Lexemes, out.Lexemes)
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	_, err := Decode(strings.NewReader(`<lexicon version="1.0"><lexeme><alias>x</alias></lexeme></lexicon>`))
+	assert.Error(t, err)
+
+	_, err = Decode(strings.NewReader(`not xml`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,123 @@
+// Package sentence는 ko/en/ja 텍스트를 문장 단위로 나누고 합성 단위로 묶습니다.
+package sentence
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SplitRaw는 문장 부호와 줄바꿈 뒤에서 텍스트를 나눕니다. 공백을 포함해 원문을 그대로 보존하므로
+// 결과를 이어 붙이면 입력과 같습니다. 마침표는 뒤에 공백이 올 때만 문장 끝으로 보므로 "3.14"는 나누지 않습니다.
+func SplitRaw(text string) []string {
+	var out []string
+	runes := []rune(text)
+	start := 0
+	for i, r := range runes {
+		end := false
+		switch r {
+		case '。', '！', '？', '\n':
+			end = true
+		case '.', '!', '?', '…':
+			end = i+1 == len(runes) || unicode.IsSpace(runes[i+1])
+		}
+		if end {
+			out = append(out, string(runes[start:i+1]))
+			start = i + 1
+		}
+	}
+	if start < len(runes) {
+		out = append(out, string(runes[start:]))
+	}
+	return out
+}
+
+// Split은 텍스트를 앞뒤 공백을 제거한 문장 목록으로 나눕니다. 빈 문장은 제외합니다.
+func Split(text string) []string {
+	var out []string
+	for _, s := range SplitRaw(text) {
+		if s = strings.TrimSpace(s); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Chunk는 텍스트를 maxRunes 이하의 합성 단위로 묶습니다. 문단(빈 줄) 경계는 넘지 않고,
+// 한 문장이 maxRunes보다 길면 쉼표, 공백 순으로 나눌 위치를 찾습니다.
+func Chunk(text string, maxRunes int) []string {
+	var chunks []string
+	for _, paragraph := range paragraphs(text) {
+		var current []rune
+		flush := func() {
+			if s := strings.TrimSpace(string(current)); s != "" {
+				chunks = append(chunks, s)
+			}
+			current = current[:0]
+		}
+
+		for _, s := range Split(paragraph) {
+			for _, piece := range splitLong([]rune(s), maxRunes) {
+				if len(current) > 0 && len(current)+1+len(piece) > maxRunes {
+					flush()
+				}
+				if len(current) > 0 {
+					current = append(current, ' ')
+				}
+				current = append(current, piece...)
+			}
+		}
+		flush()
+	}
+	return chunks
+}
+
+// paragraphs는 빈 줄로 구분된 문단을 반환합니다. 문단 안의 줄바꿈은 공백으로 바꿉니다.
+func paragraphs(text string) []string {
+	var out []string
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(lines) > 0 {
+				out = append(out, strings.Join(lines, " "))
+				lines = nil
+			}
+			continue
+		}
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	if len(lines) > 0 {
+		out = append(out, strings.Join(lines, " "))
+	}
+	return out
+}
+
+// splitLong은 maxRunes보다 긴 문장을 쉼표 뒤, 공백, 글자 순으로 나눕니다.
+func splitLong(s []rune, maxRunes int) [][]rune {
+	var out [][]rune
+	for len(s) > maxRunes {
+		cut := lastIndexFunc(s[:maxRunes+1], func(r rune) bool { return strings.ContainsRune(",，、;:", r) })
+		if cut < 0 {
+			cut = lastIndexFunc(s[:maxRunes+1], unicode.IsSpace)
+		} else {
+			cut++ // 쉼표는 앞 조각에 포함
+		}
+		if cut <= 0 {
+			cut = maxRunes
+		}
+		out = append(out, []rune(strings.TrimSpace(string(s[:cut]))))
+		s = []rune(strings.TrimSpace(string(s[cut:])))
+	}
+	if len(s) > 0 {
+		out = append(out, s)
+	}
+	return out
+}
+
+func lastIndexFunc(s []rune, f func(rune) bool) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if f(s[i]) {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,56 @@
+package sentence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"english", "Hello there. How are you? Fine!", []string{"Hello there.", "How are you?", "Fine!"}},
+		{"korean", "안녕하세요. 반갑습니다!", []string{"안녕하세요.", "반갑습니다!"}},
+		{"japanese without spaces", "こんにちは。元気ですか？はい。", []string{"こんにちは。", "元気ですか？", "はい。"}},
+		{"decimal is not a boundary", "원주율은 3.14입니다.", []string{"원주율은 3.14입니다."}},
+		{"newlines", "첫 줄\n\n둘째 줄", []string{"첫 줄", "둘째 줄"}},
+		{"no terminator", "no terminator", []string{"no terminator"}},
+		{"empty", "  ", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Split(tt.text))
+		})
+	}
+}
+
+func TestSplitRaw_PreservesText(t *testing.T) {
+	text := " 안녕하세요.  Hello there!\nこんにちは。 3.14 "
+	assert.Equal(t, text, strings.Join(SplitRaw(text), ""))
+}
+
+func TestChunk(t *testing.T) {
+	text := "첫 문장입니다. 둘째 문장입니다. 셋째 문장입니다.\n\n새 문단입니다."
+	assert.Equal(t, []string{
+		"첫 문장입니다. 둘째 문장입니다.",
+		"셋째 문장입니다.",
+		"새 문단입니다.",
+	}, Chunk(text, 20))
+
+	// 문단 안의 줄바꿈은 공백으로 이어짐
+	assert.Equal(t, []string{"one two. three."}, Chunk("one\ntwo. three.", 100))
+}
+
+func TestChunk_SplitsLongSentences(t *testing.T) {
+	assert.Equal(t, []string{"alpha beta,", "gamma delta", "epsilon"}, Chunk("alpha beta, gamma delta epsilon", 12))
+	assert.Equal(t, []string{"abcde", "fghij", "k"}, Chunk("abcdefghijk", 5))
+
+	for _, chunk := range Chunk(strings.Repeat("가나다 라마바, ", 50), 30) {
+		assert.LessOrEqual(t, len([]rune(chunk)), 30)
+	}
+}
@@ -0,0 +1,415 @@
+// Package ssml은 TTS 요청에 사용하는 SSML 하위 집합을 파싱합니다.
+//
+// 지원 요소: <speak>, <break time|strength>, <prosody rate|pitch>, <voice name>,
+// <say-as interpret-as="characters|digits|date" format>. 그 밖의 요소는 태그만 무시하고 내용은 유지합니다.
+package ssml
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// MaxBreak는 <break> 하나가 만들 수 있는 최대 무음 길이입니다.
+const MaxBreak = 10 * time.Second
+
+// Segment는 같은 음성/운율 설정으로 합성할 텍스트 조각 또는 무음 구간입니다.
+type Segment struct {
+	Text  string        // 합성할 텍스트 (무음 구간이면 빈 문자열)
+	Break time.Duration // 무음 길이
+	Voice string        // <voice name>으로 지정된 음성 (없으면 빈 문자열)
+	Rate  float64       // 속도 배율 (1 = 기본)
+	Pitch float64       // 반음 단위 피치 이동
+}
+
+// IsBreak는 무음 구간인지 반환합니다.
+func (s Segment) IsBreak() bool {
+	return s.Text == ""
+}
+
+// IsSSML은 텍스트가 <speak> 문서인지 판단합니다.
+func IsSSML(text string) bool {
+	t := strings.TrimSpace(text)
+	t = strings.TrimPrefix(t, "\ufeff")
+	if strings.HasPrefix(t, "<?xml") {
+		if end := strings.Index(t, "?>"); end >= 0 {
+			t = strings.TrimSpace(t[end+2:])
+		}
+	}
+	return strings.HasPrefix(t, "<speak")
+}
+
+type state struct {
+	voice string
+	rate  float64
+	pitch float64
+}
+
+type parser struct {
+	language string
+	stack    []state
+	segments []Segment
+	text     strings.Builder
+	sayAs    *sayAs
+}
+
+type sayAs struct {
+	interpretAs string
+	format      string
+	text        strings.Builder
+}
+
+// Parse는 SSML 문서를 세그먼트 목록으로 변환합니다. language는 say-as date 읽기 형식에 사용됩니다.
+func Parse(doc, language string) ([]Segment, error) {
+	p := &parser{language: language, stack: []state{{rate: 1}}}
+	dec := xml.NewDecoder(strings.NewReader(doc))
+
+	root := true
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("ssml: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if root {
+				if t.Name.Local != "speak" {
+					return nil, errors.New("ssml: root element must be <speak>")
+				}
+				root = false
+				continue
+			}
+			if err := p.start(t); err != nil {
+				return nil, err
+			}
+		case xml.EndElement:
+			if err := p.end(t); err != nil {
+				return nil, err
+			}
+		case xml.CharData:
+			if p.sayAs != nil {
+				p.sayAs.text.Write(t)
+			} else {
+				p.text.Write(t)
+			}
+		}
+	}
+	if root {
+		return nil, errors.New("ssml: empty document")
+	}
+
+	p.flush()
+	return p.segments, nil
+}
+
+func (p *parser) current() state {
+	return p.stack[len(p.stack)-1]
+}
+
+func (p *parser) start(el xml.StartElement) error {
+	switch el.Name.Local {
+	case "break":
+		d, err := breakDuration(attr(el, "time"), attr(el, "strength"))
+		if err != nil {
+			return err
+		}
+		p.flush()
+		if d > 0 {
+			p.segments = append(p.segments, Segment{Break: d})
+		}
+	case "prosody":
+		next := p.current()
+		if v := attr(el, "rate"); v != "" {
+			rate, err := parseRate(v)
+			if err != nil {
+				return err
+			}
+			next.rate *= rate
+		}
+		if v := attr(el, "pitch"); v != "" {
+			pitch, err := parsePitch(v)
+			if err != nil {
+				return err
+			}
+			next.pitch += pitch
+		}
+		p.push(next)
+	case "voice":
+		next := p.current()
+		if name := attr(el, "name"); name != "" {
+			next.voice = name
+		}
+		p.push(next)
+	case "say-as":
+		if p.sayAs != nil {
+			return errors.New("ssml: nested <say-as> is not supported")
+		}
+		p.sayAs = &sayAs{interpretAs: attr(el, "interpret-as"), format: attr(el, "format")}
+	}
+	return nil
+}
+
+func (p *parser) end(el xml.EndElement) error {
+	switch el.Name.Local {
+	case "prosody", "voice":
+		p.flush()
+		p.stack = p.stack[:len(p.stack)-1]
+	case "say-as":
+		spoken, err := interpret(p.sayAs.interpretAs, p.sayAs.format, p.sayAs.text.String(), p.language)
+		if err != nil {
+			return err
+		}
+		p.text.WriteString(" " + spoken + " ")
+		p.sayAs = nil
+	}
+	return nil
+}
+
+func (p *parser) push(s state) {
+	p.flush()
+	p.stack = append(p.stack, s)
+}
+
+// flush는 누적된 텍스트를 현재 설정의 세그먼트로 내보냅니다.
+func (p *parser) flush() {
+	text := strings.Join(strings.Fields(p.text.String()), " ")
+	p.text.Reset()
+	if text == "" {
+		return
+	}
+
+	s := p.current()
+	if n := len(p.segments); n > 0 {
+		last := &p.segments[n-1]
+		if !last.IsBreak() && last.Voice == s.voice && last.Rate == s.rate && last.Pitch == s.pitch {
+			last.Text += " " + text
+			return
+		}
+	}
+	p.segments = append(p.segments, Segment{Text: text, Voice: s.voice, Rate: s.rate, Pitch: s.pitch})
+}
+
+func attr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return strings.TrimSpace(a.Value)
+		}
+	}
+	return ""
+}
+
+var breakStrengths = map[string]time.Duration{
+	"none":     0,
+	"x-weak":   100 * time.Millisecond,
+	"weak":     250 * time.Millisecond,
+	"medium":   500 * time.Millisecond,
+	"strong":   750 * time.Millisecond,
+	"x-strong": time.Second,
+}
+
+func breakDuration(timeAttr, strength string) (time.Duration, error) {
+	if timeAttr == "" {
+		if strength == "" {
+			return breakStrengths["medium"], nil
+		}
+		d, ok := breakStrengths[strength]
+		if !ok {
+			return 0, fmt.Errorf("ssml: invalid break strength %q", strength)
+		}
+		return d, nil
+	}
+
+	var d time.Duration
+	switch {
+	case strings.HasSuffix(timeAttr, "ms"):
+		v, err := strconv.ParseFloat(strings.TrimSuffix(timeAttr, "ms"), 64)
+		if err != nil || v < 0 {
+			return 0, fmt.Errorf("ssml: invalid break time %q", timeAttr)
+		}
+		d = time.Duration(v * float64(time.Millisecond))
+	case strings.HasSuffix(timeAttr, "s"):
+		v, err := strconv.ParseFloat(strings.TrimSuffix(timeAttr, "s"), 64)
+		if err != nil || v < 0 {
+			return 0, fmt.Errorf("ssml: invalid break time %q", timeAttr)
+		}
+		d = time.Duration(v * float64(time.Second))
+	default:
+		return 0, fmt.Errorf("ssml: invalid break time %q", timeAttr)
+	}
+
+	if d > MaxBreak {
+		d = MaxBreak
+	}
+	return d, nil
+}
+
+var rateKeywords = map[string]float64{
+	"x-slow":  0.6,
+	"slow":    0.8,
+	"medium":  1,
+	"default": 1,
+	"fast":    1.25,
+	"x-fast":  1.5,
+}
+
+func parseRate(v string) (float64, error) {
+	if r, ok := rateKeywords[v]; ok {
+		return r, nil
+	}
+	if strings.HasSuffix(v, "%") {
+		pct, err := strconv.ParseFloat(strings.TrimSuffix(v, "%"), 64)
+		if err != nil || pct <= 0 {
+			return 0, fmt.Errorf("ssml: invalid prosody rate %q", v)
+		}
+		return pct / 100, nil
+	}
+	r, err := strconv.ParseFloat(v, 64)
+	if err != nil || r <= 0 {
+		return 0, fmt.Errorf("ssml: invalid prosody rate %q", v)
+	}
+	return r, nil
+}
+
+var pitchKeywords = map[string]float64{
+	"x-low":   -4,
+	"low":     -2,
+	"medium":  0,
+	"default": 0,
+	"high":    2,
+	"x-high":  4,
+}
+
+// parsePitch는 피치 값을 반음 단위로 변환합니다. "+2st", "-10%", "high" 형식을 지원합니다.
+func parsePitch(v string) (float64, error) {
+	if p, ok := pitchKeywords[v]; ok {
+		return p, nil
+	}
+	switch {
+	case strings.HasSuffix(v, "st"):
+		st, err := strconv.ParseFloat(strings.TrimSuffix(v, "st"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("ssml: invalid prosody pitch %q", v)
+		}
+		return st, nil
+	case strings.HasSuffix(v, "%"):
+		pct, err := strconv.ParseFloat(strings.TrimSuffix(v, "%"), 64)
+		if err != nil || pct <= -100 {
+			return 0, fmt.Errorf("ssml: invalid prosody pitch %q", v)
+		}
+		return 12 * math.Log2(1+pct/100), nil
+	}
+	return 0, fmt.Errorf("ssml: unsupported prosody pitch %q", v)
+}
+
+func interpret(interpretAs, format, text, language string) (string, error) {
+	text = strings.TrimSpace(text)
+	switch interpretAs {
+	case "characters", "spell-out":
+		var chars []string
+		for _, r := range text {
+			if !unicode.IsSpace(r) {
+				chars = append(chars, string(r))
+			}
+		}
+		return strings.Join(chars, " "), nil
+	case "digits":
+		var digits []string
+		for _, r := range text {
+			if unicode.IsDigit(r) {
+				digits = append(digits, string(r))
+			}
+		}
+		return strings.Join(digits, " "), nil
+	case "date":
+		return speakDate(text, format, language)
+	case "":
+		return text, nil
+	}
+	return "", fmt.Errorf("ssml: unsupported say-as interpret-as %q", interpretAs)
+}
+
+var monthNames = []string{"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December"}
+
+func speakDate(text, format, language string) (string, error) {
+	if format == "" {
+		format = "ymd"
+	}
+	parts := strings.FieldsFunc(text, func(r rune) bool { return r == '-' || r == '/' || r == '.' })
+	if len(parts) != len(format) {
+		return "", fmt.Errorf("ssml: date %q does not match format %q", text, format)
+	}
+
+	var year, month, day int
+	for i, f := range format {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return "", fmt.Errorf("ssml: invalid date %q", text)
+		}
+		switch f {
+		case 'y':
+			year = n
+		case 'm':
+			month = n
+		case 'd':
+			day = n
+		default:
+			return "", fmt.Errorf("ssml: invalid date format %q", format)
+		}
+	}
+	if month < 0 || month > 12 || day < 0 || day > 31 || (month == 0 && strings.ContainsRune(format, 'm')) {
+		return "", fmt.Errorf("ssml: invalid date %q", text)
+	}
+
+	var out []string
+	switch language {
+	case "ko":
+		if year > 0 {
+			out = append(out, fmt.Sprintf("%d년", year))
+		}
+		if month > 0 {
+			out = append(out, fmt.Sprintf("%d월", month))
+		}
+		if day > 0 {
+			out = append(out, fmt.Sprintf("%d일", day))
+		}
+		return strings.Join(out, " "), nil
+	case "ja":
+		s := ""
+		if year > 0 {
+			s += fmt.Sprintf("%d年", year)
+		}
+		if month > 0 {
+			s += fmt.Sprintf("%d月", month)
+		}
+		if day > 0 {
+			s += fmt.Sprintf("%d日", day)
+		}
+		return s, nil
+	}
+
+	s := ""
+	if month > 0 {
+		s = monthNames[month-1]
+	}
+	if day > 0 {
+		s = strings.TrimSpace(s + " " + strconv.Itoa(day))
+	}
+	if year > 0 {
+		if s != "" && day > 0 {
+			s += ","
+		}
+		s = strings.TrimSpace(s + " " + strconv.Itoa(year))
+	}
+	return s, nil
+}
@@ -0,0 +1,98 @@
+package ssml
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsSSML(t *testing.T) {
+	assert.True(t, IsSSML("<speak>hi</speak>"))
+	assert.True(t, IsSSML("  <?xml version=\"1.0\"?>\n<speak version=\"1.1\">hi</speak>"))
+	assert.False(t, IsSSML("hello <speak>"))
+	assert.False(t, IsSSML("plain text"))
+}
+
+func TestParse_BreaksAndProsody(t *testing.T) {
+	doc := `<speak>
+		안녕하세요.
+		<break time="500ms"/>
+		<prosody rate="slow" pitch="+2st">천천히 말합니다.
+			<prosody rate="150%">조금 빠르게</prosody>
+		</prosody>
+		<break strength="strong"/>
+		끝.
+	</speak>`
+
+	slow, fast := 0.8, 1.5
+	segments, err := Parse(doc, "ko")
+	assert.NoError(t, err)
+	assert.Equal(t, []Segment{
+		{Text: "안녕하세요.", Rate: 1},
+		{Break: 500 * time.Millisecond},
+		{Text: "천천히 말합니다.", Rate: 0.8, Pitch: 2},
+		{Text: "조금 빠르게", Rate: slow * fast, Pitch: 2},
+		{Break: 750 * time.Millisecond},
+		{Text: "끝.", Rate: 1},
+	}, segments)
+}
+
+func TestParse_VoiceAndMerge(t *testing.T) {
+	doc := `<speak>Hello <voice name="narrator">I am the narrator.</voice> back <emphasis>again</emphasis></speak>`
+
+	segments, err := Parse(doc, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, []Segment{
+		{Text: "Hello", Rate: 1},
+		{Text: "I am the narrator.", Voice: "narrator", Rate: 1},
+		{Text: "back again", Rate: 1},
+	}, segments)
+}
+
+func TestParse_SayAs(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		language string
+		want     string
+	}{
+		{"characters", `<speak><say-as interpret-as="characters">API</say-as></speak>`, "en", "A P I"},
+		{"digits", `<speak>번호 <say-as interpret-as="digits">010-1234</say-as></speak>`, "ko", "번호 0 1 0 1 2 3 4"},
+		{"date ko", `<speak><say-as interpret-as="date">2026-10-18</say-as></speak>`, "ko", "2026년 10월 18일"},
+		{"date en mdy", `<speak><say-as interpret-as="date" format="mdy">10/18/2026</say-as></speak>`, "en", "October 18, 2026"},
+		{"date ja", `<speak><say-as interpret-as="date" format="ym">2026.10</say-as></speak>`, "ja", "2026年10月"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segments, err := Parse(tt.doc, tt.language)
+			assert.NoError(t, err)
+			assert.Len(t, segments, 1)
+			assert.Equal(t, tt.want, segments[0].Text)
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []string{
+		`<p>not speak</p>`,
+		`<speak><break time="abc"/></speak>`,
+		`<speak><prosody rate="-1">x</prosody></speak>`,
+		`<speak><prosody pitch="+20Hz">x</prosody></speak>`,
+		`<speak><say-as interpret-as="cardinal">1</say-as></speak>`,
+		`<speak><say-as interpret-as="date">2026-13-01</say-as></speak>`,
+		`<speak>unterminated`,
+	}
+
+	for _, doc := range tests {
+		_, err := Parse(doc, "en")
+		assert.Error(t, err, doc)
+	}
+}
+
+func TestParse_BreakCapped(t *testing.T) {
+	segments, err := Parse(`<speak><break time="60s"/></speak>`, "en")
+	assert.NoError(t, err)
+	assert.Equal(t, []Segment{{Break: MaxBreak}}, segments)
+}
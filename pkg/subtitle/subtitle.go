@@ -0,0 +1,45 @@
+// Package subtitle은 자막 큐를 SRT, WebVTT 형식으로 직렬화합니다.
+package subtitle
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Cue는 화면에 한 번에 표시되는 자막 한 줄(또는 여러 줄)입니다.
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Text  string
+}
+
+// EncodeSRT는 큐 목록을 SubRip(.srt) 형식으로 직렬화합니다.
+func EncodeSRT(cues []Cue) []byte {
+	var b strings.Builder
+	for i, c := range cues {
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", i+1, timestamp(c.Start, ','), timestamp(c.End, ','), c.Text)
+	}
+	return []byte(b.String())
+}
+
+// EncodeVTT는 큐 목록을 WebVTT(.vtt) 형식으로 직렬화합니다.
+func EncodeVTT(cues []Cue) []byte {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n\n")
+	for i, c := range cues {
+		// 빈 줄과 "-->"는 큐 본문에 올 수 없음
+		text := strings.ReplaceAll(c.Text, "-->", "->")
+		fmt.Fprintf(&b, "%d\n%s --> %s\n%s\n\n", i+1, timestamp(c.Start, '.'), timestamp(c.End, '.'), text)
+	}
+	return []byte(b.String())
+}
+
+// timestamp는 "HH:MM:SS,mmm"(SRT) 또는 "HH:MM:SS.mmm"(WebVTT) 형식의 시각을 반환합니다.
+func timestamp(d time.Duration, sep byte) string {
+	if d < 0 {
+		d = 0
+	}
+	ms := d.Round(time.Millisecond).Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%c%03d", ms/3600000, ms/60000%60, ms/1000%60, sep, ms%1000)
+}
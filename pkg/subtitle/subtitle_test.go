@@ -0,0 +1,29 @@
+package subtitle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var cues = []Cue{
+	{Start: 0, End: 1500 * time.Millisecond, Text: "안녕하세요."},
+	{Start: 1500 * time.Millisecond, End: time.Hour + 2*time.Minute + 3*time.Second + 4*time.Millisecond, Text: "a --> b"},
+}
+
+func TestEncodeSRT(t *testing.T) {
+	assert.Equal(t, "1\n00:00:00,000 --> 00:00:01,500\n안녕하세요.\n\n"+
+		"2\n00:00:01,500 --> 01:02:03,004\na --> b\n\n", string(EncodeSRT(cues)))
+}
+
+func TestEncodeVTT(t *testing.T) {
+	assert.Equal(t, "WEBVTT\n\n"+
+		"1\n00:00:00.000 --> 00:00:01.500\n안녕하세요.\n\n"+
+		"2\n00:00:01.500 --> 01:02:03.004\na -> b\n\n", string(EncodeVTT(cues)))
+}
+
+func TestEncode_Empty(t *testing.T) {
+	assert.Equal(t, "", string(EncodeSRT(nil)))
+	assert.Equal(t, "WEBVTT\n\n", string(EncodeVTT(nil)))
+}
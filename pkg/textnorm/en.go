@@ -0,0 +1,339 @@
+package textnorm
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	enOnes = []string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen"}
+	enTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	enScales = []string{"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion"}
+	enMonths = []string{"January", "February", "March", "April", "May", "June",
+		"July", "August", "September", "October", "November", "December"}
+)
+
+// cardinal은 정수를 영어 기수로 읽습니다. (2026 → "two thousand twenty-six")
+func cardinal(n int64) string {
+	if n == 0 {
+		return "zero"
+	}
+	if n < 0 {
+		return "minus " + cardinal(-n)
+	}
+
+	var parts []string
+	for i := 0; n > 0; i++ {
+		g := n % 1000
+		n /= 1000
+		if g == 0 {
+			continue
+		}
+		s := below1000(int(g))
+		if enScales[i] != "" {
+			s += " " + enScales[i]
+		}
+		parts = append([]string{s}, parts...)
+	}
+	return strings.Join(parts, " ")
+}
+
+func below1000(n int) string {
+	var parts []string
+	if n >= 100 {
+		parts = append(parts, enOnes[n/100]+" hundred")
+		n %= 100
+	}
+	if n > 0 {
+		parts = append(parts, below100(n))
+	}
+	return strings.Join(parts, " ")
+}
+
+func below100(n int) string {
+	if n < 20 {
+		return enOnes[n]
+	}
+	if n%10 == 0 {
+		return enTens[n/10]
+	}
+	return enTens[n/10] + "-" + enOnes[n%10]
+}
+
+var enOrdinalExceptions = map[string]string{
+	"one": "first", "two": "second", "three": "third", "five": "fifth",
+	"eight": "eighth", "nine": "ninth", "twelve": "twelfth",
+}
+
+// ordinal은 정수를 영어 서수로 읽습니다. (21 → "twenty-first")
+func ordinal(n int64) string {
+	words := cardinal(n)
+	i := strings.LastIndexAny(words, " -")
+	head, last := words[:i+1], words[i+1:]
+
+	if o, ok := enOrdinalExceptions[last]; ok {
+		return head + o
+	}
+	if strings.HasSuffix(last, "y") {
+		return head + strings.TrimSuffix(last, "y") + "ieth"
+	}
+	return head + last + "th"
+}
+
+// year는 연도를 관용적으로 읽습니다. (1999 → "nineteen ninety-nine", 2005 → "two thousand five", 2026 → "twenty twenty-six")
+func year(n int64) string {
+	if n < 1000 || n > 9999 || (n >= 2000 && n < 2010) {
+		return cardinal(n)
+	}
+	hi, lo := int(n/100), int(n%100)
+	switch {
+	case lo == 0:
+		return below100(hi) + " hundred"
+	case lo < 10:
+		return below100(hi) + " oh " + enOnes[lo]
+	}
+	return below100(hi) + " " + below100(lo)
+}
+
+func enDigitString(s string) string {
+	var words []string
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			words = append(words, enOnes[r-'0'])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// readEnNumber는 "1,234.5" 형식의 숫자를 읽습니다.
+func readEnNumber(s string) string {
+	n, frac, ok := number(s)
+	if !ok {
+		return s
+	}
+	out := cardinal(n)
+	if frac != "" {
+		out += " point " + enDigitString(frac)
+	}
+	return out
+}
+
+func isOne(s string) bool {
+	n, frac, ok := number(s)
+	return ok && n == 1 && strings.Trim(frac, "0") == ""
+}
+
+func enDate(y, m, d int) string {
+	return enMonths[m-1] + " " + ordinal(int64(d)) + ", " + year(int64(y))
+}
+
+type enUnit struct {
+	singular, plural string
+}
+
+var enUnits = map[string]enUnit{
+	"km/h": {"kilometer per hour", "kilometers per hour"},
+	"mph":  {"mile per hour", "miles per hour"},
+	"kWh":  {"kilowatt hour", "kilowatt hours"},
+	"kHz":  {"kilohertz", "kilohertz"},
+	"MHz":  {"megahertz", "megahertz"},
+	"GHz":  {"gigahertz", "gigahertz"},
+	"Hz":   {"hertz", "hertz"},
+	"km":   {"kilometer", "kilometers"},
+	"kg":   {"kilogram", "kilograms"},
+	"mg":   {"milligram", "milligrams"},
+	"cm":   {"centimeter", "centimeters"},
+	"mm":   {"millimeter", "millimeters"},
+	"ml":   {"milliliter", "milliliters"},
+	"mL":   {"milliliter", "milliliters"},
+	"lbs":  {"pound", "pounds"},
+	"lb":   {"pound", "pounds"},
+	"oz":   {"ounce", "ounces"},
+	"ft":   {"foot", "feet"},
+	"mi":   {"mile", "miles"},
+	"TB":   {"terabyte", "terabytes"},
+	"GB":   {"gigabyte", "gigabytes"},
+	"MB":   {"megabyte", "megabytes"},
+	"KB":   {"kilobyte", "kilobytes"},
+	"°C":   {"degree Celsius", "degrees Celsius"},
+	"℃":    {"degree Celsius", "degrees Celsius"},
+	"°F":   {"degree Fahrenheit", "degrees Fahrenheit"},
+	"℉":    {"degree Fahrenheit", "degrees Fahrenheit"},
+	"m":    {"meter", "meters"},
+	"g":    {"gram", "grams"},
+	"L":    {"liter", "liters"},
+}
+
+type enCurrency struct {
+	singular, plural, centSingular, centPlural string
+}
+
+var enCurrencies = map[string]enCurrency{
+	"$": {"dollar", "dollars", "cent", "cents"},
+	"€": {"euro", "euros", "cent", "cents"},
+	"£": {"pound", "pounds", "penny", "pence"},
+	"¥": {"yen", "yen", "", ""},
+	"₩": {"won", "won", "", ""},
+}
+
+var enAbbreviations = map[string]string{
+	"Dr.":     "Doctor",
+	"Mr.":     "Mister",
+	"Mrs.":    "Missus",
+	"Ms.":     "Miz",
+	"Prof.":   "Professor",
+	"etc.":    "et cetera",
+	"e.g.":    "for example",
+	"i.e.":    "that is",
+	"vs.":     "versus",
+	"vs":      "versus",
+	"approx.": "approximately",
+}
+
+var enRules = []rule{
+	// ISO 날짜: 2026-10-18 → October eighteenth, twenty twenty-six
+	{regexp.MustCompile(`(\d{4})-(\d{1,2})-(\d{1,2})`), func(text string, m []int) (string, bool) {
+		y, _ := strconv.Atoi(group(text, m, 1))
+		mo, _ := strconv.Atoi(group(text, m, 2))
+		d, _ := strconv.Atoi(group(text, m, 3))
+		if mo < 1 || mo > 12 || d < 1 || d > 31 || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		return enDate(y, mo, d), true
+	}},
+	// 미국식 날짜: 10/18/2026
+	{regexp.MustCompile(`(\d{1,2})/(\d{1,2})/(\d{4})`), func(text string, m []int) (string, bool) {
+		mo, _ := strconv.Atoi(group(text, m, 1))
+		d, _ := strconv.Atoi(group(text, m, 2))
+		y, _ := strconv.Atoi(group(text, m, 3))
+		if mo < 1 || mo > 12 || d < 1 || d > 31 || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		return enDate(y, mo, d), true
+	}},
+	// 월 이름 + 일(+ 연도): October 18th, 2026
+	{regexp.MustCompile(`\b(` + strings.Join(enMonths, "|") + `)\s+(\d{1,2})(?:st|nd|rd|th)?(?:,\s*(\d{4}))?\b`), func(text string, m []int) (string, bool) {
+		d, _ := strconv.Atoi(group(text, m, 2))
+		if d < 1 || d > 31 {
+			return "", false
+		}
+		out := group(text, m, 1) + " " + ordinal(int64(d))
+		if y := group(text, m, 3); y != "" {
+			n, _ := strconv.Atoi(y)
+			out += ", " + year(int64(n))
+		}
+		return out, true
+	}},
+	// 시각: 3:30 PM → three thirty PM, 9:05 → nine oh five, 7:00 → seven o'clock
+	{regexp.MustCompile(`(\d{1,2}):(\d{2})(?:\s?([AaPp])\.?[Mm]\.?)?`), func(text string, m []int) (string, bool) {
+		h, _ := strconv.Atoi(group(text, m, 1))
+		mi, _ := strconv.Atoi(group(text, m, 2))
+		if h > 24 || mi > 59 || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		out := cardinal(int64(h))
+		switch {
+		case mi == 0 && group(text, m, 3) == "":
+			out += " o'clock"
+		case mi == 0:
+		case mi < 10:
+			out += " oh " + enOnes[mi]
+		default:
+			out += " " + below100(mi)
+		}
+		if p := group(text, m, 3); p != "" {
+			out += " " + strings.ToUpper(p) + "M"
+		}
+		return out, true
+	}},
+	// 통화: $1,234.50 → one thousand two hundred thirty-four dollars and fifty cents, $5 million → five million dollars
+	{regexp.MustCompile(`([$€£¥₩])\s?(\d{1,3}(?:,\d{3})+|\d+)(?:\.(\d{1,2}))?(?:\s(thousand|million|billion|trillion)\b)?`), func(text string, m []int) (string, bool) {
+		c := enCurrencies[group(text, m, 1)]
+		whole, cents, scale := group(text, m, 2), group(text, m, 3), group(text, m, 4)
+
+		if scale != "" {
+			amount := whole
+			if cents != "" {
+				amount += "." + cents
+			}
+			return readEnNumber(amount) + " " + scale + " " + c.plural, true
+		}
+		if cents != "" && c.centPlural == "" {
+			return readEnNumber(whole+"."+cents) + " " + c.plural, true
+		}
+
+		out := readEnNumber(whole) + " " + c.plural
+		if isOne(whole) {
+			out = "one " + c.singular
+		}
+		if cents != "" {
+			if len(cents) == 1 {
+				cents += "0"
+			}
+			n, _ := strconv.Atoi(cents)
+			if n == 0 {
+				return out, true
+			}
+			unit := c.centPlural
+			if n == 1 {
+				unit = c.centSingular
+			}
+			out += " and " + cardinal(int64(n)) + " " + unit
+		}
+		return out, true
+	}},
+	// 백분율
+	{regexp.MustCompile(`(` + numberPattern + `)\s?%`), func(text string, m []int) (string, bool) {
+		return readEnNumber(group(text, m, 1)) + " percent", true
+	}},
+	// 서수: 21st → twenty-first
+	{regexp.MustCompile(`(\d+)(?:st|nd|rd|th)\b`), func(text string, m []int) (string, bool) {
+		n, err := strconv.ParseInt(group(text, m, 1), 10, 64)
+		if err != nil || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		return ordinal(n), true
+	}},
+	// 단위: 3kg → three kilograms, 1 km → one kilometer
+	{regexp.MustCompile(`(` + numberPattern + `)\s?(` + alternation(keys(enUnits)) + `)`), func(text string, m []int) (string, bool) {
+		if followedByLetter(text, m[1]) || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		n, u := group(text, m, 1), enUnits[group(text, m, 2)]
+		if isOne(n) && !strings.Contains(n, ".") {
+			return readEnNumber(n) + " " + u.singular, true
+		}
+		return readEnNumber(n) + " " + u.plural, true
+	}},
+	// 약어: No. 5 → number 5
+	{regexp.MustCompile(`\bNo\.\s?(\d)`), func(text string, m []int) (string, bool) {
+		return "number " + group(text, m, 1), true
+	}},
+	{regexp.MustCompile(`(?:` + alternation(keys(enAbbreviations)) + `)`), func(text string, m []int) (string, bool) {
+		if precededByWordChar(text, m[0]) || followedByLetter(text, m[1]) {
+			return "", false
+		}
+		return enAbbreviations[text[m[0]:m[1]]], true
+	}},
+	// 연도로 읽는 문맥: in 1999 → in nineteen ninety-nine
+	{regexp.MustCompile(`\b(in|since|by|from|until|year|of)\s+(\d{4})\b`), func(text string, m []int) (string, bool) {
+		n, _ := strconv.ParseInt(group(text, m, 2), 10, 64)
+		if n < 1100 || n > 2099 {
+			return "", false
+		}
+		return group(text, m, 1) + " " + year(n), true
+	}},
+	// 음수
+	{regexp.MustCompile(`(^|[\s(])-(` + numberPattern + `)`), func(text string, m []int) (string, bool) {
+		return group(text, m, 1) + "minus " + readEnNumber(group(text, m, 2)), true
+	}},
+	// 남은 숫자
+	{regexp.MustCompile(numberPattern), func(text string, m []int) (string, bool) {
+		if precededByWordChar(text, m[0]) || followedByLetter(text, m[1]) {
+			return "", false
+		}
+		return readEnNumber(text[m[0]:m[1]]), true
+	}},
+}
@@ -0,0 +1,88 @@
+package textnorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize_English(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"cardinal", "I have 42 apples", "I have forty-two apples"},
+		{"grouped", "1,234,567", "one million two hundred thirty-four thousand five hundred sixty-seven"},
+		{"decimal", "pi is 3.14", "pi is three point one four"},
+		{"negative", "it is -5 outside", "it is minus five outside"},
+		{"iso date", "Due 2026-10-18.", "Due October eighteenth, twenty twenty-six."},
+		{"us date", "10/18/2026", "October eighteenth, twenty twenty-six"},
+		{"month day year", "October 18th, 2026", "October eighteenth, twenty twenty-six"},
+		{"month day", "on May 1", "on May first"},
+		{"time pm", "at 3:30 PM", "at three thirty PM"},
+		{"time oh", "9:05", "nine oh five"},
+		{"time oclock", "7:00", "seven o'clock"},
+		{"time dotted am", "7:00 a.m.", "seven AM"},
+		{"dollars and cents", "$1,234.50", "one thousand two hundred thirty-four dollars and fifty cents"},
+		{"one dollar", "$1", "one dollar"},
+		{"scaled amount", "$5 million", "five million dollars"},
+		{"euro cent", "€2.01", "two euros and one cent"},
+		{"pence", "£3.5", "three pounds and fifty pence"},
+		{"yen has no cents", "¥500", "five hundred yen"},
+		{"percent", "30% off", "thirty percent off"},
+		{"ordinal", "the 21st century", "the twenty-first century"},
+		{"ordinal twelfth", "12th", "twelfth"},
+		{"unit plural", "3kg", "three kilograms"},
+		{"unit singular", "1 km", "one kilometer"},
+		{"unit decimal plural", "1.5 kg", "one point five kilograms"},
+		{"irregular plural", "6 ft", "six feet"},
+		{"temperature", "25°C", "twenty-five degrees Celsius"},
+		{"unit not matched inside word", "5 minutes", "five minutes"},
+		{"doctor", "Dr. Smith", "Doctor Smith"},
+		{"for example", "e.g. this", "for example this"},
+		{"versus", "cats vs. dogs", "cats versus dogs"},
+		{"number sign", "No. 5", "number five"},
+		{"year context", "in 1999", "in nineteen ninety-nine"},
+		{"year 2000s", "since 2005", "since two thousand five"},
+		{"year hundred", "by 1900", "by nineteen hundred"},
+		{"alnum untouched", "MP3 player", "MP3 player"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Normalize(tt.in, "en"))
+		})
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	tests := map[int64]string{
+		1:    "first",
+		2:    "second",
+		3:    "third",
+		5:    "fifth",
+		9:    "ninth",
+		11:   "eleventh",
+		20:   "twentieth",
+		23:   "twenty-third",
+		100:  "one hundredth",
+		1001: "one thousand first",
+	}
+	for n, want := range tests {
+		assert.Equal(t, want, ordinal(n), n)
+	}
+}
+
+func TestYear(t *testing.T) {
+	tests := map[int64]string{
+		1066: "ten sixty-six",
+		1905: "nineteen oh five",
+		2000: "two thousand",
+		2010: "twenty ten",
+		2026: "twenty twenty-six",
+	}
+	for n, want := range tests {
+		assert.Equal(t, want, year(n), n)
+	}
+}
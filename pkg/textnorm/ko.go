@@ -0,0 +1,300 @@
+package textnorm
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	koDigits         = []string{"영", "일", "이", "삼", "사", "오", "육", "칠", "팔", "구"}
+	koNativeOnes     = []string{"", "하나", "둘", "셋", "넷", "다섯", "여섯", "일곱", "여덟", "아홉"}
+	koNativeOnesAttr = []string{"", "한", "두", "세", "네", "다섯", "여섯", "일곱", "여덟", "아홉"}
+	koNativeTens     = []string{"", "열", "스물", "서른", "마흔", "쉰", "예순", "일흔", "여든", "아흔"}
+	koLargeUnits     = []string{"", "만", "억", "조", "경"}
+)
+
+// sinoKorean은 정수를 한자어 수사로 읽습니다. 만 단위로 띄어 씁니다. (12345 → "만 이천삼백사십오")
+func sinoKorean(n int64) string {
+	if n == 0 {
+		return "영"
+	}
+	if n < 0 {
+		return "마이너스 " + sinoKorean(-n)
+	}
+
+	var parts []string
+	for i := 0; n > 0 && i < len(koLargeUnits); i++ {
+		g := n % 10000
+		n /= 10000
+		if g == 0 {
+			continue
+		}
+		s := sinoGroup(g)
+		if i == 1 && g == 1 {
+			s = "" // 10000은 "일만"이 아니라 "만"
+		}
+		parts = append([]string{s + koLargeUnits[i]}, parts...)
+	}
+	return strings.Join(parts, " ")
+}
+
+// sinoGroup은 1~9999를 읽습니다. 십, 백, 천 앞의 "일"은 생략합니다.
+func sinoGroup(g int64) string {
+	var b strings.Builder
+	for _, u := range []struct {
+		value int64
+		name  string
+	}{{1000, "천"}, {100, "백"}, {10, "십"}} {
+		d := g / u.value
+		g %= u.value
+		if d == 0 {
+			continue
+		}
+		if d > 1 {
+			b.WriteString(koDigits[d])
+		}
+		b.WriteString(u.name)
+	}
+	if g > 0 {
+		b.WriteString(koDigits[g])
+	}
+	return b.String()
+}
+
+// nativeKorean은 1~99를 고유어 수사로 읽습니다. attributive이면 단위 명사 앞 관형형(한, 두, 세, 네, 스무)을 씁니다.
+func nativeKorean(n int, attributive bool) string {
+	tens, ones := n/10, n%10
+	t := koNativeTens[tens]
+	if attributive && tens == 2 && ones == 0 {
+		t = "스무"
+	}
+	if attributive {
+		return t + koNativeOnesAttr[ones]
+	}
+	return t + koNativeOnes[ones]
+}
+
+// koDigitString은 숫자를 한 자리씩 읽습니다. zero는 0을 읽는 방식("영" 또는 "공")입니다.
+func koDigitString(s, zero string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			continue
+		}
+		if r == '0' {
+			b.WriteString(zero)
+		} else {
+			b.WriteString(koDigits[r-'0'])
+		}
+	}
+	return b.String()
+}
+
+// readKoNumber는 "1,234.5" 형식의 숫자를 한자어 수사로 읽습니다. 0으로 시작하는 숫자열은 한 자리씩 읽고,
+// 소수부 끝의 0은 읽지 않습니다.
+func readKoNumber(s string) string {
+	if len(s) > 1 && s[0] == '0' && s[1] != '.' {
+		return koDigitString(s, "영")
+	}
+	n, frac, ok := number(s)
+	if !ok {
+		return s
+	}
+	out := sinoKorean(n)
+	if frac = strings.TrimRight(frac, "0"); frac != "" {
+		out += " 점 " + koDigitString(frac, "영")
+	}
+	return out
+}
+
+func koMonth(m int) string {
+	switch m {
+	case 6:
+		return "유월"
+	case 10:
+		return "시월"
+	}
+	return sinoKorean(int64(m)) + "월"
+}
+
+// koHour는 시각의 시를 고유어로 읽습니다. 0시는 "영 시"입니다.
+func koHour(h int) string {
+	if h == 0 {
+		return "영 시"
+	}
+	return nativeKorean(h, true) + " 시"
+}
+
+type koUnit struct {
+	prefix string // 단위가 수 앞에 오는 경우 (시속, 섭씨 등)
+	name   string
+}
+
+var koUnits = map[string]koUnit{
+	"km/h": {"시속 ", "킬로미터"},
+	"kWh":  {"", "킬로와트시"},
+	"kHz":  {"", "킬로헤르츠"},
+	"MHz":  {"", "메가헤르츠"},
+	"GHz":  {"", "기가헤르츠"},
+	"Hz":   {"", "헤르츠"},
+	"km":   {"", "킬로미터"},
+	"kg":   {"", "킬로그램"},
+	"mg":   {"", "밀리그램"},
+	"cm":   {"", "센티미터"},
+	"mm":   {"", "밀리미터"},
+	"ml":   {"", "밀리리터"},
+	"mL":   {"", "밀리리터"},
+	"m²":   {"", "제곱미터"},
+	"㎡":    {"", "제곱미터"},
+	"㎏":    {"", "킬로그램"},
+	"㎞":    {"", "킬로미터"},
+	"㎝":    {"", "센티미터"},
+	"㎜":    {"", "밀리미터"},
+	"TB":   {"", "테라바이트"},
+	"GB":   {"", "기가바이트"},
+	"MB":   {"", "메가바이트"},
+	"KB":   {"", "킬로바이트"},
+	"°C":   {"섭씨 ", "도"},
+	"℃":    {"섭씨 ", "도"},
+	"°F":   {"화씨 ", "도"},
+	"℉":    {"화씨 ", "도"},
+	"m":    {"", "미터"},
+	"g":    {"", "그램"},
+	"L":    {"", "리터"},
+}
+
+// koNativeCounters는 고유어 수사와 함께 쓰는 단위 명사입니다. 100 이상은 한자어로 읽습니다.
+var koNativeCounters = []string{
+	"번째", "시간", "켤레", "송이", "그루", "군데", "마리",
+	"개", "명", "시", "살", "권", "잔", "병", "가지", "장", "대", "척", "채", "벌",
+}
+
+var koCurrencies = map[string]string{"₩": "원", "$": "달러", "€": "유로", "£": "파운드", "¥": "엔"}
+
+var koAbbreviations = map[string]string{"vs": "대", "VS": "대", "etc.": "등", "Tel.": "전화"}
+
+func alternation(words []string) string {
+	sorted := append([]string(nil), words...)
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+	quoted := make([]string, len(sorted))
+	for i, w := range sorted {
+		quoted[i] = regexp.QuoteMeta(w)
+	}
+	return strings.Join(quoted, "|")
+}
+
+func keys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
+
+var koRules = []rule{
+	// 전화번호: 010-1234-5678 → 공일공 일이삼사 오육칠팔
+	{regexp.MustCompile(`0\d{1,2}-\d{3,4}-\d{4}`), func(text string, m []int) (string, bool) {
+		if precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		groups := strings.Split(text[m[0]:m[1]], "-")
+		for i, g := range groups {
+			groups[i] = koDigitString(g, "공")
+		}
+		return strings.Join(groups, " "), true
+	}},
+	// 날짜: 2026-10-18, 2026.10.18, 2026/10/18
+	{regexp.MustCompile(`(\d{4})[-./](\d{1,2})[-./](\d{1,2})`), func(text string, m []int) (string, bool) {
+		y, _ := strconv.Atoi(group(text, m, 1))
+		mo, _ := strconv.Atoi(group(text, m, 2))
+		d, _ := strconv.Atoi(group(text, m, 3))
+		if mo < 1 || mo > 12 || d < 1 || d > 31 || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		return sinoKorean(int64(y)) + " 년 " + koMonth(mo) + " " + sinoKorean(int64(d)) + " 일", true
+	}},
+	// 시각: 오후 3:30, 15:30:05
+	{regexp.MustCompile(`(?:(오전|오후|새벽|아침|저녁|밤)\s*)?(\d{1,2}):(\d{2})(?::(\d{2}))?`), func(text string, m []int) (string, bool) {
+		h, _ := strconv.Atoi(group(text, m, 2))
+		mi, _ := strconv.Atoi(group(text, m, 3))
+		if h > 24 || mi > 59 || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		out := koHour(h)
+		if p := group(text, m, 1); p != "" {
+			out = p + " " + out
+		}
+		if mi > 0 {
+			out += " " + sinoKorean(int64(mi)) + " 분"
+		}
+		if s := group(text, m, 4); s != "" {
+			if sec, _ := strconv.Atoi(s); sec > 0 && sec < 60 {
+				out += " " + sinoKorean(int64(sec)) + " 초"
+			}
+		}
+		return out, true
+	}},
+	// 월: 6월 → 유월, 10월 → 시월
+	{regexp.MustCompile(`(\d{1,2})\s?월`), func(text string, m []int) (string, bool) {
+		mo, _ := strconv.Atoi(group(text, m, 1))
+		if mo < 1 || mo > 12 || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		return koMonth(mo), true
+	}},
+	// 통화 기호: $5 → 오 달러
+	{regexp.MustCompile(`([₩$€£¥])\s?(` + numberPattern + `)`), func(text string, m []int) (string, bool) {
+		return readKoNumber(group(text, m, 2)) + " " + koCurrencies[group(text, m, 1)], true
+	}},
+	// 백분율
+	{regexp.MustCompile(`(` + numberPattern + `)\s?%`), func(text string, m []int) (string, bool) {
+		return readKoNumber(group(text, m, 1)) + " 퍼센트", true
+	}},
+	// 단위: 3kg → 삼 킬로그램, 25°C → 섭씨 이십오 도
+	{regexp.MustCompile(`(` + numberPattern + `)\s?(` + alternation(keys(koUnits)) + `)`), func(text string, m []int) (string, bool) {
+		if followedByLetter(text, m[1]) || precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		u := koUnits[group(text, m, 2)]
+		return u.prefix + readKoNumber(group(text, m, 1)) + " " + u.name, true
+	}},
+	// 개월은 한자어: 3개월 → 삼 개월
+	{regexp.MustCompile(`(\d+)\s?개월`), func(text string, m []int) (string, bool) {
+		return readKoNumber(group(text, m, 1)) + " 개월", true
+	}},
+	// 고유어 단위 명사: 3개 → 세 개, 20살 → 스무 살, 1번째 → 첫 번째
+	{regexp.MustCompile(`(\d+)\s?(` + alternation(koNativeCounters) + `)`), func(text string, m []int) (string, bool) {
+		if precededByWordChar(text, m[0]) {
+			return "", false
+		}
+		digits, counter := group(text, m, 1), group(text, m, 2)
+		n, err := strconv.Atoi(digits)
+		if err != nil || n == 0 || n > 99 {
+			return readKoNumber(digits) + " " + counter, true
+		}
+		if n == 1 && counter == "번째" {
+			return "첫 번째", true
+		}
+		return nativeKorean(n, true) + " " + counter, true
+	}},
+	// 음수: -5 → 마이너스 오
+	{regexp.MustCompile(`(^|[\s(])-(` + numberPattern + `)`), func(text string, m []int) (string, bool) {
+		return group(text, m, 1) + "마이너스 " + readKoNumber(group(text, m, 2)), true
+	}},
+	// 남은 숫자: 영문자에 붙은 숫자(MP3, 3D)는 그대로 둠
+	{regexp.MustCompile(numberPattern), func(text string, m []int) (string, bool) {
+		if precededByWordChar(text, m[0]) || followedByLetter(text, m[1]) {
+			return "", false
+		}
+		return readKoNumber(text[m[0]:m[1]]), true
+	}},
+	// 약어
+	{regexp.MustCompile(`(?:` + alternation(keys(koAbbreviations)) + `)`), func(text string, m []int) (string, bool) {
+		if precededByWordChar(text, m[0]) || followedByLetter(text, m[1]) {
+			return "", false
+		}
+		return koAbbreviations[text[m[0]:m[1]]], true
+	}},
+}
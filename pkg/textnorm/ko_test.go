@@ -0,0 +1,92 @@
+package textnorm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize_Korean(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"sino cardinal", "총 1,000원입니다", "총 천원입니다"},
+		{"man without il", "12345명", "만 이천삼백사십오 명"},
+		{"large number", "3억 원", "삼억 원"},
+		{"decimal", "원주율은 3.14", "원주율은 삼 점 일사"},
+		{"leading zero digits", "코드 007", "코드 영영칠"},
+		{"negative", "기온 -5도", "기온 마이너스 오도"},
+		{"phone", "010-1234-5678로 연락", "공일공 일이삼사 오육칠팔로 연락"},
+		{"iso date", "2026-10-18에 만나요", "이천이십육 년 시월 십팔 일에 만나요"},
+		{"dotted date", "2026.06.05", "이천이십육 년 유월 오 일"},
+		{"month only", "6월과 10월", "유월과 시월"},
+		{"invalid month left", "13월", "십삼월"},
+		{"time with period", "오후 3:30에", "오후 세 시 삼십 분에"},
+		{"time on the hour", "12:00", "열두 시"},
+		{"time with seconds", "15:30:05", "열다섯 시 삼십 분 오 초"},
+		{"currency symbol", "$5 가격", "오 달러 가격"},
+		{"currency decimal", "$1,234.50", "천이백삼십사 점 오 달러"},
+		{"won symbol", "₩1,500", "천오백 원"},
+		{"percent", "30% 할인", "삼십 퍼센트 할인"},
+		{"unit", "3kg", "삼 킬로그램"},
+		{"unit with space", "2.5 km", "이 점 오 킬로미터"},
+		{"speed", "60km/h", "시속 육십 킬로미터"},
+		{"temperature", "25°C", "섭씨 이십오 도"},
+		{"unit not matched inside word", "3min", "3min"},
+		{"native counter", "사과 3개", "사과 세 개"},
+		{"native counter twenty", "20살", "스무 살"},
+		{"native counter compound", "21명", "스물한 명"},
+		{"hours", "3 시간", "세 시간"},
+		{"first", "1번째", "첫 번째"},
+		{"large counter falls back to sino", "150개", "백오십 개"},
+		{"months are sino", "3개월", "삼 개월"},
+		{"alnum untouched", "MP3 파일", "MP3 파일"},
+		{"abbreviation", "한국 vs 일본", "한국 대 일본"},
+		{"no digits", "안녕하세요", "안녕하세요"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Normalize(tt.in, "ko"))
+		})
+	}
+}
+
+func TestSinoKorean(t *testing.T) {
+	tests := map[int64]string{
+		0:         "영",
+		1:         "일",
+		10:        "십",
+		11:        "십일",
+		101:       "백일",
+		1000:      "천",
+		2026:      "이천이십육",
+		10000:     "만",
+		10001:     "만 일",
+		100000000: "일억",
+		123456789: "일억 이천삼백사십오만 육천칠백팔십구",
+		-3:        "마이너스 삼",
+	}
+	for n, want := range tests {
+		assert.Equal(t, want, sinoKorean(n), n)
+	}
+}
+
+func TestNativeKorean(t *testing.T) {
+	assert.Equal(t, "하나", nativeKorean(1, false))
+	assert.Equal(t, "한", nativeKorean(1, true))
+	assert.Equal(t, "스물", nativeKorean(20, false))
+	assert.Equal(t, "스무", nativeKorean(20, true))
+	assert.Equal(t, "아흔아홉", nativeKorean(99, false))
+	assert.Equal(t, "아흔아홉", nativeKorean(99, true))
+}
+
+func TestNormalize_LanguageDispatch(t *testing.T) {
+	assert.Equal(t, "세 개", Normalize("3개", "ko-KR"))
+	assert.Equal(t, "three", Normalize("3", "en_US"))
+	assert.Equal(t, "3つ", Normalize("3つ", "ja"))
+	assert.True(t, Supported("KO"))
+	assert.False(t, Supported("ja"))
+}
@@ -0,0 +1,109 @@
+// Package textnorm은 TTS 입력 텍스트의 숫자, 날짜, 시간, 통화, 백분율, 단위, 약어를 읽는 형태로 풀어 씁니다.
+package textnorm
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Normalize는 language("ko", "en", "ko-KR" 등)에 맞는 규칙으로 텍스트를 정규화합니다.
+// 지원하지 않는 언어는 그대로 반환합니다.
+func Normalize(text, language string) string {
+	switch baseLanguage(language) {
+	case "ko":
+		return apply(koRules, text)
+	case "en":
+		return apply(enRules, text)
+	}
+	return text
+}
+
+// Supported는 언어에 정규화 규칙이 있는지 반환합니다.
+func Supported(language string) bool {
+	switch baseLanguage(language) {
+	case "ko", "en":
+		return true
+	}
+	return false
+}
+
+func baseLanguage(language string) string {
+	l := strings.ToLower(language)
+	if i := strings.IndexAny(l, "-_"); i >= 0 {
+		return l[:i]
+	}
+	return l
+}
+
+// rule은 정규식과 치환 함수입니다. 치환 함수가 false를 반환하면 해당 일치는 그대로 둡니다.
+// m은 regexp.FindStringSubmatchIndex 형식이며, text는 전체 입력입니다.
+type rule struct {
+	re      *regexp.Regexp
+	replace func(text string, m []int) (string, bool)
+}
+
+func apply(rules []rule, text string) string {
+	for _, r := range rules {
+		text = applyRule(r, text)
+	}
+	return text
+}
+
+func applyRule(r rule, text string) string {
+	matches := r.re.FindAllStringSubmatchIndex(text, -1)
+	if matches == nil {
+		return text
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		out, ok := r.replace(text, m)
+		if !ok {
+			continue
+		}
+		b.WriteString(text[last:m[0]])
+		b.WriteString(out)
+		last = m[1]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// group은 i번째 그룹 문자열을 반환합니다. 일치하지 않은 그룹은 빈 문자열입니다.
+func group(text string, m []int, i int) string {
+	if 2*i+1 >= len(m) || m[2*i] < 0 {
+		return ""
+	}
+	return text[m[2*i]:m[2*i+1]]
+}
+
+// followedByLetter는 일치 바로 뒤가 ASCII 영문자인지 반환합니다. "3min"의 "m" 같은 오인식을 막는 데 씁니다.
+func followedByLetter(text string, end int) bool {
+	r, _ := utf8.DecodeRuneInString(text[end:])
+	return r < utf8.RuneSelf && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+}
+
+// precededByWordChar는 일치 바로 앞이 ASCII 영숫자인지 반환합니다.
+func precededByWordChar(text string, start int) bool {
+	r, _ := utf8.DecodeLastRuneInString(text[:start])
+	return r < utf8.RuneSelf && (r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+}
+
+// number는 "1,234.5" 형식의 숫자 문자열을 정수부와 소수부 숫자열로 나눕니다.
+func number(s string) (intPart int64, fraction string, ok bool) {
+	s = strings.ReplaceAll(s, ",", "")
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" {
+		whole = "0"
+	}
+	n, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	return n, frac, true
+}
+
+const numberPattern = `\d{1,3}(?:,\d{3})+(?:\.\d+)?|\d+(?:\.\d+)?`
@@ -0,0 +1,79 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSpans() []SpanData {
+	sc, _ := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	start := time.Unix(1760864400, 5)
+	return []SpanData{{
+		SpanContext:   sc,
+		Parent:        SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		Name:          "supertone.synthesize",
+		Kind:          KindClient,
+		Start:         start,
+		End:           start.Add(time.Second),
+		Attributes:    []Attribute{String("voice_id", "v1"), Int("chars", 12), Bool("cached", false), Float("ratio", 0.5)},
+		Status:        StatusError,
+		StatusMessage: "TTS API error: 503",
+	}}
+}
+
+func TestWriterExporter(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, NewWriterExporter(&buf, "tts_proxy").Export(context.Background(), testSpans()))
+
+	assert.JSONEq(t, `{"resourceSpans":[{
+		"resource":{"attributes":[{"key":"service.name","value":{"stringValue":"tts_proxy"}}]},
+		"scopeSpans":[{"scope":{"name":"tts_proxy/pkg/tracing"},"spans":[{
+			"traceId":"4bf92f3577b34da6a3ce929d0e0e4736",
+			"spanId":"00f067aa0ba902b7",
+			"parentSpanId":"0102030405060708",
+			"name":"supertone.synthesize",
+			"kind":3,
+			"startTimeUnixNano":"1760864400000000005",
+			"endTimeUnixNano":"1760864401000000005",
+			"attributes":[
+				{"key":"voice_id","value":{"stringValue":"v1"}},
+				{"key":"chars","value":{"intValue":"12"}},
+				{"key":"cached","value":{"boolValue":false}},
+				{"key":"ratio","value":{"doubleValue":0.5}}
+			],
+			"status":{"code":2,"message":"TTS API error: 503"}
+		}]}]
+	}]}`, buf.String())
+	assert.Equal(t, byte('\n'), buf.Bytes()[buf.Len()-1])
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+		if got == nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	require.NoError(t, NewOTLPExporter(server.URL+"/", "tts_proxy").Export(context.Background(), testSpans()))
+	assert.Contains(t, got, "resourceSpans")
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	assert.EqualError(t, NewOTLPExporter(server.URL, "tts_proxy").Export(context.Background(), testSpans()), "OTLP export failed: 503 Service Unavailable")
+}
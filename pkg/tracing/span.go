@@ -0,0 +1,260 @@
+package tracing
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SpanKind는 OTLP의 스팬 종류입니다.
+type SpanKind int
+
+const (
+	KindInternal SpanKind = 1
+	KindServer   SpanKind = 2
+	KindClient   SpanKind = 3
+)
+
+// StatusCode는 OTLP의 스팬 상태입니다.
+type StatusCode int
+
+const (
+	StatusUnset StatusCode = 0
+	StatusOK    StatusCode = 1
+	StatusError StatusCode = 2
+)
+
+// Attribute는 스팬에 붙는 키-값입니다. 값은 string, bool, int, int64, float64 중 하나입니다.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+func String(key, value string) Attribute        { return Attribute{key, value} }
+func Int(key string, value int) Attribute       { return Attribute{key, int64(value)} }
+func Bool(key string, value bool) Attribute     { return Attribute{key, value} }
+func Float(key string, value float64) Attribute { return Attribute{key, value} }
+
+// SpanData는 끝난 스팬의 내보내기용 데이터입니다.
+type SpanData struct {
+	SpanContext
+	Parent        SpanID // 루트 스팬이면 0
+	Name          string
+	Kind          SpanKind
+	Start, End    time.Time
+	Attributes    []Attribute
+	Status        StatusCode
+	StatusMessage string
+}
+
+// Span은 진행 중인 작업 하나입니다. nil Span의 메서드는 아무 일도 하지 않으므로 추적이 꺼져 있어도 그대로 호출할 수 있습니다.
+type Span struct {
+	tracer *Tracer
+
+	mu    sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.data.SpanContext
+}
+
+func (s *Span) SetName(name string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.data.Name = name
+	s.mu.Unlock()
+}
+
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.data.Attributes = append(s.data.Attributes, attrs...)
+	s.mu.Unlock()
+}
+
+// RecordError는 err가 nil이 아니면 스팬 상태를 오류로 표시합니다.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.SetStatus(StatusError, err.Error())
+}
+
+func (s *Span) SetStatus(code StatusCode, message string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.data.Status, s.data.StatusMessage = code, message
+	s.mu.Unlock()
+}
+
+// End는 스팬을 끝내고 표본으로 선택된 스팬이면 내보내기 대기열에 넣습니다. 두 번째 호출부터는 무시합니다.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		return
+	}
+	s.ended = true
+	s.data.End = s.tracer.now()
+	data := s.data
+	s.mu.Unlock()
+
+	if data.Sampled {
+		s.tracer.enqueue(data)
+	}
+}
+
+// Tracer는 스팬을 만들고 끝난 스팬을 모아 Exporter로 내보냅니다.
+type Tracer struct {
+	exporter    Exporter
+	sampleRatio float64
+	batchSize   int
+	now         func() time.Time
+
+	mu      sync.Mutex
+	pending []SpanData
+	flushCh chan struct{}
+	done    chan struct{}
+	stopped atomic.Bool
+	wg      sync.WaitGroup
+}
+
+// TracerOptions는 Tracer 설정입니다.
+type TracerOptions struct {
+	// SampleRatio는 새로 시작하는 추적 중 기록할 비율(0~1)입니다. 부모가 있으면 부모의 결정을 따릅니다.
+	SampleRatio float64
+	// BatchSize개가 모이거나 FlushInterval이 지나면 내보냅니다.
+	BatchSize     int
+	FlushInterval time.Duration
+}
+
+// NewTracer는 exporter로 스팬을 내보내는 Tracer를 만들고 백그라운드 내보내기를 시작합니다.
+func NewTracer(exporter Exporter, opts TracerOptions) *Tracer {
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 512
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = 5 * time.Second
+	}
+	t := &Tracer{
+		exporter:    exporter,
+		sampleRatio: math.Max(0, math.Min(1, opts.SampleRatio)),
+		batchSize:   opts.BatchSize,
+		now:         time.Now,
+		flushCh:     make(chan struct{}, 1),
+		done:        make(chan struct{}),
+	}
+	t.wg.Add(1)
+	go t.loop(opts.FlushInterval)
+	return t
+}
+
+// Start는 컨텍스트의 현재 스팬이나 원격 부모의 자식 스팬을 시작합니다. t가 nil이면 추적하지 않습니다.
+func (t *Tracer) Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, *Span) {
+	if t == nil {
+		return ctx, nil
+	}
+	parent := SpanContextFromContext(ctx)
+	sc := SpanContext{SpanID: newSpanID()}
+	if parent.IsValid() {
+		sc.TraceID, sc.Sampled = parent.TraceID, parent.Sampled
+	} else {
+		sc.TraceID = newTraceID()
+		sc.Sampled = t.sampleRatio >= 1 || rand.Float64() < t.sampleRatio
+	}
+	span := &Span{tracer: t, data: SpanData{
+		SpanContext: sc,
+		Parent:      parent.SpanID,
+		Name:        name,
+		Kind:        kind,
+		Start:       t.now(),
+		Attributes:  attrs,
+	}}
+	return context.WithValue(ctx, spanKey, span), span
+}
+
+func (t *Tracer) enqueue(data SpanData) {
+	if t.stopped.Load() {
+		return
+	}
+	t.mu.Lock()
+	t.pending = append(t.pending, data)
+	full := len(t.pending) >= t.batchSize
+	t.mu.Unlock()
+	if full {
+		select {
+		case t.flushCh <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *Tracer) loop(interval time.Duration) {
+	defer t.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-t.flushCh:
+		case <-t.done:
+			return
+		}
+		t.Flush(context.Background())
+	}
+}
+
+// Flush는 대기 중인 스팬을 바로 내보냅니다.
+func (t *Tracer) Flush(ctx context.Context) error {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	batch := t.pending
+	t.pending = nil
+	t.mu.Unlock()
+	if len(batch) == 0 {
+		return nil
+	}
+	return t.exporter.Export(ctx, batch)
+}
+
+// Shutdown은 백그라운드 내보내기를 멈추고 남은 스팬을 내보냅니다. 이후 끝나는 스팬은 버립니다.
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	if t == nil || t.stopped.Swap(true) {
+		return nil
+	}
+	close(t.done)
+	t.wg.Wait()
+	return t.Flush(ctx)
+}
+
+var defaultTracer atomic.Pointer[Tracer]
+
+// SetDefault는 Start가 사용할 기본 Tracer를 설정합니다. nil이면 추적을 끕니다.
+func SetDefault(t *Tracer) { defaultTracer.Store(t) }
+
+// Default는 기본 Tracer를 반환합니다. 설정하지 않았으면 nil입니다.
+func Default() *Tracer { return defaultTracer.Load() }
+
+// Start는 기본 Tracer로 스팬을 시작합니다. 기본 Tracer가 없으면 ctx를 그대로, 스팬은 nil을 반환합니다.
+func Start(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, *Span) {
+	return Default().Start(ctx, name, kind, attrs...)
+}
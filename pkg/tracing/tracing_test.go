@@ -0,0 +1,121 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	sc, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", sc.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", sc.SpanID.String())
+	assert.True(t, sc.Sampled)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", sc.Traceparent())
+
+	// 상위 버전은 뒤에 필드가 더 있어도 앞 네 필드를 읽음
+	sc, err = ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00-extra")
+	require.NoError(t, err)
+	assert.False(t, sc.Sampled)
+
+	for _, s := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902zz-01",
+	} {
+		_, err := ParseTraceparent(s)
+		assert.Error(t, err, s)
+	}
+}
+
+type memoryExporter struct{ spans []SpanData }
+
+func (m *memoryExporter) Export(ctx context.Context, spans []SpanData) error {
+	m.spans = append(m.spans, spans...)
+	return nil
+}
+
+func TestTracer_Propagation(t *testing.T) {
+	exporter := &memoryExporter{}
+	tracer := NewTracer(exporter, TracerOptions{SampleRatio: 1})
+
+	ctx := Extract(context.Background(), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx, server := tracer.Start(ctx, "HTTP POST", KindServer)
+	clientCtx, client := tracer.Start(ctx, "upstream", KindClient, String("voice_id", "v1"))
+
+	header := http.Header{}
+	Inject(clientCtx, header)
+	assert.Equal(t, client.SpanContext().Traceparent(), header.Get(TraceparentHeader))
+
+	client.SetAttributes(Int("http.response.status_code", 200))
+	client.End()
+	server.SetName("HTTP POST /tts")
+	server.End()
+	server.End() // 두 번째 End는 무시
+	require.NoError(t, tracer.Shutdown(context.Background()))
+
+	require.Len(t, exporter.spans, 2)
+	upstream, root := exporter.spans[0], exporter.spans[1]
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", root.TraceID.String())
+	assert.Equal(t, "00f067aa0ba902b7", root.Parent.String())
+	assert.Equal(t, "HTTP POST /tts", root.Name)
+	assert.Equal(t, root.TraceID, upstream.TraceID)
+	assert.Equal(t, root.SpanID, upstream.Parent)
+	assert.Equal(t, []Attribute{String("voice_id", "v1"), Int("http.response.status_code", 200)}, upstream.Attributes)
+
+	// Shutdown 뒤에 끝난 스팬은 버림
+	_, late := tracer.Start(context.Background(), "late", KindInternal)
+	late.End()
+	assert.NoError(t, tracer.Flush(context.Background()))
+	assert.Len(t, exporter.spans, 2)
+}
+
+func TestTracer_Sampling(t *testing.T) {
+	exporter := &memoryExporter{}
+	tracer := NewTracer(exporter, TracerOptions{SampleRatio: 0})
+
+	ctx, span := tracer.Start(context.Background(), "dropped", KindServer)
+	assert.True(t, span.SpanContext().IsValid())
+	assert.False(t, span.SpanContext().Sampled)
+	_, child := tracer.Start(ctx, "child", KindInternal)
+	child.End()
+	span.End()
+
+	// 부모가 표본이면 비율과 관계없이 기록
+	ctx = Extract(context.Background(), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	_, kept := tracer.Start(ctx, "kept", KindServer)
+	kept.End()
+	require.NoError(t, tracer.Shutdown(context.Background()))
+
+	require.Len(t, exporter.spans, 1)
+	assert.Equal(t, "kept", exporter.spans[0].Name)
+}
+
+func TestNoTracer(t *testing.T) {
+	// 기본 Tracer가 없어도 들어온 traceparent는 그대로 전달
+	SetDefault(nil)
+	ctx := Extract(context.Background(), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ctx, span := Start(ctx, "noop", KindInternal)
+	assert.Nil(t, span)
+	span.SetAttributes(String("a", "b"))
+	span.RecordError(assert.AnError)
+	span.End()
+
+	header := http.Header{}
+	Inject(ctx, header)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", header.Get(TraceparentHeader))
+
+	header = http.Header{}
+	Inject(context.Background(), header)
+	assert.Empty(t, header.Get(TraceparentHeader))
+}
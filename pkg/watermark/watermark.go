@@ -0,0 +1,339 @@
+// Package watermark는 합성 음성에 사람이 듣기 어려운 대역 확산(spread-spectrum) 워터마크를 삽입하고 검출합니다.
+//
+// 페이로드(요청 ID 64비트, 사용자 지문 32비트, CRC-32)는 키로 뒤섞은 뒤 비트마다 키에서 만든 의사 잡음(PN) 칩열의 부호로
+// 실립니다. 칩열은 8kHz로 만들어 원본 표본화율로 올리므로 4kHz 아래 대역에 놓이고, 8kHz 이상으로의 리샘플링, 16비트 양자화,
+// G.711, 음량 변화, 앞뒤 자르기를 견딥니다. 음성 에너지에 비례해 크기를 바꿔 음성에 가려지도록 하며,
+// 삽입할 때 원본 음성이 칩열과 상관된 성분을 비트마다 상쇄해(Improved Spread Spectrum) 검출 시 음성의 간섭을 없앱니다.
+// 페이로드는 오디오 끝까지 반복되어 검출 시 여러 사본을 합칩니다.
+package watermark
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"hash/crc32"
+	"math"
+	"sort"
+
+	"tts_proxy/pkg/audio"
+)
+
+// ErrNotFound는 오디오에서 워터마크를 찾지 못했을 때 반환됩니다.
+var ErrNotFound = errors.New("watermark not found")
+
+const (
+	chipRate    = 8000 // 칩열 표본화율, 검출도 이 표본화율에서 함
+	bitChips    = 96   // 비트당 칩 수 (12ms)
+	frameBits   = 128  // 요청 ID 64 + 사용자 지문 32 + CRC-32
+	strengthDB  = -24  // 음성 RMS 대비 워터마크 크기
+	floorDB     = -66  // 무음 구간에서도 유지하는 워터마크 크기 (dBFS)
+	envWindowMS = 10   // 음성 에너지 창 길이
+	maxBitGain  = 4.0  // 원본 상쇄분을 포함한 비트별 워터마크 크기 상한 (envelope 배수)
+
+	embedIterations = 2 // 리샘플링 오차를 보정하는 횟수
+	chaseBits       = 6 // CRC가 맞지 않을 때 뒤집어 볼 가장 불확실한 비트 수
+)
+
+// MinDuration은 페이로드 한 벌을 싣는 데 필요한 오디오 길이(초)입니다.
+const MinDuration = float64(frameBits*bitChips) / chipRate
+
+// Payload는 워터마크에 싣는 정보입니다.
+type Payload struct {
+	RequestID uint64 // 요청 ID (RequestTag로 변환)
+	UserHash  uint32 // 사용자 지문 (UserHash로 계산)
+}
+
+// Detection은 검출 결과입니다.
+type Detection struct {
+	Payload
+	// Confidence는 합치기 전 개별 비트 읽기 중 복원한 페이로드와 일치하는 비율입니다. 무작위이면 0.5 근처입니다.
+	Confidence float64
+	// Copies는 합친 페이로드 사본 수입니다. 오디오 끝의 일부 사본도 포함합니다.
+	Copies float64
+}
+
+// RequestTag는 요청 ID를 64비트 값으로 바꿉니다. 16자리 16진수이면 그대로 읽고, 아니면 SHA-256 앞 8바이트를 씁니다.
+func RequestTag(requestID string) uint64 {
+	if b, err := hex.DecodeString(requestID); err == nil && len(b) == 8 {
+		return binary.BigEndian.Uint64(b)
+	}
+	sum := sha256.Sum256([]byte(requestID))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// UserHash는 키로 계산한 사용자 ID의 32비트 지문입니다. 키가 없으면 지문으로 사용자를 추측할 수 없습니다.
+func UserHash(key []byte, userID string) uint32 {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("user:" + userID))
+	return binary.BigEndian.Uint32(mac.Sum(nil))
+}
+
+// Embed는 p에 payload를 담은 워터마크를 더합니다. 모든 채널에 같은 신호를 더하며 p를 직접 바꿉니다.
+func Embed(p *audio.PCM, key []byte, payload Payload) {
+	if p.Frames() == 0 || p.SampleRate < chipRate {
+		return
+	}
+
+	// 검출기와 같은 방법으로 8kHz 모노 원본을 만들어 비트마다 칩열과의 상관값을 상쇄
+	host := audio.Resample(audio.RemixChannels(p, 1), chipRate).Samples
+	env := envelope(host)
+	bits := frameSymbols(key, payload)
+	pn := pnSequence(key)
+
+	nbits := (len(host) + bitChips - 1) / bitChips
+	level := make([]float64, nbits)
+	gain := make([]float64, nbits)
+	for k := range gain {
+		start, end := k*bitChips, min((k+1)*bitChips, len(host))
+		for _, v := range env[start:end] {
+			level[k] += v
+		}
+		level[k] /= float64(end - start)
+		gain[k] = bits[k%frameBits] - correlate(host[start:end], pn)/level[k]
+	}
+
+	// 원본 표본화율로 올렸다 다시 내리면 대역 끝이 깎이므로, 검출기가 볼 상관값을 계산해 남은 오차만큼 이득을 보정
+	var mark *audio.PCM
+	for iter := 0; ; iter++ {
+		mark = &audio.PCM{SampleRate: chipRate, Channels: 1, Samples: make([]float64, len(host))}
+		for k := range gain {
+			gain[k] = min(max(gain[k], -maxBitGain), maxBitGain)
+			for n := k * bitChips; n < min((k+1)*bitChips, len(host)); n++ {
+				mark.Samples[n] = gain[k] * env[n] * pn[n-k*bitChips]
+			}
+		}
+		mark = audio.Resample(mark, p.SampleRate)
+		if iter == embedIterations || p.SampleRate == chipRate {
+			break
+		}
+		seen := audio.Resample(mark, chipRate).Samples
+		for k := range gain {
+			start, end := k*bitChips, min((k+1)*bitChips, len(host), len(seen))
+			if start >= end {
+				continue
+			}
+			actual := (correlate(host[start:end], pn) + correlate(seen[start:end], pn)) / level[k]
+			gain[k] += bits[k%frameBits] - actual
+		}
+	}
+
+	for f := 0; f < p.Frames() && f < len(mark.Samples); f++ {
+		for ch := 0; ch < p.Channels; ch++ {
+			p.Samples[f*p.Channels+ch] += mark.Samples[f]
+		}
+	}
+}
+
+// Detect는 p에서 워터마크를 찾아 페이로드를 반환합니다. 찾지 못하면 ErrNotFound를 반환합니다.
+func Detect(p *audio.PCM, key []byte) (*Detection, error) {
+	if p.Frames() == 0 || p.SampleRate < chipRate {
+		return nil, ErrNotFound
+	}
+	y := audio.Resample(audio.RemixChannels(p, 1), chipRate).Samples
+	pn := pnSequence(key)
+
+	// 비트 경계 찾기: 앞부분이 잘렸을 수 있으므로 칩 단위 위치마다 비트 상관값의 에너지를 비교
+	offset, best := 0, -1.0
+	for o := 0; o < bitChips && o+bitChips <= len(y); o++ {
+		var energy float64
+		for k := o; k+bitChips <= len(y); k += bitChips {
+			c := bitValue(y[k:k+bitChips], pn)
+			energy += c * c
+		}
+		if energy > best {
+			offset, best = o, energy
+		}
+	}
+	var soft []float64
+	for k := offset; k+bitChips <= len(y); k += bitChips {
+		soft = append(soft, bitValue(y[k:k+bitChips], pn))
+	}
+	if len(soft) < frameBits {
+		return nil, ErrNotFound
+	}
+
+	// 페이로드 시작 찾기: 가능한 시작 위치마다 사본을 합쳐 CRC가 맞는지 확인
+	scramble := keyStream(key, "frame", frameBits)
+	var found *Detection
+	for start := 0; start < frameBits; start++ {
+		frame := make([]float64, frameBits)
+		for k, c := range soft {
+			j := (k + start) % frameBits
+			frame[j] += c * scramble[j]
+		}
+		payload, ok := decodeFrame(frame)
+		if !ok {
+			continue
+		}
+		frame = payloadFrame(key, payload)
+		if conf := agreement(soft, frame, start, scramble); found == nil || conf > found.Confidence {
+			found = &Detection{Payload: payload, Confidence: conf, Copies: float64(len(soft)) / frameBits}
+		}
+	}
+	if found == nil {
+		return nil, ErrNotFound
+	}
+	return found, nil
+}
+
+// bitValue는 한 비트 구간의 칩열 상관값을 구간 RMS로 나눈 연판정 값입니다. 음량과 관계없이 비슷한 크기가 되므로
+// 원본 간섭이 상쇄된 무음 구간의 비트가 크게 반영됩니다.
+func bitValue(y, pn []float64) float64 {
+	level := rms(y)
+	if level == 0 {
+		return 0
+	}
+	return correlate(y, pn) / level
+}
+
+func rms(x []float64) float64 {
+	if len(x) == 0 {
+		return 0
+	}
+	var energy float64
+	for _, v := range x {
+		energy += v * v
+	}
+	return math.Sqrt(energy / float64(len(x)))
+}
+
+// frameSymbols는 페이로드 프레임을 키로 뒤섞은 ±1 심볼로 만듭니다.
+func frameSymbols(key []byte, payload Payload) []float64 {
+	data := make([]byte, 16)
+	binary.BigEndian.PutUint64(data[0:8], payload.RequestID)
+	binary.BigEndian.PutUint32(data[8:12], payload.UserHash)
+	binary.BigEndian.PutUint32(data[12:16], crc32.ChecksumIEEE(data[:12]))
+
+	scramble := keyStream(key, "frame", frameBits)
+	symbols := make([]float64, frameBits)
+	for i := range symbols {
+		symbols[i] = scramble[i]
+		if data[i/8]>>(7-i%8)&1 == 1 {
+			symbols[i] = -symbols[i]
+		}
+	}
+	return symbols
+}
+
+// decodeFrame은 뒤섞기를 되돌린 연판정 값에서 비트를 읽고 CRC를 확인합니다. CRC가 맞지 않으면 가장 불확실한
+// chaseBits개 비트를 뒤집는 모든 조합을 시도합니다 (Chase 복호).
+func decodeFrame(frame []float64) (Payload, bool) {
+	data := make([]byte, 16)
+	for i, v := range frame {
+		if v < 0 {
+			data[i/8] |= 1 << (7 - i%8)
+		}
+	}
+
+	weak := make([]int, len(frame))
+	for i := range weak {
+		weak[i] = i
+	}
+	sort.Slice(weak, func(a, b int) bool { return math.Abs(frame[weak[a]]) < math.Abs(frame[weak[b]]) })
+	weak = weak[:chaseBits]
+
+	for mask := 0; mask < 1<<chaseBits; mask++ {
+		candidate := append([]byte(nil), data...)
+		for b, i := range weak {
+			if mask>>b&1 == 1 {
+				candidate[i/8] ^= 1 << (7 - i%8)
+			}
+		}
+		if crc32.ChecksumIEEE(candidate[:12]) == binary.BigEndian.Uint32(candidate[12:16]) {
+			return Payload{RequestID: binary.BigEndian.Uint64(candidate[0:8]), UserHash: binary.BigEndian.Uint32(candidate[8:12])}, true
+		}
+	}
+	return Payload{}, false
+}
+
+// payloadFrame은 페이로드의 뒤섞기 전 ±1 값(1비트는 -1)을 반환합니다.
+func payloadFrame(key []byte, payload Payload) []float64 {
+	symbols := frameSymbols(key, payload)
+	scramble := keyStream(key, "frame", frameBits)
+	for i := range symbols {
+		symbols[i] *= scramble[i]
+	}
+	return symbols
+}
+
+// agreement는 사본을 합치기 전의 비트 읽기 soft 중 합친 결과 frame과 부호가 같은 비율을 반환합니다.
+func agreement(soft, frame []float64, start int, scramble []float64) float64 {
+	agree := 0
+	for k, c := range soft {
+		j := (k + start) % frameBits
+		if (c*scramble[j] < 0) == (frame[j] < 0) {
+			agree++
+		}
+	}
+	return float64(agree) / float64(len(soft))
+}
+
+// pnSequence는 비트마다 쓰는 ±1 칩열입니다.
+func pnSequence(key []byte) []float64 {
+	return keyStream(key, "chips", bitChips)
+}
+
+// keyStream은 HMAC-SHA256 카운터 모드로 키와 label에 따라 정해지는 ±1 수열을 만듭니다.
+func keyStream(key []byte, label string, n int) []float64 {
+	out := make([]float64, 0, n)
+	var counter [4]byte
+	for i := uint32(0); len(out) < n; i++ {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(label))
+		binary.BigEndian.PutUint32(counter[:], i)
+		mac.Write(counter[:])
+		for _, b := range mac.Sum(nil) {
+			for bit := 7; bit >= 0 && len(out) < n; bit-- {
+				out = append(out, float64(int(b>>bit&1)*2-1))
+			}
+		}
+	}
+	return out
+}
+
+// envelope은 칩별 워터마크 크기입니다. 10ms 창 RMS를 창 중심 사이에서 보간해 strengthDB만큼 줄이고,
+// floorDB 아래로는 내려가지 않게 합니다.
+func envelope(x []float64) []float64 {
+	window := chipRate * envWindowMS / 1000
+	windows := (len(x) + window - 1) / window
+	level := make([]float64, windows)
+	for w := range level {
+		start, end := w*window, min((w+1)*window, len(x))
+		var sum float64
+		for _, s := range x[start:end] {
+			sum += s * s
+		}
+		level[w] = math.Sqrt(sum / float64(end-start))
+	}
+
+	strength := math.Pow(10, strengthDB/20.0)
+	floor := math.Pow(10, floorDB/20.0)
+	env := make([]float64, len(x))
+	for n := range env {
+		t := float64(n)/float64(window) - 0.5
+		w := int(math.Floor(t))
+		var v float64
+		switch {
+		case w < 0:
+			v = level[0]
+		case w >= windows-1:
+			v = level[windows-1]
+		default:
+			f := t - float64(w)
+			v = level[w]*(1-f) + level[w+1]*f
+		}
+		env[n] = max(v*strength, floor)
+	}
+	return env
+}
+
+// correlate는 칩열과의 정규화된 상관값을 반환합니다.
+func correlate(e, pn []float64) float64 {
+	var sum float64
+	for i, v := range e {
+		sum += v * pn[i]
+	}
+	return sum / float64(len(e))
+}
@@ -0,0 +1,121 @@
+package watermark
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"tts_proxy/pkg/audio"
+)
+
+var testKey = []byte("test-watermark-key")
+
+// speechLike는 음절 단위로 켜지고 꺼지는 유성음 비슷한 신호입니다. 기본 주파수가 천천히 바뀌고 고조파가 옥타브당 약 6dB씩 줄며,
+// 음절 사이에 짧은 무음이 있습니다.
+func speechLike(sampleRate int, d time.Duration) *audio.PCM {
+	rng := rand.New(rand.NewSource(1))
+	p := audio.Silence(sampleRate, 1, d)
+	phase := 0.0
+	for i := range p.Samples {
+		t := float64(i) / float64(sampleRate)
+		f0 := 140 + 30*math.Sin(2*math.Pi*0.7*t)
+		phase += 2 * math.Pi * f0 / float64(sampleRate)
+		syllable := math.Max(0, math.Sin(2*math.Pi*3*t))
+		var v float64
+		for h := 1; h*int(f0) < sampleRate/2 && h <= 30; h++ {
+			v += math.Sin(float64(h)*phase) / float64(h)
+		}
+		p.Samples[i] = 0.2*syllable*v + 0.002*syllable*rng.NormFloat64()
+	}
+	return p
+}
+
+func clone(p *audio.PCM) *audio.PCM {
+	return &audio.PCM{SampleRate: p.SampleRate, Channels: p.Channels, Samples: append([]float64(nil), p.Samples...)}
+}
+
+func roundTripWAV(t *testing.T, p *audio.PCM) *audio.PCM {
+	out, err := audio.DecodeWAV(audio.EncodeWAV(p))
+	require.NoError(t, err)
+	return out
+}
+
+var testPayload = Payload{RequestID: 0x0123456789abcdef, UserHash: 0xdeadbeef}
+
+func TestEmbedDetect(t *testing.T) {
+	p := speechLike(48000, 6*time.Second)
+	Embed(p, testKey, testPayload)
+
+	got, err := Detect(roundTripWAV(t, p), testKey)
+	require.NoError(t, err)
+	assert.Equal(t, testPayload, got.Payload)
+	t.Logf("confidence %.1f copies %.1f", got.Confidence, got.Copies)
+}
+
+func TestDetect_Robustness(t *testing.T) {
+	original := speechLike(44100, 8*time.Second)
+	marked := clone(original)
+	Embed(marked, testKey, testPayload)
+
+	// 잡음 대비 음성 비: 워터마크가 원본보다 충분히 작아야 함
+	var sig, noise float64
+	for i, s := range original.Samples {
+		sig += s * s
+		d := marked.Samples[i] - s
+		noise += d * d
+	}
+	assert.Greater(t, 10*math.Log10(sig/noise), 20.0)
+
+	trimmed := clone(marked)
+	trimmed.Samples = trimmed.Samples[12345:]
+	quiet := clone(marked)
+	audio.Gain(quiet, -12)
+	stereo := audio.RemixChannels(marked, 2)
+	mulaw := audio.Resample(marked, 8000)
+	for i, v := range mulaw.Samples {
+		mulaw.Samples[i] = float64(audio.MuLawToLinear(audio.LinearToMuLaw(int16(v*32767)))) / 32768
+	}
+
+	for name, p := range map[string]*audio.PCM{
+		"trimmed":   trimmed,
+		"gain":      quiet,
+		"stereo":    stereo,
+		"resampled": audio.Resample(marked, 16000),
+		"mulaw":     mulaw,
+	} {
+		got, err := Detect(roundTripWAV(t, p), testKey)
+		if assert.NoError(t, err, name) {
+			assert.Equal(t, testPayload, got.Payload, name)
+			t.Logf("%s: confidence %.1f", name, got.Confidence)
+		}
+	}
+}
+
+func TestDetect_NotFound(t *testing.T) {
+	p := speechLike(24000, 6*time.Second)
+	_, err := Detect(p, testKey)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	Embed(p, testKey, testPayload)
+	_, err = Detect(p, []byte("other-key"))
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// 페이로드 한 벌보다 짧으면 검출할 수 없음
+	short := speechLike(24000, time.Second)
+	Embed(short, testKey, testPayload)
+	_, err = Detect(short, testKey)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestRequestTagAndUserHash(t *testing.T) {
+	assert.Equal(t, uint64(0x0123456789abcdef), RequestTag("0123456789abcdef"))
+	assert.Equal(t, RequestTag("req-1"), RequestTag("req-1"))
+	assert.NotEqual(t, RequestTag("req-1"), RequestTag("req-2"))
+
+	assert.Equal(t, UserHash(testKey, "alice"), UserHash(testKey, "alice"))
+	assert.NotEqual(t, UserHash(testKey, "alice"), UserHash(testKey, "bob"))
+	assert.NotEqual(t, UserHash(testKey, "alice"), UserHash([]byte("other-key"), "alice"))
+}